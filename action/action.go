@@ -90,7 +90,10 @@ func Verify(sealed SealedEnvelope) error {
 		return errors.Wrap(ErrInsufficientBalanceForGas, "insufficient gas")
 	}
 
-	hash := sealed.Envelope.Hash()
+	hash, err := sealed.envelopeHash()
+	if err != nil {
+		return errors.Wrap(err, "failed to get envelope hash")
+	}
 	if sealed.SrcPubkey().Verify(hash[:], sealed.Signature()) {
 		return nil
 	}
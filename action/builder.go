@@ -85,6 +85,13 @@ func (b *EnvelopeBuilder) SetVersion(v uint32) *EnvelopeBuilder {
 	return b
 }
 
+// SetChainID sets the chain ID which the action is signed for. It only takes effect with an envelope
+// version no lower than ChainIDEnvelopeVersion, which is the default if chain ID is set.
+func (b *EnvelopeBuilder) SetChainID(chainID uint32) *EnvelopeBuilder {
+	b.elp.chainID = chainID
+	return b
+}
+
 // SetNonce sets action's nonce.
 func (b *EnvelopeBuilder) SetNonce(n uint64) *EnvelopeBuilder {
 	b.elp.nonce = n
@@ -130,6 +137,12 @@ func (b *EnvelopeBuilder) Build() Envelope {
 	}
 	if b.elp.version == 0 {
 		b.elp.version = version.ProtocolVersion
+		if b.elp.chainID != 0 {
+			b.elp.version = ChainIDEnvelopeVersion
+		}
+	}
+	if b.elp.version < ChainIDEnvelopeVersion {
+		b.elp.chainID = 0
 	}
 	return b.elp
 }
@@ -32,3 +32,18 @@ func TestActionBuilder(t *testing.T) {
 	assert.Equal(t, uint64(10003), act.GasLimit())
 	assert.Equal(t, big.NewInt(10004), act.GasPrice())
 }
+
+func TestEnvelopeBuilderChainID(t *testing.T) {
+	elp := (&EnvelopeBuilder{}).SetNonce(1).Build()
+	assert.Equal(t, uint32(version.ProtocolVersion), elp.Version())
+	assert.Zero(t, elp.ChainID())
+
+	elp = (&EnvelopeBuilder{}).SetNonce(1).SetChainID(2).Build()
+	assert.Equal(t, uint32(ChainIDEnvelopeVersion), elp.Version())
+	assert.Equal(t, uint32(2), elp.ChainID())
+
+	// chain ID is dropped by an envelope version without it
+	elp = (&EnvelopeBuilder{}).SetVersion(version.ProtocolVersion).SetChainID(2).Build()
+	assert.Equal(t, uint32(version.ProtocolVersion), elp.Version())
+	assert.Zero(t, elp.ChainID())
+}
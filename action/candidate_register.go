@@ -10,6 +10,7 @@ import (
 	"math/big"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/pkg/errors"
 
 	"github.com/iotexproject/iotex-address/address"
@@ -42,6 +43,8 @@ type CandidateRegister struct {
 	duration        uint32
 	autoStake       bool
 	payload         []byte
+	// rewardDistributionRatio is the share of the epoch reward distributed to voters in basis points
+	rewardDistributionRatio uint32
 }
 
 // NewCandidateRegister creates a CandidateRegister instance
@@ -116,6 +119,14 @@ func (cr *CandidateRegister) OperatorAddress() address.Address { return cr.opera
 // RewardAddress returns candidate rewardAddress to register
 func (cr *CandidateRegister) RewardAddress() address.Address { return cr.rewardAddress }
 
+// RewardDistributionRatio returns the share of the epoch reward distributed to voters in basis points
+func (cr *CandidateRegister) RewardDistributionRatio() uint32 { return cr.rewardDistributionRatio }
+
+// SetRewardDistributionRatio sets the share of the epoch reward distributed to voters in basis points
+func (cr *CandidateRegister) SetRewardDistributionRatio(ratio uint32) {
+	cr.rewardDistributionRatio = ratio
+}
+
 // OwnerAddress returns candidate ownerAddress to register
 func (cr *CandidateRegister) OwnerAddress() address.Address { return cr.ownerAddress }
 
@@ -136,6 +147,10 @@ func (cr *CandidateRegister) Proto() *iotextypes.CandidateRegister {
 		AutoStake:      cr.autoStake,
 	}
 
+	if cr.rewardDistributionRatio > 0 {
+		act.Candidate.RewardDistributionRatio = &wrappers.UInt32Value{Value: cr.rewardDistributionRatio}
+	}
+
 	if cr.amount != nil {
 		act.StakedAmount = cr.amount.String()
 	}
@@ -171,6 +186,7 @@ func (cr *CandidateRegister) LoadProto(pbAct *iotextypes.CandidateRegister) erro
 
 	cr.operatorAddress = operatorAddr
 	cr.rewardAddress = rewardAddr
+	cr.rewardDistributionRatio = cInfo.GetRewardDistributionRatio().GetValue()
 	cr.duration = pbAct.GetStakedDuration()
 	cr.autoStake = pbAct.GetAutoStake()
 
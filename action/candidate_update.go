@@ -10,6 +10,7 @@ import (
 	"math/big"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/pkg/errors"
 
 	"github.com/iotexproject/iotex-address/address"
@@ -28,6 +29,9 @@ type CandidateUpdate struct {
 	name            string
 	operatorAddress address.Address
 	rewardAddress   address.Address
+	// rewardDistributionRatio is the share of the epoch reward distributed to voters in basis points, nil if not
+	// updated
+	rewardDistributionRatio *uint32
 }
 
 // NewCandidateUpdate creates a CandidateUpdate instance
@@ -73,6 +77,19 @@ func (cu *CandidateUpdate) OperatorAddress() address.Address { return cu.operato
 // RewardAddress returns candidate rewardAddress to update
 func (cu *CandidateUpdate) RewardAddress() address.Address { return cu.rewardAddress }
 
+// RewardDistributionRatio returns the reward distribution ratio to update, and whether it is updated
+func (cu *CandidateUpdate) RewardDistributionRatio() (uint32, bool) {
+	if cu.rewardDistributionRatio == nil {
+		return 0, false
+	}
+	return *cu.rewardDistributionRatio, true
+}
+
+// SetRewardDistributionRatio sets the reward distribution ratio to update in basis points
+func (cu *CandidateUpdate) SetRewardDistributionRatio(ratio uint32) {
+	cu.rewardDistributionRatio = &ratio
+}
+
 // Serialize returns a raw byte stream of the CandidateUpdate struct
 func (cu *CandidateUpdate) Serialize() []byte {
 	return byteutil.Must(proto.Marshal(cu.Proto()))
@@ -92,6 +109,9 @@ func (cu *CandidateUpdate) Proto() *iotextypes.CandidateBasicInfo {
 		act.RewardAddress = cu.rewardAddress.String()
 	}
 
+	if cu.rewardDistributionRatio != nil {
+		act.RewardDistributionRatio = &wrappers.UInt32Value{Value: *cu.rewardDistributionRatio}
+	}
 	return act
 }
 
@@ -118,6 +138,11 @@ func (cu *CandidateUpdate) LoadProto(pbAct *iotextypes.CandidateBasicInfo) error
 		}
 		cu.rewardAddress = rewardAddr
 	}
+
+	cu.rewardDistributionRatio = nil
+	if ratio := pbAct.GetRewardDistributionRatio(); ratio != nil {
+		cu.SetRewardDistributionRatio(ratio.GetValue())
+	}
 	return nil
 }
 
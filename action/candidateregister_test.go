@@ -105,6 +105,7 @@ func TestCandidateRegister(t *testing.T) {
 		require.Equal(test.Duration, cr2.Duration())
 		require.Equal(test.AutoStake, cr2.AutoStake())
 		require.Equal(test.Payload, cr2.Payload())
+		require.Zero(cr2.RewardDistributionRatio())
 
 		// verify sign
 		bd := &EnvelopeBuilder{}
@@ -124,6 +125,10 @@ func TestCandidateRegister(t *testing.T) {
 		require.Equal(test.SelpHash, hex.EncodeToString(hash[:]))
 		// verify signature
 		require.NoError(Verify(selp))
+
+		cr.SetRewardDistributionRatio(2500)
+		require.NoError(cr2.LoadProto(cr.Proto()))
+		require.EqualValues(2500, cr2.RewardDistributionRatio())
 	}
 
 }
@@ -54,6 +54,20 @@ func TestCandidateUpdate(t *testing.T) {
 	require.Equal(cuName, cu2.Name())
 	require.Equal(cuOperatorAddrStr, cu2.OperatorAddress().String())
 	require.Equal(cuRewardAddrStr, cu2.RewardAddress().String())
+	_, ok := cu2.RewardDistributionRatio()
+	require.False(ok)
+
+	// the reward distribution ratio is only updated when it is set, including to 0
+	cu.SetRewardDistributionRatio(0)
+	require.NoError(cu2.LoadProto(cu.Proto()))
+	ratio, ok := cu2.RewardDistributionRatio()
+	require.True(ok)
+	require.Zero(ratio)
+	cu.SetRewardDistributionRatio(3000)
+	require.NoError(cu2.LoadProto(cu.Proto()))
+	ratio, ok = cu2.RewardDistributionRatio()
+	require.True(ok)
+	require.EqualValues(3000, ratio)
 }
 
 func TestCandidateUpdateSignVerify(t *testing.T) {
@@ -29,4 +29,6 @@ var (
 	ErrVotee = errors.New("votee is not a candidate")
 	// ErrNotFound indicates the nonexistence of action
 	ErrNotFound = errors.New("action not found")
+	// ErrChainID indicates the error of chain ID
+	ErrChainID = errors.New("invalid chain ID")
 )
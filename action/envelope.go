@@ -12,9 +12,14 @@ import (
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
 )
 
+// ChainIDEnvelopeVersion is the first envelope version whose serialization, and hence the signed hash,
+// includes the chain ID
+const ChainIDEnvelopeVersion = 2
+
 // Envelope defines an envelope wrapped on action with some envelope metadata.
 type Envelope struct {
 	version  uint32
+	chainID  uint32
 	nonce    uint64
 	gasLimit uint64
 	payload  actionPayload
@@ -24,6 +29,18 @@ type Envelope struct {
 // Version returns the version
 func (elp *Envelope) Version() uint32 { return elp.version }
 
+// ChainID returns the chain ID, which is 0 for envelopes prior to ChainIDEnvelopeVersion
+func (elp *Envelope) ChainID() uint32 { return elp.chainID }
+
+// SetChainID sets the chain ID which the envelope is signed for, and upgrades the envelope to
+// ChainIDEnvelopeVersion if it is of a lower version
+func (elp *Envelope) SetChainID(chainID uint32) {
+	if elp.version < ChainIDEnvelopeVersion {
+		elp.version = ChainIDEnvelopeVersion
+	}
+	elp.chainID = chainID
+}
+
 // Nonce returns the nonce
 func (elp *Envelope) Nonce() uint64 { return elp.nonce }
 
@@ -72,6 +89,9 @@ func (elp *Envelope) Proto() *iotextypes.ActionCore {
 	if elp.gasPrice != nil {
 		actCore.GasPrice = elp.gasPrice.String()
 	}
+	if elp.version >= ChainIDEnvelopeVersion {
+		actCore.ChainID = elp.chainID
+	}
 
 	// TODO assert each action
 	act := elp.Action()
@@ -88,6 +108,8 @@ func (elp *Envelope) Proto() *iotextypes.ActionCore {
 		actCore.Action = &iotextypes.ActionCore_DepositToRewardingFund{DepositToRewardingFund: act.Proto()}
 	case *PutPollResult:
 		actCore.Action = &iotextypes.ActionCore_PutPollResult{PutPollResult: act.Proto()}
+	case *PutDoubleSignEvidence:
+		actCore.Action = &iotextypes.ActionCore_PutDoubleSignEvidence{PutDoubleSignEvidence: act.Proto()}
 	case *CreateStake:
 		actCore.Action = &iotextypes.ActionCore_StakeCreate{StakeCreate: act.Proto()}
 	case *Unstake:
@@ -102,6 +124,10 @@ func (elp *Envelope) Proto() *iotextypes.ActionCore {
 		actCore.Action = &iotextypes.ActionCore_StakeChangeCandidate{StakeChangeCandidate: act.Proto()}
 	case *TransferStake:
 		actCore.Action = &iotextypes.ActionCore_StakeTransferOwnership{StakeTransferOwnership: act.Proto()}
+	case *SplitStake:
+		actCore.Action = &iotextypes.ActionCore_StakeSplit{StakeSplit: act.Proto()}
+	case *MergeStake:
+		actCore.Action = &iotextypes.ActionCore_StakeMerge{StakeMerge: act.Proto()}
 	case *CandidateRegister:
 		actCore.Action = &iotextypes.ActionCore_CandidateRegister{CandidateRegister: act.Proto()}
 	case *CandidateUpdate:
@@ -126,6 +152,9 @@ func (elp *Envelope) LoadProto(pbAct *iotextypes.ActionCore) error {
 	elp.gasLimit = pbAct.GetGasLimit()
 	elp.gasPrice = &big.Int{}
 	elp.gasPrice.SetString(pbAct.GetGasPrice(), 10)
+	if elp.version >= ChainIDEnvelopeVersion {
+		elp.chainID = pbAct.GetChainID()
+	}
 
 	switch {
 	case pbAct.GetTransfer() != nil:
@@ -164,6 +193,12 @@ func (elp *Envelope) LoadProto(pbAct *iotextypes.ActionCore) error {
 			return err
 		}
 		elp.payload = act
+	case pbAct.GetPutDoubleSignEvidence() != nil:
+		act := &PutDoubleSignEvidence{}
+		if err := act.LoadProto(pbAct.GetPutDoubleSignEvidence()); err != nil {
+			return err
+		}
+		elp.payload = act
 
 	case pbAct.GetStakeCreate() != nil:
 		act := &CreateStake{}
@@ -207,6 +242,18 @@ func (elp *Envelope) LoadProto(pbAct *iotextypes.ActionCore) error {
 			return err
 		}
 		elp.payload = act
+	case pbAct.GetStakeSplit() != nil:
+		act := &SplitStake{}
+		if err := act.LoadProto(pbAct.GetStakeSplit()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetStakeMerge() != nil:
+		act := &MergeStake{}
+		if err := act.LoadProto(pbAct.GetStakeMerge()); err != nil {
+			return err
+		}
+		elp.payload = act
 	case pbAct.GetCandidateRegister() != nil:
 		act := &CandidateRegister{}
 		if err := act.LoadProto(pbAct.GetCandidateRegister()); err != nil {
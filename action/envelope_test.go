@@ -103,6 +103,33 @@ func TestEnvelope_Hash(t *testing.T) {
 	expH := hash.BytesToHash256(exp)
 	req.Equal(expH, h)
 }
+func TestEnvelope_ChainID(t *testing.T) {
+	req := require.New(t)
+	evlp, _ := createEnvelope()
+	req.Zero(evlp.ChainID())
+	legacyHash := evlp.Hash()
+
+	evlp.SetChainID(2)
+	req.Equal(uint32(ChainIDEnvelopeVersion), evlp.Version())
+	req.Equal(uint32(2), evlp.ChainID())
+	// chain ID is part of the serialization, hence the hash
+	req.NotEqual(legacyHash, evlp.Hash())
+	h := evlp.Hash()
+	evlp.SetChainID(1)
+	req.NotEqual(h, evlp.Hash())
+
+	pb := evlp.Proto()
+	loaded := Envelope{}
+	req.NoError(loaded.LoadProto(pb))
+	req.Equal(uint32(ChainIDEnvelopeVersion), loaded.Version())
+	req.Equal(uint32(1), loaded.ChainID())
+	req.Equal(evlp.Hash(), loaded.Hash())
+
+	// chain ID is ignored in a legacy envelope
+	pb.Version = ChainIDEnvelopeVersion - 1
+	req.NoError(loaded.LoadProto(pb))
+	req.Zero(loaded.ChainID())
+}
 func createEnvelope() (Envelope, *Transfer) {
 	tsf, _ := NewTransfer(
 		uint64(10),
@@ -37,6 +37,8 @@ type (
 	BlockchainCtx struct {
 		// Genesis is a copy of current genesis
 		Genesis genesis.Genesis
+		// ChainID is the ID of the chain
+		ChainID uint32
 		// Tip is the information of tip block
 		Tip TipInfo
 	}
@@ -190,10 +190,7 @@ func newContract(addr hash.Hash160, account *state.Account, sm protocol.StateMan
 	options := []mptrie.Option{
 		mptrie.KVStoreOption(newKVStoreForTrieWithStateManager(ContractKVNameSpace, sm)),
 		mptrie.KeyLengthOption(len(hash.Hash256{})),
-		mptrie.HashFuncOption(func(data []byte) []byte {
-			h := hash.Hash256b(append(addr[:], data...))
-			return h[:]
-		}),
+		mptrie.HashFuncOption(storageTrieHashFunc(addr)),
 	}
 	if account.Root != hash.ZeroHash256 {
 		options = append(options, mptrie.RootHashOption(account.Root[:]))
@@ -212,3 +209,42 @@ func newContract(addr hash.Hash160, account *state.Account, sm protocol.StateMan
 	c.trie = tr
 	return c, nil
 }
+
+// StorageProof returns the merkle proof of a storage slot against the storage root of a contract
+func StorageProof(sr protocol.StateReader, addr hash.Hash160, root hash.Hash256, key hash.Hash256) ([][]byte, error) {
+	if root == hash.ZeroHash256 {
+		// the contract has never written its storage
+		return nil, nil
+	}
+	tr, err := mptrie.New(
+		mptrie.KVStoreOption(newKVStoreForTrieWithStateReader(ContractKVNameSpace, sr)),
+		mptrie.KeyLengthOption(len(hash.Hash256{})),
+		mptrie.HashFuncOption(storageTrieHashFunc(addr)),
+		mptrie.RootHashOption(root[:]),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create storage trie for contract %x", addr)
+	}
+	if err := tr.Start(context.Background()); err != nil {
+		return nil, err
+	}
+	defer tr.Stop(context.Background())
+
+	return tr.GetProof(key[:])
+}
+
+// VerifyStorageProof verifies the proof of a storage slot, and returns the value of the slot if it exists
+func VerifyStorageProof(addr hash.Hash160, root hash.Hash256, key hash.Hash256, proof [][]byte) ([]byte, error) {
+	if root == hash.ZeroHash256 {
+		return nil, errors.Wrapf(trie.ErrNotExist, "contract %x has empty storage", addr)
+	}
+
+	return mptrie.VerifyProof(root[:], key[:], proof, mptrie.HashFuncOption(storageTrieHashFunc(addr)))
+}
+
+func storageTrieHashFunc(addr hash.Hash160) mptrie.HashFunc {
+	return func(data []byte) []byte {
+		h := hash.Hash256b(append(addr[:], data...))
+		return h[:]
+	}
+}
@@ -173,7 +173,12 @@ func ExecuteContract(
 	if err != nil {
 		return nil, nil, err
 	}
-	retval, depositGas, remainingGas, contractAddress, statusCode, err := executeInEVM(ps, stateDB, hu, blkCtx.GasLimit, blkCtx.BlockHeight)
+	var vmConfig vm.Config
+	if tracer, ok := GetTracer(ctx); ok {
+		vmConfig.Debug = true
+		vmConfig.Tracer = tracer
+	}
+	retval, depositGas, remainingGas, contractAddress, statusCode, err := executeInEVM(ps, stateDB, hu, vmConfig, blkCtx.GasLimit, blkCtx.BlockHeight)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -236,16 +241,15 @@ func getChainConfig(hu config.HeightUpgrade) *params.ChainConfig {
 }
 
 //Error in executeInEVM is a consensus issue
-func executeInEVM(evmParams *Params, stateDB *StateDBAdapter, hu config.HeightUpgrade, gasLimit uint64, blockHeight uint64) ([]byte, uint64, uint64, string, uint64, error) {
+func executeInEVM(evmParams *Params, stateDB *StateDBAdapter, hu config.HeightUpgrade, vmConfig vm.Config, gasLimit uint64, blockHeight uint64) ([]byte, uint64, uint64, string, uint64, error) {
 	isBering := hu.IsPost(config.Bering, blockHeight)
 	remainingGas := evmParams.gas
 	if err := securityDeposit(evmParams, stateDB, gasLimit); err != nil {
 		log.L().Warn("unexpected error: not enough security deposit", zap.Error(err))
 		return nil, 0, 0, action.EmptyAddress, uint64(iotextypes.ReceiptStatus_Failure), err
 	}
-	var config vm.Config
 	chainConfig := getChainConfig(hu)
-	evm := vm.NewEVM(evmParams.context, stateDB, chainConfig, config)
+	evm := vm.NewEVM(evmParams.context, stateDB, chainConfig, vmConfig)
 	intriGas, err := intrinsicGas(evmParams.data)
 	if err != nil {
 		return nil, evmParams.gas, remainingGas, action.EmptyAddress, uint64(iotextypes.ReceiptStatus_Failure), err
@@ -17,15 +17,27 @@ import (
 	"github.com/iotexproject/iotex-core/state"
 )
 
-type kvStoreForTrie struct {
-	nsOpt protocol.StateOption
-	sm    protocol.StateManager
-}
+type (
+	kvStoreForTrie struct {
+		nsOpt protocol.StateOption
+		sm    protocol.StateManager
+	}
+
+	// readOnlyKVStoreForTrie only supports reading trie nodes
+	readOnlyKVStoreForTrie struct {
+		nsOpt protocol.StateOption
+		sr    protocol.StateReader
+	}
+)
 
 func newKVStoreForTrieWithStateManager(ns string, sm protocol.StateManager) trie.KVStore {
 	return &kvStoreForTrie{nsOpt: protocol.NamespaceOption(ns), sm: sm}
 }
 
+func newKVStoreForTrieWithStateReader(ns string, sr protocol.StateReader) trie.KVStore {
+	return &readOnlyKVStoreForTrie{nsOpt: protocol.NamespaceOption(ns), sr: sr}
+}
+
 func (kv *kvStoreForTrie) Start(context.Context) error {
 	return nil
 }
@@ -61,3 +73,29 @@ func (kv *kvStoreForTrie) Get(key []byte) ([]byte, error) {
 	}
 	return value, err
 }
+
+func (kv *readOnlyKVStoreForTrie) Start(context.Context) error {
+	return nil
+}
+
+func (kv *readOnlyKVStoreForTrie) Stop(context.Context) error {
+	return nil
+}
+
+func (kv *readOnlyKVStoreForTrie) Put([]byte, []byte) error {
+	return errors.Wrap(trie.ErrInvalidTrie, "cannot put into a read only kvstore")
+}
+
+func (kv *readOnlyKVStoreForTrie) Delete([]byte) error {
+	return errors.Wrap(trie.ErrInvalidTrie, "cannot delete from a read only kvstore")
+}
+
+func (kv *readOnlyKVStoreForTrie) Get(key []byte) ([]byte, error) {
+	var value SerializableBytes
+	_, err := kv.sr.State(&value, protocol.KeyOption(key), kv.nsOpt)
+	switch errors.Cause(err) {
+	case state.ErrStateNotExist:
+		return nil, errors.Wrapf(db.ErrNotExist, "failed to find key %x", key)
+	}
+	return value, err
+}
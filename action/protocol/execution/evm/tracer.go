@@ -0,0 +1,293 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+type (
+	tracerContextKey struct{}
+
+	// CallFrame is a message call or contract creation in the call tree of an execution
+	CallFrame struct {
+		// Type is the opcode name of the call, i.e., CALL, CALLCODE, DELEGATECALL, STATICCALL, CREATE or CREATE2
+		Type    string
+		From    common.Address
+		To      common.Address
+		Value   *big.Int
+		Gas     uint64
+		GasUsed uint64
+		Input   []byte
+		Output  []byte
+		// Error is the reason of the call failure, which is empty if the call succeeds
+		Error string
+		Calls []*CallFrame
+
+		lastGas  uint64
+		lastCost uint64
+	}
+
+	// StorageChange is the change of a storage slot during an execution
+	StorageChange struct {
+		Original common.Hash
+		Current  common.Hash
+	}
+
+	// Tracer records the opcode-level trace of an execution, together with the tree of internal calls and the
+	// storage changes
+	Tracer struct {
+		structLogger *vm.StructLogger
+		env          *vm.EVM
+		root         *CallFrame
+		// frames are the calls being executed, the last one of which is at the current depth
+		frames []*CallFrame
+		// pending is the call issued by the last opcode, which is not entered yet
+		pending *CallFrame
+		storage map[common.Address]map[common.Hash]*StorageChange
+	}
+)
+
+// WithTracer adds a tracer into context, such that the executions in the context are traced
+func WithTracer(ctx context.Context, tracer *Tracer) context.Context {
+	return context.WithValue(ctx, tracerContextKey{}, tracer)
+}
+
+// GetTracer gets the tracer from context
+func GetTracer(ctx context.Context) (*Tracer, bool) {
+	tracer, ok := ctx.Value(tracerContextKey{}).(*Tracer)
+	return tracer, ok
+}
+
+// NewTracer creates a tracer, and the opcode-level trace is captured as configured, or not captured if cfg is nil
+func NewTracer(cfg *vm.LogConfig) *Tracer {
+	t := &Tracer{
+		storage: make(map[common.Address]map[common.Hash]*StorageChange),
+	}
+	if cfg != nil {
+		t.structLogger = vm.NewStructLogger(cfg)
+	}
+	return t
+}
+
+// StructLogs returns the opcode-level trace
+func (t *Tracer) StructLogs() []vm.StructLog {
+	if t.structLogger == nil {
+		return nil
+	}
+	return t.structLogger.StructLogs()
+}
+
+// CallTree returns the top level call of the execution, or nil if the execution doesn't reach the EVM
+func (t *Tracer) CallTree() *CallFrame {
+	return t.root
+}
+
+// StorageChanges returns the storage slots written by the execution, indexed by contract address
+func (t *Tracer) StorageChanges() map[common.Address]map[common.Hash]*StorageChange {
+	return t.storage
+}
+
+// CaptureStart implements vm.Tracer, which is called when the top level call starts
+func (t *Tracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	typ := vm.CALL
+	if create {
+		typ = vm.CREATE
+	}
+	t.root = &CallFrame{
+		Type:  typ.String(),
+		From:  from,
+		To:    to,
+		Value: new(big.Int).Set(value),
+		Gas:   gas,
+		Input: common.CopyBytes(input),
+	}
+	t.frames = []*CallFrame{t.root}
+	if t.structLogger == nil {
+		return nil
+	}
+	return t.structLogger.CaptureStart(from, to, create, input, gas, value)
+}
+
+// CaptureState implements vm.Tracer, which is called before each opcode is executed
+func (t *Tracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	t.env = env
+	if t.root != nil {
+		t.enter(depth, gas)
+		t.exit(depth, stack)
+		frame := t.frames[len(t.frames)-1]
+		frame.lastGas, frame.lastCost = gas, cost
+		if err != nil {
+			frame.Error = err.Error()
+		} else {
+			t.captureOp(frame, op, memory, stack, contract)
+		}
+	}
+	if op == vm.SSTORE && stack != nil && len(stack.Data()) >= 1 {
+		t.captureStorage(env, contract.Address(), common.BigToHash(stack.Back(0)))
+	}
+	if t.structLogger == nil {
+		return nil
+	}
+	return t.structLogger.CaptureState(env, pc, op, gas, cost, memory, stack, contract, depth, err)
+}
+
+// CaptureFault implements vm.Tracer, which is called when an opcode fails
+func (t *Tracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if t.root != nil && err != nil {
+		t.frames[len(t.frames)-1].Error = err.Error()
+	}
+	if t.structLogger == nil {
+		return nil
+	}
+	return t.structLogger.CaptureFault(env, pc, op, gas, cost, memory, stack, contract, depth, err)
+}
+
+// CaptureEnd implements vm.Tracer, which is called when the top level call ends
+func (t *Tracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	if t.root != nil {
+		// calls never returning to their parents end together with the top level call
+		for i := len(t.frames) - 1; i > 0; i-- {
+			t.closeFrame(t.frames[i])
+			t.frames[i-1].Calls = append(t.frames[i-1].Calls, t.frames[i])
+		}
+		t.frames = t.frames[:1]
+		t.pending = nil
+		t.root.Output = common.CopyBytes(output)
+		t.root.GasUsed = gasUsed
+		if err != nil {
+			t.root.Error = err.Error()
+		}
+	}
+	if t.env != nil {
+		for addr, slots := range t.storage {
+			for key, change := range slots {
+				change.Current = t.env.StateDB.GetState(addr, key)
+			}
+		}
+	}
+	if t.structLogger == nil {
+		return nil
+	}
+	return t.structLogger.CaptureEnd(output, gasUsed, d, err)
+}
+
+// enter pushes the pending call if the execution goes one level deeper
+func (t *Tracer) enter(depth int, gas uint64) {
+	if t.pending == nil || depth != len(t.frames)+1 {
+		return
+	}
+	t.pending.Gas = gas
+	t.frames = append(t.frames, t.pending)
+	t.pending = nil
+}
+
+// exit closes the calls returned to the current depth, and reads their results from the stack of the caller
+func (t *Tracer) exit(depth int, stack *vm.Stack) {
+	var returned *CallFrame
+	if t.pending != nil {
+		// the call returned without running any code, e.g., a value transfer to an account without code
+		returned, t.pending = t.pending, nil
+	}
+	for depth < len(t.frames) && len(t.frames) > 1 {
+		returned = t.frames[len(t.frames)-1]
+		t.frames = t.frames[:len(t.frames)-1]
+		t.closeFrame(returned)
+	}
+	if returned == nil {
+		return
+	}
+	if stack != nil && len(stack.Data()) > 0 {
+		result := stack.Back(0)
+		switch returned.Type {
+		case vm.CREATE.String(), vm.CREATE2.String():
+			if result.Sign() != 0 {
+				returned.To = common.BigToAddress(result)
+			}
+		}
+		if result.Sign() == 0 && returned.Error == "" {
+			returned.Error = "call failed"
+		}
+	}
+	parent := t.frames[len(t.frames)-1]
+	parent.Calls = append(parent.Calls, returned)
+}
+
+func (t *Tracer) closeFrame(frame *CallFrame) {
+	remaining := uint64(0)
+	if frame.lastGas > frame.lastCost {
+		remaining = frame.lastGas - frame.lastCost
+	}
+	// the error of go-ethereum is not accessible
+	if frame.Error != "" && frame.Error != "evm: execution reverted" {
+		// all the gas is consumed unless the call is reverted
+		remaining = 0
+	}
+	if frame.Gas > remaining {
+		frame.GasUsed = frame.Gas - remaining
+	}
+}
+
+// captureOp records the call issued by the opcode, or the output of the current call
+func (t *Tracer) captureOp(frame *CallFrame, op vm.OpCode, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract) {
+	switch op {
+	case vm.CALL, vm.CALLCODE:
+		t.pending = &CallFrame{
+			Type:  op.String(),
+			From:  contract.Address(),
+			To:    common.BigToAddress(stack.Back(1)),
+			Value: new(big.Int).Set(stack.Back(2)),
+			Gas:   stack.Back(0).Uint64(),
+			Input: memoryCopy(memory, stack.Back(3), stack.Back(4)),
+		}
+	case vm.DELEGATECALL, vm.STATICCALL:
+		t.pending = &CallFrame{
+			Type:  op.String(),
+			From:  contract.Address(),
+			To:    common.BigToAddress(stack.Back(1)),
+			Value: big.NewInt(0),
+			Gas:   stack.Back(0).Uint64(),
+			Input: memoryCopy(memory, stack.Back(2), stack.Back(3)),
+		}
+	case vm.CREATE, vm.CREATE2:
+		t.pending = &CallFrame{
+			Type:  op.String(),
+			From:  contract.Address(),
+			Value: new(big.Int).Set(stack.Back(0)),
+			Input: memoryCopy(memory, stack.Back(1), stack.Back(2)),
+		}
+	case vm.RETURN, vm.REVERT:
+		frame.Output = memoryCopy(memory, stack.Back(0), stack.Back(1))
+	}
+}
+
+func (t *Tracer) captureStorage(env *vm.EVM, addr common.Address, key common.Hash) {
+	slots, ok := t.storage[addr]
+	if !ok {
+		slots = make(map[common.Hash]*StorageChange)
+		t.storage[addr] = slots
+	}
+	if _, ok := slots[key]; !ok {
+		slots[key] = &StorageChange{Original: env.StateDB.GetState(addr, key)}
+	}
+}
+
+// memoryCopy copies a range of the memory, which has been expanded to cover the range before the opcode is traced
+func memoryCopy(memory *vm.Memory, offset, size *big.Int) []byte {
+	if size.Sign() == 0 || !offset.IsInt64() || !size.IsInt64() {
+		return nil
+	}
+	if offset.Int64()+size.Int64() > int64(memory.Len()) {
+		return nil
+	}
+	return memory.Get(offset.Int64(), size.Int64())
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracer(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		contract = common.BytesToAddress([]byte("contract"))
+		callee   = common.HexToAddress("0xbb")
+		account  = common.HexToAddress("0xcc")
+		reverter = common.HexToAddress("0xdd")
+	)
+	sdb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()))
+	require.NoError(err)
+	sdb.AddBalance(contract, big.NewInt(10))
+	// store 42 in slot 1 and return it
+	sdb.SetCode(callee, common.FromHex("602a600155602a60005260206000f3"))
+	// revert without data
+	sdb.SetCode(reverter, common.FromHex("60006000fd"))
+
+	tracer := NewTracer(&vm.LogConfig{})
+	ctx := WithTracer(context.Background(), tracer)
+	tr, ok := GetTracer(ctx)
+	require.True(ok)
+	require.Equal(tracer, tr)
+
+	// store 7 in slot 0, call the callee, transfer 1 to the account, and call the reverter
+	code := common.FromHex("6007600055" +
+		"60206000600060006000" + "60bb61fffff150" +
+		"60006000600060006001" + "60cc61fffff150" +
+		"60006000600060006000" + "60dd61fffff150" +
+		"00")
+	_, _, err = runtime.Execute(code, nil, &runtime.Config{
+		ChainConfig: params.AllEthashProtocolChanges,
+		GasLimit:    1000000,
+		State:       sdb,
+		EVMConfig:   vm.Config{Debug: true, Tracer: tracer},
+	})
+	require.NoError(err)
+
+	root := tracer.CallTree()
+	require.Equal("CALL", root.Type)
+	require.Equal(contract, root.To)
+	require.Empty(root.Error)
+	require.NotZero(root.GasUsed)
+	require.Equal(3, len(root.Calls))
+
+	call := root.Calls[0]
+	require.Equal("CALL", call.Type)
+	require.Equal(contract, call.From)
+	require.Equal(callee, call.To)
+	require.Empty(call.Error)
+	require.Equal(common.LeftPadBytes([]byte{42}, 32), call.Output)
+	require.NotZero(call.GasUsed)
+	require.True(call.GasUsed < call.Gas)
+
+	transfer := root.Calls[1]
+	require.Equal(account, transfer.To)
+	require.Equal(big.NewInt(1), transfer.Value)
+	require.Empty(transfer.Error)
+	require.Empty(transfer.Calls)
+
+	reverted := root.Calls[2]
+	require.Equal(reverter, reverted.To)
+	require.Equal("evm: execution reverted", reverted.Error)
+	require.True(reverted.GasUsed < reverted.Gas)
+
+	changes := tracer.StorageChanges()
+	require.Equal(2, len(changes))
+	require.Equal(&StorageChange{Current: common.BigToHash(big.NewInt(7))}, changes[contract][common.Hash{}])
+	require.Equal(&StorageChange{Current: common.BigToHash(big.NewInt(42))}, changes[callee][common.BigToHash(big.NewInt(1))])
+
+	logs := tracer.StructLogs()
+	require.NotEmpty(logs)
+	require.Equal(vm.PUSH1, logs[0].Op)
+	require.Equal(1, logs[0].Depth)
+	depths := make(map[int]bool)
+	for _, l := range logs {
+		depths[l.Depth] = true
+	}
+	require.True(depths[2])
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/state"
 )
 
@@ -40,6 +41,9 @@ func (v *GenericValidator) Validate(ctx context.Context, selp action.SealedEnvel
 	if err := action.Verify(selp); err != nil {
 		return errors.Wrap(err, "failed to verify action signature")
 	}
+	if err := validateHawaii(ctx, selp); err != nil {
+		return err
+	}
 	caller, err := address.FromBytes(selp.SrcPubkey().Hash())
 	if err != nil {
 		return err
@@ -56,3 +60,36 @@ func (v *GenericValidator) Validate(ctx context.Context, selp action.SealedEnvel
 	}
 	return selp.Action().SanityCheck()
 }
+
+// validateHawaii validates the rules activated at Hawaii, which rejects an action signed for another chain
+// and accepts Ethereum RLP encoded actions
+func validateHawaii(ctx context.Context, selp action.SealedEnvelope) error {
+	bcCtx, ok := GetBlockchainCtx(ctx)
+	if !ok {
+		return nil
+	}
+	height := bcCtx.Tip.Height + 1
+	if blkCtx, ok := GetBlockCtx(ctx); ok {
+		height = blkCtx.BlockHeight
+	}
+	hu := config.NewHeightUpgrade(&bcCtx.Genesis)
+	if hu.IsPre(config.Hawaii, height) {
+		if selp.Encoding() != action.IotexProtobuf {
+			return errors.Wrap(action.ErrInvalidEncoding, "Ethereum RLP encoded action is not activated yet")
+		}
+		if selp.Version() >= action.ChainIDEnvelopeVersion {
+			return errors.Wrap(action.ErrChainID, "envelope with chain ID is not activated yet")
+		}
+		return nil
+	}
+	if selp.Encoding() == action.EthereumRLP && selp.EVMNetworkID() != bcCtx.Genesis.EVMNetworkID {
+		return errors.Wrapf(action.ErrChainID, "action is signed for EVM network %d, expecting %d",
+			selp.EVMNetworkID(), bcCtx.Genesis.EVMNetworkID)
+	}
+	// envelopes prior to action.ChainIDEnvelopeVersion carry no chain ID, and are still accepted for
+	// backward compatibility
+	if selp.Version() >= action.ChainIDEnvelopeVersion && selp.ChainID() != bcCtx.ChainID {
+		return errors.Wrapf(action.ErrChainID, "action is signed for chain %d, expecting %d", selp.ChainID(), bcCtx.ChainID)
+	}
+	return nil
+}
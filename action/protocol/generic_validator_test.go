@@ -8,12 +8,15 @@ package protocol
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"encoding/hex"
 	"math/big"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/golang/mock/gomock"
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/pkg/errors"
@@ -148,4 +151,67 @@ func TestActionProtoAndGenericValidator(t *testing.T) {
 		selp := action.FakeSeal(elp, identityset.PrivateKey(27).PublicKey())
 		require.True(strings.Contains(valid.Validate(ctx, selp).Error(), "failed to verify action signature"))
 	})
+	t.Run("chain ID", func(t *testing.T) {
+		v, err := action.NewExecution("", 0, big.NewInt(10), uint64(10), big.NewInt(10), data)
+		require.NoError(err)
+		g := config.Default.Genesis
+		g.HawaiiBlockHeight = 2
+		for _, c := range []struct {
+			legacy  bool
+			chainID uint32
+			height  uint64
+			err     error
+		}{
+			{true, 0, 2, nil},
+			{false, 1, 2, nil},
+			{false, 2, 2, action.ErrChainID},
+			{true, 0, 1, nil},
+			{false, 1, 1, action.ErrChainID},
+		} {
+			bd := &action.EnvelopeBuilder{}
+			bd.SetGasPrice(big.NewInt(10)).SetGasLimit(uint64(100000)).SetAction(v)
+			if !c.legacy {
+				bd.SetChainID(c.chainID)
+			}
+			selp, err := action.Sign(bd.Build(), identityset.PrivateKey(28))
+			require.NoError(err)
+			nselp := action.SealedEnvelope{}
+			require.NoError(nselp.LoadProto(selp.Proto()))
+			require.Equal(c.chainID, nselp.ChainID())
+			cctx := WithBlockchainCtx(WithBlockCtx(ctx, BlockCtx{BlockHeight: c.height}), BlockchainCtx{
+				Genesis: g,
+				ChainID: 1,
+			})
+			require.Equal(c.err, errors.Cause(valid.Validate(cctx, nselp)))
+		}
+	})
+	t.Run("Ethereum RLP encoding", func(t *testing.T) {
+		g := config.Default.Genesis
+		g.HawaiiBlockHeight = 2
+		for _, c := range []struct {
+			networkID uint32
+			height    uint64
+			err       error
+		}{
+			{g.EVMNetworkID, 1, action.ErrInvalidEncoding},
+			{g.EVMNetworkID, 2, nil},
+			{g.EVMNetworkID + 1, 2, action.ErrChainID},
+		} {
+			tx, err := types.SignTx(
+				types.NewContractCreation(3, big.NewInt(0), 100000, big.NewInt(10), nil),
+				types.NewEIP155Signer(big.NewInt(int64(c.networkID))),
+				identityset.PrivateKey(28).EcdsaPrivateKey().(*ecdsa.PrivateKey),
+			)
+			require.NoError(err)
+			raw, err := rlp.EncodeToBytes(tx)
+			require.NoError(err)
+			selp, err := action.DecodeRawTx(raw, c.networkID)
+			require.NoError(err)
+			cctx := WithBlockchainCtx(WithBlockCtx(ctx, BlockCtx{BlockHeight: c.height}), BlockchainCtx{
+				Genesis: g,
+				ChainID: 1,
+			})
+			require.Equal(c.err, errors.Cause(valid.Validate(cctx, selp)))
+		}
+	})
 }
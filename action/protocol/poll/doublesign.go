@@ -0,0 +1,316 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package poll
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	blake2b "github.com/minio/blake2b-simd"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/poll/doublesignerpb"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// ErrInvalidDoubleSignEvidence indicates that the endorsements do not prove a double sign
+var ErrInvalidDoubleSignEvidence = errors.New("invalid double sign evidence")
+
+type (
+	// DoubleSignEvidences returns the double sign evidences detected by consensus, to be put on chain
+	DoubleSignEvidences func() []*action.PutDoubleSignEvidence
+
+	// DoubleSignEvidenceReporter is a poll protocol which puts the double sign evidences on chain
+	DoubleSignEvidenceReporter interface {
+		SetDoubleSignEvidences(DoubleSignEvidences)
+	}
+
+	doubleSignEvidenceCreator interface {
+		createDoubleSignEvidenceActions(context.Context, protocol.StateReader) []action.Envelope
+	}
+
+	// DoubleSigners is the list of delegates who double signed in an epoch, they are put on probation at the end
+	// of the epoch
+	DoubleSigners struct {
+		EpochNum  uint64
+		Addresses []string
+	}
+
+	// consensusVote is the document endorsed by the delegates in consensus
+	consensusVote struct {
+		blkHash []byte
+		topic   iotextypes.ConsensusVote_Topic
+	}
+
+	doubleSignEvidenceSource struct {
+		mu        sync.RWMutex
+		evidences DoubleSignEvidences
+	}
+)
+
+func (v *consensusVote) Hash() ([]byte, error) {
+	ser, err := proto.Marshal(&iotextypes.ConsensusVote{
+		BlockHash: v.blkHash,
+		Topic:     v.topic,
+	})
+	if err != nil {
+		return nil, err
+	}
+	hash := blake2b.Sum256(ser)
+	return hash[:], nil
+}
+
+func (s *doubleSignEvidenceSource) set(evidences DoubleSignEvidences) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.evidences = evidences
+}
+
+func (s *doubleSignEvidenceSource) get() []*action.PutDoubleSignEvidence {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.evidences == nil {
+		return nil
+	}
+	return s.evidences()
+}
+
+// VerifyDoubleSignEvidence checks that both endorsements are signed by the same endorser for two different valid
+// blocks at the same height, i.e., two COMMIT endorsements at the same height, or two PROPOSAL or LOCK endorsements
+// in the same round. It returns the public key of the endorser and the height of the blocks.
+func VerifyDoubleSignEvidence(act *action.PutDoubleSignEvidence) (crypto.PublicKey, uint64, error) {
+	switch act.Topic() {
+	case iotextypes.ConsensusVote_PROPOSAL, iotextypes.ConsensusVote_LOCK, iotextypes.ConsensusVote_COMMIT:
+	default:
+		return nil, 0, errors.Wrapf(ErrInvalidDoubleSignEvidence, "invalid topic %d", act.Topic())
+	}
+	if len(act.Headers()) != 2 || len(act.Endorsements()) != 2 {
+		return nil, 0, errors.Wrap(ErrInvalidDoubleSignEvidence, "missing block header or endorsement")
+	}
+	headers := make([]*block.Header, 2)
+	for i, ser := range act.Headers() {
+		headers[i] = &block.Header{}
+		if err := headers[i].Deserialize(ser); err != nil {
+			return nil, 0, errors.Wrapf(ErrInvalidDoubleSignEvidence, "failed to deserialize block header: %v", err)
+		}
+		if !headers[i].VerifySignature() {
+			return nil, 0, errors.Wrap(ErrInvalidDoubleSignEvidence, "invalid block signature")
+		}
+		blkHash := headers[i].HashBlock()
+		vote := &consensusVote{blkHash: blkHash[:], topic: act.Topic()}
+		if !endorsement.VerifyEndorsement(vote, act.Endorsements()[i]) {
+			return nil, 0, errors.Wrap(ErrInvalidDoubleSignEvidence, "invalid endorsement")
+		}
+	}
+	endorser := act.Endorsements()[0].Endorser()
+	if !bytes.Equal(endorser.Bytes(), act.Endorsements()[1].Endorser().Bytes()) {
+		return nil, 0, errors.Wrap(ErrInvalidDoubleSignEvidence, "different endorsers")
+	}
+	if headers[0].HashBlock() == headers[1].HashBlock() {
+		return nil, 0, errors.Wrap(ErrInvalidDoubleSignEvidence, "same block")
+	}
+	if headers[0].Height() != headers[1].Height() {
+		return nil, 0, errors.Wrap(ErrInvalidDoubleSignEvidence, "different heights")
+	}
+	// blocks proposed in the same round share the round start time as timestamp
+	if act.Topic() != iotextypes.ConsensusVote_COMMIT && !headers[0].Timestamp().Equal(headers[1].Timestamp()) {
+		return nil, 0, errors.Wrap(ErrInvalidDoubleSignEvidence, "different rounds")
+	}
+	return endorser, headers[0].Height(), nil
+}
+
+// Serialize serializes DoubleSigners struct to bytes
+func (ds *DoubleSigners) Serialize() ([]byte, error) {
+	return proto.Marshal(&doublesignerpb.DoubleSigners{
+		EpochNum:  ds.EpochNum,
+		Addresses: ds.Addresses,
+	})
+}
+
+// Deserialize deserializes bytes to DoubleSigners struct
+func (ds *DoubleSigners) Deserialize(buf []byte) error {
+	pb := &doublesignerpb.DoubleSigners{}
+	if err := proto.Unmarshal(buf, pb); err != nil {
+		return errors.Wrap(err, "failed to unmarshal double signers")
+	}
+	ds.EpochNum = pb.EpochNum
+	ds.Addresses = pb.Addresses
+	return nil
+}
+
+// Contains returns true if the address is in the list
+func (ds *DoubleSigners) Contains(addr string) bool {
+	for _, a := range ds.Addresses {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// doubleSignersFromDB returns the delegates who double signed in the epoch
+func doubleSignersFromDB(sr protocol.StateReader, epochNum uint64) (*DoubleSigners, error) {
+	ds := &DoubleSigners{}
+	key := candidatesutil.ConstructKey(doubleSignersKey)
+	_, err := sr.State(ds, protocol.KeyOption(key[:]), protocol.NamespaceOption(protocol.SystemNamespace))
+	switch errors.Cause(err) {
+	case nil:
+		if ds.EpochNum == epochNum {
+			return ds, nil
+		}
+	case state.ErrStateNotExist:
+	default:
+		return nil, errors.Wrap(err, "failed to read double signers")
+	}
+	// the record of an earlier epoch has been consumed at the end of that epoch
+	return &DoubleSigners{EpochNum: epochNum}, nil
+}
+
+func setDoubleSigners(sm protocol.StateManager, ds *DoubleSigners) error {
+	key := candidatesutil.ConstructKey(doubleSignersKey)
+	_, err := sm.PutState(ds, protocol.KeyOption(key[:]), protocol.NamespaceOption(protocol.SystemNamespace))
+	return err
+}
+
+// SetDoubleSignEvidences sets the source of the double sign evidences to put on chain
+func (sh *Slasher) SetDoubleSignEvidences(evidences DoubleSignEvidences) {
+	sh.evidences.set(evidences)
+}
+
+// createDoubleSignEvidenceActions creates the actions putting the valid double sign evidences on chain, one for each
+// double signer not reported yet
+func (sh *Slasher) createDoubleSignEvidenceActions(ctx context.Context, sr protocol.StateReader) []action.Envelope {
+	var (
+		elps     []action.Envelope
+		reported = make(map[string]bool)
+	)
+	for _, act := range sh.evidences.get() {
+		signer, err := sh.checkDoubleSignEvidence(ctx, sr, act)
+		if err != nil {
+			log.L().Debug("Skip double sign evidence.", zap.Error(err))
+			continue
+		}
+		if reported[signer] {
+			continue
+		}
+		reported[signer] = true
+		builder := action.EnvelopeBuilder{}
+		elps = append(elps, builder.SetNonce(act.Nonce()).SetAction(act).Build())
+	}
+	return elps
+}
+
+func (sh *Slasher) validateDoubleSignEvidence(ctx context.Context, sr protocol.StateReader, act *action.PutDoubleSignEvidence) error {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	if blkCtx.Producer.String() != actionCtx.Caller.String() {
+		return errors.New("Only producer could create this protocol")
+	}
+	_, err := sh.checkDoubleSignEvidence(ctx, sr, act)
+	return err
+}
+
+// checkDoubleSignEvidence verifies the evidence and returns the address of the double signer, who has to be an active
+// block producer not reported in the current epoch yet. The evidence has to be of a block in the current epoch, or of
+// the last block of the previous epoch, which can only be reported in the current epoch.
+func (sh *Slasher) checkDoubleSignEvidence(ctx context.Context, sr protocol.StateReader, act *action.PutDoubleSignEvidence) (string, error) {
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	if !sh.hu.IsPost(config.Iceland, blkCtx.BlockHeight) {
+		return "", errors.Wrap(ErrInvalidDoubleSignEvidence, "double sign evidence is not enabled yet")
+	}
+	pk, height, err := VerifyDoubleSignEvidence(act)
+	if err != nil {
+		return "", err
+	}
+	rp := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx))
+	epochNum := rp.GetEpochNum(blkCtx.BlockHeight)
+	if height >= blkCtx.BlockHeight || height+1 < rp.GetEpochHeight(epochNum) {
+		return "", errors.Wrapf(ErrInvalidDoubleSignEvidence, "evidence of block %d is out of date", height)
+	}
+	signer, err := address.FromBytes(pk.Hash())
+	if err != nil {
+		return "", err
+	}
+	delegates, _, err := sh.GetActiveBlockProducers(ctx, sr, false)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read active block producers")
+	}
+	isDelegate := false
+	for _, d := range delegates {
+		if d.Address == signer.String() {
+			isDelegate = true
+			break
+		}
+	}
+	if !isDelegate {
+		return "", errors.Wrapf(ErrInvalidDoubleSignEvidence, "%s is not an active block producer", signer)
+	}
+	ds, err := doubleSignersFromDB(sr, epochNum)
+	if err != nil {
+		return "", err
+	}
+	if ds.Contains(signer.String()) {
+		return "", errors.Wrapf(ErrInvalidDoubleSignEvidence, "double sign of %s has been reported", signer)
+	}
+	return signer.String(), nil
+}
+
+// handleDoubleSignEvidence records the double signer, who will be put on probation at the end of the epoch
+func (sh *Slasher) handleDoubleSignEvidence(
+	ctx context.Context,
+	sm protocol.StateManager,
+	act *action.PutDoubleSignEvidence,
+	protocolAddr string,
+) (*action.Receipt, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	rp := rolldpos.MustGetProtocol(protocol.MustGetRegistry(ctx))
+	pk, height, err := VerifyDoubleSignEvidence(act)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := address.FromBytes(pk.Hash())
+	if err != nil {
+		return nil, err
+	}
+	log.L().Info("Handle PutDoubleSignEvidence Action",
+		zap.String("signer", signer.String()),
+		zap.Uint64("height", height),
+		zap.String("topic", act.Topic().String()),
+	)
+	ds, err := doubleSignersFromDB(sm, rp.GetEpochNum(blkCtx.BlockHeight))
+	if err != nil {
+		return nil, err
+	}
+	if !ds.Contains(signer.String()) {
+		ds.Addresses = append(ds.Addresses, signer.String())
+		if err := setDoubleSigners(sm, ds); err != nil {
+			return nil, errors.Wrap(err, "failed to set double signers")
+		}
+	}
+	return &action.Receipt{
+		Status:          uint64(iotextypes.ReceiptStatus_Success),
+		ActionHash:      actionCtx.ActionHash,
+		BlockHeight:     blkCtx.BlockHeight,
+		GasConsumed:     actionCtx.IntrinsicGas,
+		ContractAddress: protocolAddr,
+	}, nil
+}
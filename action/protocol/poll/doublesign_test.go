@@ -0,0 +1,224 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package poll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func testDoubleSignEvidence(
+	t *testing.T,
+	topic iotextypes.ConsensusVote_Topic,
+	height uint64,
+	signer crypto.PrivateKey,
+	sameBlock bool,
+) *action.PutDoubleSignEvidence {
+	require := require.New(t)
+	ts := time.Unix(1600000000, 0)
+	var (
+		headers      [][]byte
+		endorsements []*endorsement.Endorsement
+	)
+	for _, prevHash := range []string{"a", "b"} {
+		if sameBlock {
+			prevHash = "a"
+		}
+		blk, err := block.NewBuilder(block.NewRunnableActionsBuilder().Build()).
+			SetHeight(height).
+			SetTimestamp(ts).
+			SetPrevBlockHash(hash.Hash256b([]byte(prevHash))).
+			SignAndBuild(identityset.PrivateKey(1))
+		require.NoError(err)
+		ser, err := blk.Header.Serialize()
+		require.NoError(err)
+		headers = append(headers, ser)
+		blkHash := blk.HashBlock()
+		en, err := endorsement.Endorse(signer, &consensusVote{blkHash: blkHash[:], topic: topic}, ts)
+		require.NoError(err)
+		endorsements = append(endorsements, en)
+	}
+	return action.NewPutDoubleSignEvidence(0, topic, headers, endorsements)
+}
+
+func TestVerifyDoubleSignEvidence(t *testing.T) {
+	require := require.New(t)
+	signer := identityset.PrivateKey(2)
+	act := testDoubleSignEvidence(t, iotextypes.ConsensusVote_COMMIT, 20, signer, false)
+	pk, height, err := VerifyDoubleSignEvidence(act)
+	require.NoError(err)
+	require.Equal(signer.PublicKey().Bytes(), pk.Bytes())
+	require.Equal(uint64(20), height)
+
+	// endorsing the same block twice
+	act = testDoubleSignEvidence(t, iotextypes.ConsensusVote_COMMIT, 20, signer, true)
+	_, _, err = VerifyDoubleSignEvidence(act)
+	require.Equal(ErrInvalidDoubleSignEvidence, errors.Cause(err))
+
+	// endorsements of different endorsers
+	act = testDoubleSignEvidence(t, iotextypes.ConsensusVote_LOCK, 20, signer, false)
+	other := testDoubleSignEvidence(t, iotextypes.ConsensusVote_LOCK, 20, identityset.PrivateKey(3), false)
+	act = action.NewPutDoubleSignEvidence(
+		0,
+		act.Topic(),
+		act.Headers(),
+		[]*endorsement.Endorsement{act.Endorsements()[0], other.Endorsements()[1]},
+	)
+	_, _, err = VerifyDoubleSignEvidence(act)
+	require.Equal(ErrInvalidDoubleSignEvidence, errors.Cause(err))
+
+	// endorsements on another topic
+	act = testDoubleSignEvidence(t, iotextypes.ConsensusVote_LOCK, 20, signer, false)
+	act = action.NewPutDoubleSignEvidence(0, iotextypes.ConsensusVote_COMMIT, act.Headers(), act.Endorsements())
+	_, _, err = VerifyDoubleSignEvidence(act)
+	require.Equal(ErrInvalidDoubleSignEvidence, errors.Cause(err))
+
+	// missing endorsement
+	act = action.NewPutDoubleSignEvidence(0, act.Topic(), act.Headers(), act.Endorsements()[:1])
+	_, _, err = VerifyDoubleSignEvidence(act)
+	require.Equal(ErrInvalidDoubleSignEvidence, errors.Cause(err))
+}
+
+func TestSlasherDoubleSignEvidence(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, ctx, sm, _, err := initConstruct(ctrl)
+	require.NoError(err)
+	_, err = shiftCandidates(sm)
+	require.NoError(err)
+	_, err = shiftProbationList(sm)
+	require.NoError(err)
+	g := config.Default.Genesis
+	g.EasterBlockHeight = 1
+	g.IcelandBlockHeight = 1
+	var delegates []string
+	newSlasher := func(g *genesis.Genesis) *Slasher {
+		sh, err := NewSlasher(
+			g,
+			func(start, end uint64) (map[string]uint64, error) {
+				produce := make(map[string]uint64)
+				for _, d := range delegates {
+					produce[d] = 15
+				}
+				return produce, nil
+			},
+			candidatesutil.CandidatesFromDB,
+			candidatesutil.ProbationListFromDB,
+			candidatesutil.UnproductiveDelegateFromDB,
+			nil,
+			2,
+			2,
+			g.DardanellesNumSubEpochs,
+			g.ProductivityThreshold,
+			g.ProbationEpochPeriod,
+			g.UnproductiveDelegateMaxCacheSize,
+			g.ProbationIntensityRate,
+		)
+		require.NoError(err)
+		return sh
+	}
+	sh := newSlasher(&g)
+	abps, _, err := sh.GetActiveBlockProducers(ctx, sm, false)
+	require.NoError(err)
+	require.Len(abps, 2)
+	var signer crypto.PrivateKey
+	for i := 1; i <= 6; i++ {
+		if identityset.Address(i).String() == abps[0].Address {
+			signer = identityset.PrivateKey(i)
+		}
+	}
+	require.NotNil(signer)
+	for _, abp := range abps {
+		delegates = append(delegates, abp.Address)
+	}
+	producer, err := address.FromString(abps[1].Address)
+	require.NoError(err)
+	withHeight := func(ctx context.Context, height uint64, caller address.Address) context.Context {
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: height, Producer: producer})
+		return protocol.WithActionCtx(ctx, protocol.ActionCtx{Caller: caller})
+	}
+	act := testDoubleSignEvidence(t, iotextypes.ConsensusVote_COMMIT, 20, signer, false)
+
+	// only the producer puts the evidence on chain
+	require.NoError(sh.validateDoubleSignEvidence(withHeight(ctx, 25, producer), sm, act))
+	require.Error(sh.validateDoubleSignEvidence(withHeight(ctx, 25, identityset.Address(10)), sm, act))
+	// the evidence is not taken before Iceland
+	preIceland := config.Default.Genesis
+	preIceland.EasterBlockHeight = 1
+	require.Equal(ErrInvalidDoubleSignEvidence, errors.Cause(
+		newSlasher(&preIceland).validateDoubleSignEvidence(withHeight(ctx, 25, producer), sm, act),
+	))
+	// the evidence has to be of a lower height in the current epoch, or of the last block of the previous epoch
+	for _, height := range []uint64{20, 65} {
+		require.Equal(ErrInvalidDoubleSignEvidence, errors.Cause(
+			sh.validateDoubleSignEvidence(withHeight(ctx, height, producer), sm, act),
+		))
+	}
+	last := testDoubleSignEvidence(t, iotextypes.ConsensusVote_COMMIT, 30, signer, false)
+	require.NoError(sh.validateDoubleSignEvidence(withHeight(ctx, 31, producer), sm, last))
+	// the double signer has to be an active block producer
+	var outsider crypto.PrivateKey
+	for i := 1; i <= 6; i++ {
+		if addr := identityset.Address(i).String(); addr != abps[0].Address && addr != abps[1].Address {
+			outsider = identityset.PrivateKey(i)
+			break
+		}
+	}
+	require.Equal(ErrInvalidDoubleSignEvidence, errors.Cause(sh.validateDoubleSignEvidence(
+		withHeight(ctx, 25, producer),
+		sm,
+		testDoubleSignEvidence(t, iotextypes.ConsensusVote_COMMIT, 20, outsider, false),
+	)))
+
+	// the producer creates one action for each double signer not reported yet
+	sh.SetDoubleSignEvidences(func() []*action.PutDoubleSignEvidence {
+		return []*action.PutDoubleSignEvidence{act, act}
+	})
+	elps := sh.createDoubleSignEvidenceActions(withHeight(ctx, 25, producer), sm)
+	require.Len(elps, 1)
+	require.Equal(act, elps[0].Action())
+
+	// the double signer is recorded, and cannot be reported again in the epoch
+	receipt, err := sh.handleDoubleSignEvidence(withHeight(ctx, 25, producer), sm, act, "poll")
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+	ds, err := doubleSignersFromDB(sm, 1)
+	require.NoError(err)
+	require.Equal([]string{abps[0].Address}, ds.Addresses)
+	ds, err = doubleSignersFromDB(sm, 2)
+	require.NoError(err)
+	require.Empty(ds.Addresses)
+	require.Equal(ErrInvalidDoubleSignEvidence, errors.Cause(
+		sh.validateDoubleSignEvidence(withHeight(ctx, 26, producer), sm, act),
+	))
+	require.Empty(sh.createDoubleSignEvidenceActions(withHeight(ctx, 26, producer), sm))
+
+	// the double signer is put on probation at the end of the epoch
+	unqualified, err := sh.calculateUnproductiveDelegates(withHeight(ctx, 30, producer), sm)
+	require.NoError(err)
+	require.Equal([]string{abps[0].Address}, unqualified)
+}
@@ -65,17 +65,36 @@ func (ns *nativeStakingV2) CreatePreStates(ctx context.Context, sm protocol.Stat
 }
 
 func (ns *nativeStakingV2) CreatePostSystemActions(ctx context.Context, sr protocol.StateReader) ([]action.Envelope, error) {
-	return createPostSystemActions(ctx, sr, ns)
+	elps, err := createPostSystemActions(ctx, sr, ns)
+	if err != nil {
+		return nil, err
+	}
+	return append(elps, ns.createDoubleSignEvidenceActions(ctx, sr)...), nil
 }
 
 func (ns *nativeStakingV2) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+	if dse, ok := act.(*action.PutDoubleSignEvidence); ok {
+		return ns.slasher.handleDoubleSignEvidence(ctx, sm, dse, ns.addr.String())
+	}
 	return handle(ctx, act, sm, ns.candIndexer, ns.addr.String())
 }
 
 func (ns *nativeStakingV2) Validate(ctx context.Context, act action.Action, sr protocol.StateReader) error {
+	if dse, ok := act.(*action.PutDoubleSignEvidence); ok {
+		return ns.slasher.validateDoubleSignEvidence(ctx, sr, dse)
+	}
 	return validate(ctx, sr, ns, act)
 }
 
+// SetDoubleSignEvidences sets the source of the double sign evidences to put on chain
+func (ns *nativeStakingV2) SetDoubleSignEvidences(evidences DoubleSignEvidences) {
+	ns.slasher.SetDoubleSignEvidences(evidences)
+}
+
+func (ns *nativeStakingV2) createDoubleSignEvidenceActions(ctx context.Context, sr protocol.StateReader) []action.Envelope {
+	return ns.slasher.createDoubleSignEvidenceActions(ctx, sr)
+}
+
 func (ns *nativeStakingV2) CalculateCandidatesByHeight(ctx context.Context, sr protocol.StateReader, height uint64) (state.CandidateList, error) {
 	// transition to V2 starting Fairbank
 	cands, err := ns.stakingV2.ActiveCandidates(ctx, sr, height)
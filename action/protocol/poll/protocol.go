@@ -34,7 +34,8 @@ const (
 	_modeNativeMix     = "nativeMix"     // native with backward compatibility for governanceMix before fairbank
 	_modeConsortium    = "consortium"
 
-	blockMetaPrefix = "BlockMeta."
+	blockMetaPrefix  = "BlockMeta."
+	doubleSignersKey = "DoubleSigners."
 )
 
 // ErrInconsistentHeight is an error that result of "readFromStateDB" is not consistent with others
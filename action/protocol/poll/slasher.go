@@ -40,6 +40,7 @@ type Slasher struct {
 	probationEpochPeriod  uint64
 	maxProbationPeriod    uint64
 	probationIntensity    uint32
+	evidences             doubleSignEvidenceSource
 }
 
 // NewSlasher returns a new Slasher
@@ -536,6 +537,22 @@ func (sh *Slasher) calculateUnproductiveDelegates(ctx context.Context, sr protoc
 			unqualified = append(unqualified, addr)
 		}
 	}
+	// the delegates who double signed in the epoch are put on probation regardless of their productivity
+	ds, err := doubleSignersFromDB(sr, epochNum)
+	if err != nil {
+		return nil, err
+	}
+	unqualifiedSet := make(map[string]bool, len(unqualified))
+	for _, addr := range unqualified {
+		unqualifiedSet[addr] = true
+	}
+	for _, addr := range ds.Addresses {
+		if unqualifiedSet[addr] {
+			continue
+		}
+		unqualifiedSet[addr] = true
+		unqualified = append(unqualified, addr)
+	}
 	return unqualified, nil
 }
 
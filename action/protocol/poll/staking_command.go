@@ -83,7 +83,16 @@ func (sc *stakingCommand) CreatePreStates(ctx context.Context, sm protocol.State
 
 func (sc *stakingCommand) CreatePostSystemActions(ctx context.Context, sr protocol.StateReader) ([]action.Envelope, error) {
 	// no height here,  v1 v2 has the same createPostSystemActions method, so directly use common one
-	return createPostSystemActions(ctx, sr, sc)
+	elps, err := createPostSystemActions(ctx, sr, sc)
+	if err != nil {
+		return nil, err
+	}
+	if sc.useV2(ctx, sr) {
+		if creator, ok := sc.stakingV2.(doubleSignEvidenceCreator); ok {
+			elps = append(elps, creator.createDoubleSignEvidenceActions(ctx, sr)...)
+		}
+	}
+	return elps, nil
 }
 
 func (sc *stakingCommand) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
@@ -94,10 +103,21 @@ func (sc *stakingCommand) Handle(ctx context.Context, act action.Action, sm prot
 }
 
 func (sc *stakingCommand) Validate(ctx context.Context, act action.Action, sr protocol.StateReader) error {
+	// double sign evidences are taken by the slasher of v2 only
+	if _, ok := act.(*action.PutDoubleSignEvidence); ok && sc.useV2(ctx, sr) {
+		return sc.stakingV2.Validate(ctx, act, sr)
+	}
 	// no height here,  v1 v2 has the same validate method, so directly use common one
 	return validate(ctx, sr, sc, act)
 }
 
+// SetDoubleSignEvidences sets the source of the double sign evidences to put on chain
+func (sc *stakingCommand) SetDoubleSignEvidences(evidences DoubleSignEvidences) {
+	if reporter, ok := sc.stakingV2.(DoubleSignEvidenceReporter); ok {
+		reporter.SetDoubleSignEvidences(evidences)
+	}
+}
+
 func (sc *stakingCommand) CalculateCandidatesByHeight(ctx context.Context, sr protocol.StateReader, height uint64) (state.CandidateList, error) {
 	if sc.useV2ByHeight(ctx, height) {
 		return sc.stakingV2.CalculateCandidatesByHeight(ctx, sr, height)
@@ -73,6 +73,10 @@ func handle(ctx context.Context, act action.Action, sm protocol.StateManager, in
 }
 
 func validate(ctx context.Context, sr protocol.StateReader, p Protocol, act action.Action) error {
+	if _, ok := act.(*action.PutDoubleSignEvidence); ok {
+		// only the protocols with a slasher take double sign evidences
+		return errors.Wrap(ErrInvalidDoubleSignEvidence, "double sign evidence is not supported")
+	}
 	ppr, ok := act.(*action.PutPollResult)
 	if !ok {
 		return nil
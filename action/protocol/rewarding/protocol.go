@@ -9,7 +9,9 @@ package rewarding
 import (
 	"context"
 	"math/big"
+	"strconv"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
@@ -49,24 +51,40 @@ type Protocol struct {
 	addr                        address.Address
 	foundationBonusP2StartEpoch uint64
 	foundationBonusP2EndEpoch   uint64
+	historyIndexer              *RewardHistoryIndexer
+}
+
+// Option is optional setting for rewarding protocol
+type Option func(*Protocol)
+
+// WithRewardHistoryIndexer sets the indexer serving the reward history of accounts
+func WithRewardHistoryIndexer(indexer *RewardHistoryIndexer) Option {
+	return func(p *Protocol) {
+		p.historyIndexer = indexer
+	}
 }
 
 // NewProtocol instantiates a rewarding protocol instance.
 func NewProtocol(
 	foundationBonusP2Start uint64,
 	foundationBonusP2End uint64,
+	opts ...Option,
 ) *Protocol {
 	h := hash.Hash160b([]byte(protocolID))
 	addr, err := address.FromBytes(h[:])
 	if err != nil {
 		log.L().Panic("Error when constructing the address of rewarding protocol", zap.Error(err))
 	}
-	return &Protocol{
+	p := &Protocol{
 		keyPrefix:                   h[:],
 		addr:                        addr,
 		foundationBonusP2StartEpoch: foundationBonusP2Start,
 		foundationBonusP2EndEpoch:   foundationBonusP2End,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // FindProtocol finds the registered protocol from registry
@@ -232,11 +250,84 @@ func (p *Protocol) ReadState(
 			return nil, uint64(0), err
 		}
 		return []byte(balance.String()), height, nil
+	case "RewardDistributions":
+		if len(args) != 1 {
+			return nil, uint64(0), errors.Errorf("invalid number of arguments %d", len(args))
+		}
+		if p.historyIndexer == nil {
+			return nil, uint64(0), errors.New("reward history indexer is not enabled")
+		}
+		epochNum, err := strconv.ParseUint(string(args[0]), 10, 64)
+		if err != nil {
+			return nil, uint64(0), err
+		}
+		distributions, height, err := p.historyIndexer.GetRewardDistributions(epochNum)
+		if err != nil {
+			return nil, uint64(0), err
+		}
+		data, err := proto.Marshal(distributions)
+		if err != nil {
+			return nil, uint64(0), err
+		}
+		return data, height, nil
+	case "RewardHistory":
+		// args are the address, the start epoch and the number of epochs
+		addr, start, count, err := p.parseHistoryArgs(64, args...)
+		if err != nil {
+			return nil, uint64(0), err
+		}
+		rewards, height, err := p.historyIndexer.GetRewards(addr, start, count)
+		if err != nil {
+			return nil, uint64(0), err
+		}
+		data, err := proto.Marshal(rewards)
+		if err != nil {
+			return nil, uint64(0), err
+		}
+		return data, height, nil
+	case "ClaimHistory":
+		// args are the address, the offset and the limit
+		addr, offset, limit, err := p.parseHistoryArgs(32, args...)
+		if err != nil {
+			return nil, uint64(0), err
+		}
+		claims, height, err := p.historyIndexer.GetClaims(addr, uint32(offset), uint32(limit))
+		if err != nil {
+			return nil, uint64(0), err
+		}
+		data, err := proto.Marshal(claims)
+		if err != nil {
+			return nil, uint64(0), err
+		}
+		return data, height, nil
 	default:
 		return nil, uint64(0), errors.New("corresponding method isn't found")
 	}
 }
 
+// parseHistoryArgs parses the address and the 2 numbers of the range to read from the reward history indexer
+func (p *Protocol) parseHistoryArgs(bitSize int, args ...[]byte) (address.Address, uint64, uint64, error) {
+	if p.historyIndexer == nil {
+		return nil, 0, 0, errors.New("reward history indexer is not enabled")
+	}
+	if len(args) != 3 {
+		return nil, 0, 0, errors.Errorf("invalid number of arguments %d", len(args))
+	}
+	addr, err := address.FromString(string(args[0]))
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	start, err := strconv.ParseUint(string(args[1]), 10, bitSize)
+	if err != nil {
+		return nil, 0, 0, errors.Wrap(err, "invalid start of range")
+	}
+	count, err := strconv.ParseUint(string(args[2]), 10, bitSize)
+	if err != nil {
+		return nil, 0, 0, errors.Wrap(err, "invalid size of range")
+	}
+	return addr, start, count, nil
+}
+
 // Register registers the protocol with a unique ID
 func (p *Protocol) Register(r *protocol.Registry) error {
 	return r.Register(protocolID, p)
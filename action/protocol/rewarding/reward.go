@@ -13,6 +13,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 
+	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol/poll"
 	"github.com/iotexproject/iotex-core/action/protocol/rewarding/rewardingpb"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/pkg/enc"
 	"github.com/iotexproject/iotex-core/pkg/log"
@@ -185,10 +187,15 @@ func (p *Protocol) GrantEpochReward(
 	if err != nil {
 		return nil, err
 	}
-	addrs, amounts, err := p.splitEpochReward(epochStartHeight, sm, candidates, a.epochReward, a.numDelegatesForEpochReward, exemptAddrs, uqdMap)
+	rewarded, addrs, amounts, err := p.splitEpochReward(epochStartHeight, sm, candidates, a.epochReward, a.numDelegatesForEpochReward, exemptAddrs, uqdMap)
 	if err != nil {
 		return nil, err
 	}
+	// After Iceland, delegates share the epoch reward with their voters by the reward distribution ratio
+	var sp *staking.Protocol
+	if hu.IsPost(config.Iceland, blkCtx.BlockHeight) {
+		sp = staking.FindProtocol(protocol.MustGetRegistry(ctx))
+	}
 	actualTotalReward := big.NewInt(0)
 	rewardLogs := make([]*action.Log, 0)
 	for i := range addrs {
@@ -200,25 +207,47 @@ func (p *Protocol) GrantEpochReward(
 		if amounts[i].Cmp(big.NewInt(0)) == 0 {
 			continue
 		}
-		if err := p.grantToAccount(ctx, sm, addrs[i], amounts[i]); err != nil {
+		amount := amounts[i]
+		var voterRewards []*staking.VoterReward
+		if sp != nil {
+			_, amount, voterRewards, err = sp.DistributeReward(sm, string(rewarded[i].CanName), amounts[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := p.grantToAccount(ctx, sm, addrs[i], amount); err != nil {
 			return nil, err
 		}
-		rewardLog := rewardingpb.RewardLog{
+		epochLog := &rewardingpb.RewardLog{
 			Type:   rewardingpb.RewardLog_EPOCH_REWARD,
 			Addr:   addrs[i].String(),
-			Amount: amounts[i].String(),
+			Amount: amount.String(),
+		}
+		if len(voterRewards) > 0 {
+			// the candidate name links the voter rewards to the delegate sharing the epoch reward
+			epochLog.Candidate = string(rewarded[i].CanName)
 		}
-		data, err := proto.Marshal(&rewardLog)
+		rewardLog, err := p.rewardLog(epochLog, blkCtx.BlockHeight, actionCtx.ActionHash)
 		if err != nil {
 			return nil, err
 		}
-		rewardLogs = append(rewardLogs, &action.Log{
-			Address:     p.addr.String(),
-			Topics:      nil,
-			Data:        data,
-			BlockHeight: blkCtx.BlockHeight,
-			ActionHash:  actionCtx.ActionHash,
-		})
+		rewardLogs = append(rewardLogs, rewardLog)
+		for _, vr := range voterRewards {
+			if err := p.grantToAccount(ctx, sm, vr.Voter, vr.Amount); err != nil {
+				return nil, err
+			}
+			rewardLog, err := p.rewardLog(&rewardingpb.RewardLog{
+				Type:        rewardingpb.RewardLog_VOTER_REWARD,
+				Addr:        vr.Voter.String(),
+				Amount:      vr.Amount.String(),
+				Candidate:   string(rewarded[i].CanName),
+				BucketIndex: vr.BucketIndex,
+			}, blkCtx.BlockHeight, actionCtx.ActionHash)
+			if err != nil {
+				return nil, err
+			}
+			rewardLogs = append(rewardLogs, rewardLog)
+		}
 		actualTotalReward = big.NewInt(0).Add(actualTotalReward, amounts[i])
 	}
 
@@ -406,6 +435,24 @@ func (p *Protocol) updateRewardHistory(ctx context.Context, sm protocol.StateMan
 	return p.putState(ctx, sm, append(prefix, indexBytes[:]...), &rewardHistory{})
 }
 
+func (p *Protocol) rewardLog(
+	rewardLog *rewardingpb.RewardLog,
+	blkHeight uint64,
+	actHash hash.Hash256,
+) (*action.Log, error) {
+	data, err := proto.Marshal(rewardLog)
+	if err != nil {
+		return nil, err
+	}
+	return &action.Log{
+		Address:     p.addr.String(),
+		Topics:      nil,
+		Data:        data,
+		BlockHeight: blkHeight,
+		ActionHash:  actHash,
+	}, nil
+}
+
 func (p *Protocol) splitEpochReward(
 	epochStartHeight uint64,
 	sm protocol.StateManager,
@@ -414,7 +461,7 @@ func (p *Protocol) splitEpochReward(
 	numDelegatesForEpochReward uint64,
 	exemptAddrs map[string]interface{},
 	uqd map[string]bool,
-) ([]address.Address, []*big.Int, error) {
+) ([]*state.Candidate, []address.Address, []*big.Int, error) {
 	filteredCandidates := make([]*state.Candidate, 0)
 	for _, candidate := range candidates {
 		if _, ok := exemptAddrs[candidate.Address]; ok {
@@ -424,7 +471,7 @@ func (p *Protocol) splitEpochReward(
 	}
 	candidates = filteredCandidates
 	if len(candidates) == 0 {
-		return nil, nil, nil
+		return nil, nil, nil, nil
 	}
 	// We at most allow numDelegatesForEpochReward delegates to get the epoch reward
 	if uint64(len(candidates)) > numDelegatesForEpochReward {
@@ -438,7 +485,7 @@ func (p *Protocol) splitEpochReward(
 		if candidate.RewardAddress != "" {
 			rewardAddr, err = address.FromString(candidate.RewardAddress)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 		} else {
 			log.S().Warnf("Candidate %s doesn't have a reward address", candidate.Address)
@@ -461,7 +508,7 @@ func (p *Protocol) splitEpochReward(
 		amountPerAddr = big.NewInt(0).Div(big.NewInt(0).Mul(totalAmount, candidate.Votes), totalWeight)
 		amounts = append(amounts, amountPerAddr)
 	}
-	return rewardAddrs, amounts, nil
+	return candidates, rewardAddrs, amounts, nil
 }
 
 func (p *Protocol) assertNoRewardYet(ctx context.Context, sm protocol.StateManager, prefix []byte, index uint64) error {
@@ -0,0 +1,583 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rewarding
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding/rewardingpb"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// RewardHistoryNamespace is a namespace to store the rewards granted to and claimed by accounts
+const RewardHistoryNamespace = "rewardHistory"
+
+// prefixes of the keys in the reward history namespace
+const (
+	accountRewardPrefix      byte = 'r'
+	rewardClaimsPrefix       byte = 'c'
+	historyRecordPrefix      byte = 'h'
+	rewardDistributionPrefix byte = 'd'
+)
+
+var (
+	rewardHistoryHeightKey = []byte("latestHeight")
+)
+
+type (
+	// RewardHistoryIndexer is an indexer to store the rewards granted to an account per epoch, the claims of the
+	// account from the rewarding fund, and the epoch rewards delegates distribute to their voters
+	RewardHistoryIndexer struct {
+		mutex   sync.RWMutex
+		height  uint64
+		kvStore db.KVStoreWithRange
+		batch   batch.KVStoreBatch
+		dirty   map[string]db.CountingIndex
+		rp      *rolldpos.Protocol
+		addr    string
+	}
+
+	// accountReward is the rewards granted to an account in an epoch, broken down by reward type
+	accountReward map[rewardingpb.RewardLog_RewardType]*big.Int
+)
+
+// NewRewardHistoryIndexer creates a new RewardHistoryIndexer
+func NewRewardHistoryIndexer(kv db.KVStore, rp *rolldpos.Protocol) (*RewardHistoryIndexer, error) {
+	if kv == nil {
+		return nil, errors.New("empty kv store")
+	}
+	if rp == nil {
+		return nil, errors.New("empty rolldpos protocol")
+	}
+	kvRange, ok := kv.(db.KVStoreWithRange)
+	if !ok {
+		return nil, errors.New("indexer can only be created from KVStoreWithRange")
+	}
+	h := hash.Hash160b([]byte(protocolID))
+	addr, err := address.FromBytes(h[:])
+	if err != nil {
+		return nil, err
+	}
+	return &RewardHistoryIndexer{
+		kvStore: kvRange,
+		batch:   batch.NewBatch(),
+		dirty:   make(map[string]db.CountingIndex),
+		rp:      rp,
+		addr:    addr.String(),
+	}, nil
+}
+
+// Start starts the indexer
+func (rhi *RewardHistoryIndexer) Start(ctx context.Context) error {
+	if err := rhi.kvStore.Start(ctx); err != nil {
+		return err
+	}
+	ret, err := rhi.kvStore.Get(RewardHistoryNamespace, rewardHistoryHeightKey)
+	switch errors.Cause(err) {
+	case nil:
+		rhi.height = byteutil.BytesToUint64BigEndian(ret)
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		rhi.height = 0
+	default:
+		return err
+	}
+	return nil
+}
+
+// Stop stops the indexer
+func (rhi *RewardHistoryIndexer) Stop(ctx context.Context) error {
+	return rhi.kvStore.Stop(ctx)
+}
+
+// ReadsReceipts returns true, since the rewards are indexed from the receipts of the block
+func (rhi *RewardHistoryIndexer) ReadsReceipts() bool {
+	return true
+}
+
+// Height returns the height of the last indexed block
+func (rhi *RewardHistoryIndexer) Height() (uint64, error) {
+	rhi.mutex.RLock()
+	defer rhi.mutex.RUnlock()
+	return rhi.height, nil
+}
+
+// PutBlock indexes the rewards granted and the claims from the rewarding fund in the block
+func (rhi *RewardHistoryIndexer) PutBlock(_ context.Context, blk *block.Block) error {
+	rhi.mutex.Lock()
+	defer rhi.mutex.Unlock()
+
+	// the block to be indexed must be exactly current top + 1, otherwise counting index would not work correctly
+	height := blk.Height()
+	if height != rhi.height+1 {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, rhi.height+1)
+	}
+	record, claims, err := rhi.historyFromBlock(blk)
+	if err != nil {
+		return err
+	}
+	if len(record.Rewards) > 0 || len(record.Claimants) > 0 {
+		if err := rhi.updateAccountRewards(height, record.Rewards, false); err != nil {
+			return err
+		}
+		for i, claimant := range record.Claimants {
+			addr, err := address.FromString(claimant)
+			if err != nil {
+				return err
+			}
+			value, err := proto.Marshal(claims[i])
+			if err != nil {
+				return err
+			}
+			index, err := rhi.getIndexForKey(rewardClaimsKey(addr))
+			if err != nil {
+				return err
+			}
+			if err := index.Add(value, true); err != nil {
+				return err
+			}
+		}
+		value, err := proto.Marshal(record)
+		if err != nil {
+			return err
+		}
+		rhi.batch.Put(RewardHistoryNamespace, historyRecordKey(height), value, "failed to put reward history at height %d", height)
+		distributions, err := rewardDistributionsFromLogs(record.Rewards)
+		if err != nil {
+			return err
+		}
+		if len(distributions.Distributions) > 0 {
+			epoch := rhi.rp.GetEpochNum(height)
+			value, err := proto.Marshal(distributions)
+			if err != nil {
+				return err
+			}
+			rhi.batch.Put(RewardHistoryNamespace, rewardDistributionKey(epoch), value, "failed to put reward distributions in epoch %d", epoch)
+		}
+	}
+	rhi.batch.Put(RewardHistoryNamespace, rewardHistoryHeightKey, byteutil.Uint64ToBytesBigEndian(height), "failed to put indexer height")
+	if err := rhi.commit(); err != nil {
+		return err
+	}
+	rhi.height = height
+	return nil
+}
+
+// DeleteTipBlock deletes the rewards and claims of the tip block
+func (rhi *RewardHistoryIndexer) DeleteTipBlock(blk *block.Block) error {
+	rhi.mutex.Lock()
+	defer rhi.mutex.Unlock()
+
+	height := blk.Height()
+	if height != rhi.height {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, rhi.height)
+	}
+	value, err := rhi.kvStore.Get(RewardHistoryNamespace, historyRecordKey(height))
+	switch errors.Cause(err) {
+	case nil:
+		record := &rewardingpb.RewardHistoryRecord{}
+		if err := proto.Unmarshal(value, record); err != nil {
+			return err
+		}
+		if err := rhi.updateAccountRewards(height, record.Rewards, true); err != nil {
+			return err
+		}
+		count := make(map[string]uint64)
+		for _, claimant := range record.Claimants {
+			count[claimant]++
+		}
+		for claimant, n := range count {
+			addr, err := address.FromString(claimant)
+			if err != nil {
+				return err
+			}
+			index, err := db.GetCountingIndex(rhi.kvStore, rewardClaimsKey(addr))
+			if err != nil {
+				return err
+			}
+			if err := index.Revert(n); err != nil {
+				return err
+			}
+		}
+		rhi.batch.Delete(RewardHistoryNamespace, historyRecordKey(height), "failed to delete reward history at height %d", height)
+		distributions, err := rewardDistributionsFromLogs(record.Rewards)
+		if err != nil {
+			return err
+		}
+		if len(distributions.Distributions) > 0 {
+			epoch := rhi.rp.GetEpochNum(height)
+			rhi.batch.Delete(RewardHistoryNamespace, rewardDistributionKey(epoch), "failed to delete reward distributions in epoch %d", epoch)
+		}
+	case db.ErrNotExist, db.ErrBucketNotExist:
+	default:
+		return err
+	}
+	rhi.batch.Put(RewardHistoryNamespace, rewardHistoryHeightKey, byteutil.Uint64ToBytesBigEndian(height-1), "failed to put indexer height")
+	if err := rhi.kvStore.WriteBatch(rhi.batch); err != nil {
+		return err
+	}
+	rhi.height = height - 1
+	return nil
+}
+
+// GetRewards returns the rewards granted to an account in the epochs [startEpoch, startEpoch+count), epochs without
+// reward omitted, and the height of the indexer
+func (rhi *RewardHistoryIndexer) GetRewards(addr address.Address, startEpoch, count uint64) (*rewardingpb.AccountRewards, uint64, error) {
+	rhi.mutex.RLock()
+	defer rhi.mutex.RUnlock()
+
+	rewards := &rewardingpb.AccountRewards{}
+	if rhi.height == 0 || startEpoch == 0 {
+		return rewards, rhi.height, nil
+	}
+	// no reward is indexed beyond the epoch of the indexer height
+	lastEpoch := rhi.rp.GetEpochNum(rhi.height)
+	for epoch := startEpoch; epoch <= lastEpoch && epoch-startEpoch < count; epoch++ {
+		ar, err := rhi.accountReward(addr, epoch)
+		if err != nil {
+			return nil, rhi.height, err
+		}
+		if len(ar) == 0 {
+			continue
+		}
+		rewards.Rewards = append(rewards.Rewards, ar.toProto(epoch))
+	}
+	return rewards, rhi.height, nil
+}
+
+// GetClaims returns the claims of an account from the rewarding fund[offset, offset+limit), and the height of the
+// indexer
+func (rhi *RewardHistoryIndexer) GetClaims(addr address.Address, offset, limit uint32) (*rewardingpb.RewardClaims, uint64, error) {
+	rhi.mutex.RLock()
+	defer rhi.mutex.RUnlock()
+
+	claims := &rewardingpb.RewardClaims{}
+	index, err := db.GetCountingIndex(rhi.kvStore, rewardClaimsKey(addr))
+	switch errors.Cause(err) {
+	case nil:
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		return claims, rhi.height, nil
+	default:
+		return nil, rhi.height, err
+	}
+	total := index.Size()
+	if uint64(offset) >= total || limit == 0 {
+		return claims, rhi.height, nil
+	}
+	count := uint64(limit)
+	if uint64(offset)+count > total {
+		count = total - uint64(offset)
+	}
+	values, err := index.Range(uint64(offset), count)
+	if err != nil {
+		return nil, rhi.height, err
+	}
+	for _, value := range values {
+		pb := &rewardingpb.RewardClaim{}
+		if err := proto.Unmarshal(value, pb); err != nil {
+			return nil, rhi.height, err
+		}
+		claims.Claims = append(claims.Claims, pb)
+	}
+	return claims, rhi.height, nil
+}
+
+// GetRewardDistributions returns the epoch rewards delegates distributed to their voters in the epoch, and the height
+// of the indexer
+func (rhi *RewardHistoryIndexer) GetRewardDistributions(epoch uint64) (*rewardingpb.RewardDistributions, uint64, error) {
+	rhi.mutex.RLock()
+	defer rhi.mutex.RUnlock()
+
+	distributions := &rewardingpb.RewardDistributions{}
+	value, err := rhi.kvStore.Get(RewardHistoryNamespace, rewardDistributionKey(epoch))
+	switch errors.Cause(err) {
+	case nil:
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		return distributions, rhi.height, nil
+	default:
+		return nil, rhi.height, err
+	}
+	if err := proto.Unmarshal(value, distributions); err != nil {
+		return nil, rhi.height, err
+	}
+	return distributions, rhi.height, nil
+}
+
+// historyFromBlock returns the reward logs of the grant reward actions, and the claims of the successful claim
+// actions in the block, the receipts of which have to be present in the block
+func (rhi *RewardHistoryIndexer) historyFromBlock(blk *block.Block) (*rewardingpb.RewardHistoryRecord, []*rewardingpb.RewardClaim, error) {
+	receipts := make(map[hash.Hash256]*action.Receipt, len(blk.Receipts))
+	for _, r := range blk.Receipts {
+		receipts[r.ActionHash] = r
+	}
+	record := &rewardingpb.RewardHistoryRecord{}
+	var claims []*rewardingpb.RewardClaim
+	for _, selp := range blk.Actions {
+		switch selp.Action().(type) {
+		case *action.GrantReward, *action.ClaimFromRewardingFund:
+		default:
+			continue
+		}
+		actHash := selp.Hash()
+		r, ok := receipts[actHash]
+		if !ok {
+			return nil, nil, errors.Errorf("failed to find receipt of rewarding action %x", actHash)
+		}
+		if r.Status != uint64(iotextypes.ReceiptStatus_Success) {
+			continue
+		}
+		switch act := selp.Action().(type) {
+		case *action.GrantReward:
+			for _, l := range r.Logs() {
+				if l.Address != rhi.addr {
+					continue
+				}
+				rl := &rewardingpb.RewardLog{}
+				if err := proto.Unmarshal(l.Data, rl); err != nil {
+					return nil, nil, err
+				}
+				record.Rewards = append(record.Rewards, rl)
+			}
+		case *action.ClaimFromRewardingFund:
+			sender, err := address.FromBytes(selp.SrcPubkey().Hash())
+			if err != nil {
+				return nil, nil, err
+			}
+			ts, err := ptypes.TimestampProto(blk.Timestamp())
+			if err != nil {
+				return nil, nil, err
+			}
+			record.Claimants = append(record.Claimants, sender.String())
+			claims = append(claims, &rewardingpb.RewardClaim{
+				Amount:      act.Amount().String(),
+				BlockHeight: blk.Height(),
+				ActionHash:  actHash[:],
+				Timestamp:   ts,
+			})
+		}
+	}
+	return record, claims, nil
+}
+
+// updateAccountRewards adds the rewards granted at the height to the accounts, or subtracts them when reverting
+func (rhi *RewardHistoryIndexer) updateAccountRewards(height uint64, logs []*rewardingpb.RewardLog, revert bool) error {
+	epoch := rhi.rp.GetEpochNum(height)
+	deltas := make(map[string]accountReward)
+	addrs := make([]address.Address, 0)
+	for _, rl := range logs {
+		amount, ok := new(big.Int).SetString(rl.GetAmount(), 10)
+		if !ok {
+			return errors.Errorf("invalid reward amount %s", rl.GetAmount())
+		}
+		if _, ok := deltas[rl.GetAddr()]; !ok {
+			addr, err := address.FromString(rl.GetAddr())
+			if err != nil {
+				return err
+			}
+			deltas[rl.GetAddr()] = make(accountReward)
+			addrs = append(addrs, addr)
+		}
+		deltas[rl.GetAddr()].add(rl.GetType(), amount)
+	}
+	for _, addr := range addrs {
+		ar, err := rhi.accountReward(addr, epoch)
+		if err != nil {
+			return err
+		}
+		for typ, amount := range deltas[addr.String()] {
+			if revert {
+				amount = new(big.Int).Neg(amount)
+			}
+			ar.add(typ, amount)
+		}
+		key := accountRewardKey(addr, epoch)
+		if len(ar) == 0 {
+			rhi.batch.Delete(RewardHistoryNamespace, key, "failed to delete reward of %s in epoch %d", addr.String(), epoch)
+			continue
+		}
+		value, err := proto.Marshal(ar.toProto(epoch))
+		if err != nil {
+			return err
+		}
+		rhi.batch.Put(RewardHistoryNamespace, key, value, "failed to put reward of %s in epoch %d", addr.String(), epoch)
+	}
+	return nil
+}
+
+// rewardDistributionsFromLogs groups the voter rewards by the candidate sharing its epoch reward. The amount of a
+// distribution is the epoch reward of the candidate, which is the sum of the reward it keeps and the voter rewards
+func rewardDistributionsFromLogs(logs []*rewardingpb.RewardLog) (*rewardingpb.RewardDistributions, error) {
+	distributions := &rewardingpb.RewardDistributions{}
+	byCandidate := make(map[string]*rewardingpb.RewardDistribution)
+	amounts := make(map[string]*big.Int)
+	for _, rl := range logs {
+		if rl.GetCandidate() == "" {
+			continue
+		}
+		amount, ok := new(big.Int).SetString(rl.GetAmount(), 10)
+		if !ok {
+			return nil, errors.Errorf("invalid reward amount %s", rl.GetAmount())
+		}
+		switch rl.GetType() {
+		case rewardingpb.RewardLog_EPOCH_REWARD:
+			d := &rewardingpb.RewardDistribution{
+				Candidate:     rl.GetCandidate(),
+				RewardAddress: rl.GetAddr(),
+			}
+			distributions.Distributions = append(distributions.Distributions, d)
+			byCandidate[rl.GetCandidate()] = d
+			amounts[rl.GetCandidate()] = amount
+		case rewardingpb.RewardLog_VOTER_REWARD:
+			d, ok := byCandidate[rl.GetCandidate()]
+			if !ok {
+				return nil, errors.Errorf("missing epoch reward of candidate %s", rl.GetCandidate())
+			}
+			amounts[rl.GetCandidate()].Add(amounts[rl.GetCandidate()], amount)
+			d.VoterRewards = append(d.VoterRewards, &rewardingpb.VoterReward{
+				BucketIndex: rl.GetBucketIndex(),
+				Voter:       rl.GetAddr(),
+				Amount:      rl.GetAmount(),
+			})
+		}
+	}
+	for _, d := range distributions.Distributions {
+		d.Amount = amounts[d.Candidate].String()
+	}
+	return distributions, nil
+}
+
+func (rhi *RewardHistoryIndexer) accountReward(addr address.Address, epoch uint64) (accountReward, error) {
+	ar := make(accountReward)
+	value, err := rhi.kvStore.Get(RewardHistoryNamespace, accountRewardKey(addr, epoch))
+	switch errors.Cause(err) {
+	case nil:
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		return ar, nil
+	default:
+		return nil, err
+	}
+	pb := &rewardingpb.AccountReward{}
+	if err := proto.Unmarshal(value, pb); err != nil {
+		return nil, err
+	}
+	for _, v := range []struct {
+		typ    rewardingpb.RewardLog_RewardType
+		amount string
+	}{
+		{rewardingpb.RewardLog_BLOCK_REWARD, pb.GetBlockReward()},
+		{rewardingpb.RewardLog_EPOCH_REWARD, pb.GetEpochReward()},
+		{rewardingpb.RewardLog_FOUNDATION_BONUS, pb.GetFoundationBonus()},
+		{rewardingpb.RewardLog_VOTER_REWARD, pb.GetVoterReward()},
+	} {
+		if v.amount == "" {
+			continue
+		}
+		amount, ok := new(big.Int).SetString(v.amount, 10)
+		if !ok {
+			return nil, errors.Errorf("invalid reward amount %s", v.amount)
+		}
+		ar.add(v.typ, amount)
+	}
+	return ar, nil
+}
+
+// getIndexForKey returns the counting index for a key in batch mode, which is committed by commit()
+func (rhi *RewardHistoryIndexer) getIndexForKey(key []byte) (db.CountingIndex, error) {
+	index, ok := rhi.dirty[string(key)]
+	if ok {
+		return index, nil
+	}
+	index, err := db.NewCountingIndexNX(rhi.kvStore, key)
+	if err != nil {
+		return nil, err
+	}
+	if err := index.UseBatch(rhi.batch); err != nil {
+		return nil, err
+	}
+	rhi.dirty[string(key)] = index
+	return index, nil
+}
+
+func (rhi *RewardHistoryIndexer) commit() error {
+	var commitErr error
+	for k, v := range rhi.dirty {
+		if commitErr == nil {
+			if err := v.Finalize(); err != nil {
+				commitErr = err
+			}
+		}
+		delete(rhi.dirty, k)
+	}
+	if commitErr != nil {
+		return commitErr
+	}
+	return rhi.kvStore.WriteBatch(rhi.batch)
+}
+
+// add adds the amount to the reward of the type, and removes the type if the reward becomes 0
+func (ar accountReward) add(typ rewardingpb.RewardLog_RewardType, amount *big.Int) {
+	sum, ok := ar[typ]
+	if !ok {
+		sum = big.NewInt(0)
+	}
+	sum = new(big.Int).Add(sum, amount)
+	if sum.Sign() == 0 {
+		delete(ar, typ)
+		return
+	}
+	ar[typ] = sum
+}
+
+func (ar accountReward) toProto(epoch uint64) *rewardingpb.AccountReward {
+	pb := &rewardingpb.AccountReward{
+		EpochNumber: epoch,
+	}
+	for typ, amount := range ar {
+		switch typ {
+		case rewardingpb.RewardLog_BLOCK_REWARD:
+			pb.BlockReward = amount.String()
+		case rewardingpb.RewardLog_EPOCH_REWARD:
+			pb.EpochReward = amount.String()
+		case rewardingpb.RewardLog_FOUNDATION_BONUS:
+			pb.FoundationBonus = amount.String()
+		case rewardingpb.RewardLog_VOTER_REWARD:
+			pb.VoterReward = amount.String()
+		}
+	}
+	return pb
+}
+
+func accountRewardKey(addr address.Address, epoch uint64) []byte {
+	key := append([]byte{accountRewardPrefix}, addr.Bytes()...)
+	return append(key, byteutil.Uint64ToBytesBigEndian(epoch)...)
+}
+
+func rewardClaimsKey(addr address.Address) []byte {
+	return append([]byte{rewardClaimsPrefix}, addr.Bytes()...)
+}
+
+func historyRecordKey(height uint64) []byte {
+	return append([]byte{historyRecordPrefix}, byteutil.Uint64ToBytesBigEndian(height)...)
+}
+
+func rewardDistributionKey(epoch uint64) []byte {
+	return append([]byte{rewardDistributionPrefix}, byteutil.Uint64ToBytesBigEndian(epoch)...)
+}
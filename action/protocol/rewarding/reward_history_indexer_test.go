@@ -0,0 +1,276 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rewarding
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding/rewardingpb"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func testGrantReward(t *testing.T, rewardType int, height uint64, producer crypto.PrivateKey) action.SealedEnvelope {
+	selp, err := action.Sign(createGrantRewardAction(rewardType, height), producer)
+	require.NoError(t, err)
+	return selp
+}
+
+func testClaim(t *testing.T, nonce uint64, amount int64, key crypto.PrivateKey) action.SealedEnvelope {
+	cb := action.ClaimFromRewardingFundBuilder{}
+	claim := cb.SetAmount(big.NewInt(amount)).Build()
+	eb := action.EnvelopeBuilder{}
+	elp := eb.SetNonce(nonce).SetGasLimit(claim.GasLimit()).SetGasPrice(big.NewInt(0)).SetAction(&claim).Build()
+	selp, err := action.Sign(elp, key)
+	require.NoError(t, err)
+	return selp
+}
+
+func testRewardReceipt(t *testing.T, selp action.SealedEnvelope, height uint64, status iotextypes.ReceiptStatus, rewards ...*rewardingpb.RewardLog) *action.Receipt {
+	r := &action.Receipt{
+		Status:      uint64(status),
+		BlockHeight: height,
+		ActionHash:  selp.Hash(),
+	}
+	p := NewProtocol(0, 0)
+	for _, rl := range rewards {
+		data, err := proto.Marshal(rl)
+		require.NoError(t, err)
+		r.AddLogs(&action.Log{
+			Address:     p.addr.String(),
+			Data:        data,
+			BlockHeight: height,
+			ActionHash:  selp.Hash(),
+		})
+	}
+	return r
+}
+
+func testRewardBlock(t *testing.T, height uint64, selps []action.SealedEnvelope, receipts []*action.Receipt, producer crypto.PrivateKey) *block.Block {
+	blk, err := block.NewTestingBuilder().
+		SetHeight(height).
+		SetTimeStamp(time.Unix(int64(height), 0)).
+		AddActions(selps...).
+		SetReceipts(receipts).
+		SignAndBuild(producer)
+	require.NoError(t, err)
+	return &blk
+}
+
+func TestRewardHistoryIndexer(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	testPath, err := testutil.PathOfTempFile("reward-history")
+	require.NoError(err)
+	defer testutil.CleanupPath(t, testPath)
+	cfg := config.Default.DB
+	cfg.DbPath = testPath
+	// each epoch consists of 2 blocks
+	rp := rolldpos.NewProtocol(2, 2, 1)
+	_, err = NewRewardHistoryIndexer(nil, rp)
+	require.Error(err)
+	_, err = NewRewardHistoryIndexer(db.NewBoltDB(cfg), nil)
+	require.Error(err)
+	indexer, err := NewRewardHistoryIndexer(db.NewBoltDB(cfg), rp)
+	require.NoError(err)
+	require.NoError(indexer.Start(ctx))
+	defer func() {
+		require.NoError(indexer.Stop(ctx))
+	}()
+
+	var (
+		addrA, addrB, addrC = identityset.Address(1), identityset.Address(2), identityset.Address(3)
+		keyA, keyB          = identityset.PrivateKey(1), identityset.PrivateKey(2)
+		producer            = identityset.PrivateKey(27)
+	)
+	rewardLog := func(typ rewardingpb.RewardLog_RewardType, addr address.Address, amount string) *rewardingpb.RewardLog {
+		return &rewardingpb.RewardLog{Type: typ, Addr: addr.String(), Amount: amount}
+	}
+
+	// block 1: block reward to A, B claims 5 and fails to claim 500
+	grant1 := testGrantReward(t, action.BlockReward, 1, producer)
+	claimB := testClaim(t, 1, 5, keyB)
+	failedClaim := testClaim(t, 2, 500, keyB)
+	blk1 := testRewardBlock(t, 1, []action.SealedEnvelope{claimB, failedClaim, grant1}, []*action.Receipt{
+		testRewardReceipt(t, claimB, 1, iotextypes.ReceiptStatus_Success),
+		testRewardReceipt(t, failedClaim, 1, iotextypes.ReceiptStatus_Failure),
+		testRewardReceipt(t, grant1, 1, iotextypes.ReceiptStatus_Success, rewardLog(rewardingpb.RewardLog_BLOCK_REWARD, addrA, "10")),
+	}, producer)
+
+	// block 2: block reward to A, and epoch reward of epoch 1
+	grant2 := testGrantReward(t, action.BlockReward, 2, producer)
+	epochGrant := testGrantReward(t, action.EpochReward, 2, producer)
+	blk2 := testRewardBlock(t, 2, []action.SealedEnvelope{grant2, epochGrant}, []*action.Receipt{
+		testRewardReceipt(t, grant2, 2, iotextypes.ReceiptStatus_Success, rewardLog(rewardingpb.RewardLog_BLOCK_REWARD, addrA, "10")),
+		testRewardReceipt(t, epochGrant, 2, iotextypes.ReceiptStatus_Success,
+			rewardLog(rewardingpb.RewardLog_EPOCH_REWARD, addrA, "100"),
+			rewardLog(rewardingpb.RewardLog_FOUNDATION_BONUS, addrA, "5"),
+			&rewardingpb.RewardLog{Type: rewardingpb.RewardLog_EPOCH_REWARD, Addr: addrC.String(), Amount: "50", Candidate: "c"},
+			&rewardingpb.RewardLog{Type: rewardingpb.RewardLog_VOTER_REWARD, Addr: addrB.String(), Amount: "20", Candidate: "c", BucketIndex: 7},
+		),
+	}, producer)
+
+	// block 3: block reward to C, A claims 30
+	grant3 := testGrantReward(t, action.BlockReward, 3, producer)
+	claimA := testClaim(t, 1, 30, keyA)
+	blk3 := testRewardBlock(t, 3, []action.SealedEnvelope{claimA, grant3}, []*action.Receipt{
+		testRewardReceipt(t, claimA, 3, iotextypes.ReceiptStatus_Success),
+		testRewardReceipt(t, grant3, 3, iotextypes.ReceiptStatus_Success, rewardLog(rewardingpb.RewardLog_BLOCK_REWARD, addrC, "10")),
+	}, producer)
+
+	// the receipts of the rewarding actions must be present
+	noReceipt := testRewardBlock(t, 1, []action.SealedEnvelope{grant1}, nil, producer)
+	require.Error(indexer.PutBlock(ctx, noReceipt))
+	require.Equal(db.ErrInvalid, errors.Cause(indexer.PutBlock(ctx, blk2)))
+	for _, blk := range []*block.Block{blk1, blk2, blk3} {
+		require.NoError(indexer.PutBlock(ctx, blk))
+	}
+	height, err := indexer.Height()
+	require.NoError(err)
+	require.EqualValues(3, height)
+
+	checkRewards := func(addr address.Address, start, count uint64, expected ...*rewardingpb.AccountReward) {
+		rewards, height, err := indexer.GetRewards(addr, start, count)
+		require.NoError(err)
+		require.Equal(indexer.height, height)
+		require.Len(rewards.Rewards, len(expected))
+		for i := range expected {
+			require.True(proto.Equal(expected[i], rewards.Rewards[i]))
+		}
+	}
+	epoch1A := &rewardingpb.AccountReward{EpochNumber: 1, BlockReward: "20", EpochReward: "100", FoundationBonus: "5"}
+	epoch1B := &rewardingpb.AccountReward{EpochNumber: 1, VoterReward: "20"}
+	epoch1C := &rewardingpb.AccountReward{EpochNumber: 1, EpochReward: "50"}
+	epoch2C := &rewardingpb.AccountReward{EpochNumber: 2, BlockReward: "10"}
+	checkRewards(addrA, 1, 10, epoch1A)
+	checkRewards(addrA, 2, 10)
+	checkRewards(addrA, 0, 10)
+	checkRewards(addrB, 1, 10, epoch1B)
+	checkRewards(addrC, 1, 10, epoch1C, epoch2C)
+	checkRewards(addrC, 1, 1, epoch1C)
+	checkRewards(addrC, 1, 0)
+
+	checkClaims := func(addr address.Address, offset, limit uint32, expected ...action.SealedEnvelope) {
+		claims, _, err := indexer.GetClaims(addr, offset, limit)
+		require.NoError(err)
+		require.Len(claims.Claims, len(expected))
+		for i, selp := range expected {
+			actHash := selp.Hash()
+			require.Equal(actHash[:], claims.Claims[i].ActionHash)
+			require.Equal(selp.Action().(*action.ClaimFromRewardingFund).Amount().String(), claims.Claims[i].Amount)
+		}
+	}
+	checkClaims(addrA, 0, 10, claimA)
+	checkClaims(addrA, 1, 10)
+	checkClaims(addrB, 0, 10, claimB)
+	checkClaims(addrC, 0, 10)
+	claims, _, err := indexer.GetClaims(addrA, 0, 1)
+	require.NoError(err)
+	require.EqualValues(3, claims.Claims[0].BlockHeight)
+	require.EqualValues(3, claims.Claims[0].Timestamp.Seconds)
+
+	checkDistributions := func(epoch uint64, expected ...*rewardingpb.RewardDistribution) {
+		distributions, _, err := indexer.GetRewardDistributions(epoch)
+		require.NoError(err)
+		require.Len(distributions.Distributions, len(expected))
+		for i := range expected {
+			require.True(proto.Equal(expected[i], distributions.Distributions[i]))
+		}
+	}
+	distributionC := &rewardingpb.RewardDistribution{
+		Candidate:     "c",
+		RewardAddress: addrC.String(),
+		Amount:        "70",
+		VoterRewards: []*rewardingpb.VoterReward{
+			{BucketIndex: 7, Voter: addrB.String(), Amount: "20"},
+		},
+	}
+	checkDistributions(1, distributionC)
+	checkDistributions(2)
+
+	// delete the tip blocks
+	require.Equal(db.ErrInvalid, errors.Cause(indexer.DeleteTipBlock(blk2)))
+	require.NoError(indexer.DeleteTipBlock(blk3))
+	checkRewards(addrC, 1, 10, epoch1C)
+	checkClaims(addrA, 0, 10)
+	require.NoError(indexer.DeleteTipBlock(blk2))
+	checkRewards(addrA, 1, 10, &rewardingpb.AccountReward{EpochNumber: 1, BlockReward: "10"})
+	checkRewards(addrB, 1, 10)
+	checkRewards(addrC, 1, 10)
+	checkDistributions(1)
+	checkClaims(addrB, 0, 10, claimB)
+
+	// the indexer resumes from the height after restart
+	require.NoError(indexer.Stop(ctx))
+	require.NoError(indexer.Start(ctx))
+	height, err = indexer.Height()
+	require.NoError(err)
+	require.EqualValues(1, height)
+	for _, blk := range []*block.Block{blk2, blk3} {
+		require.NoError(indexer.PutBlock(ctx, blk))
+	}
+	checkRewards(addrA, 1, 10, epoch1A)
+	checkRewards(addrC, 1, 10, epoch1C, epoch2C)
+	checkClaims(addrA, 0, 10, claimA)
+	checkDistributions(1, distributionC)
+
+	// read state
+	p := NewProtocol(0, 0)
+	_, _, err = p.ReadState(ctx, nil, []byte("RewardHistory"), []byte(addrA.String()), []byte("1"), []byte("10"))
+	require.Error(err)
+	p = NewProtocol(0, 0, WithRewardHistoryIndexer(indexer))
+	for _, args := range [][][]byte{
+		{[]byte(addrA.String()), []byte("1")},
+		{[]byte("invalid"), []byte("1"), []byte("10")},
+		{[]byte(addrA.String()), []byte("-1"), []byte("10")},
+		{[]byte(addrA.String()), []byte("1"), []byte("ten")},
+	} {
+		_, _, err = p.ReadState(ctx, nil, []byte("RewardHistory"), args...)
+		require.Error(err)
+	}
+	data, height, err := p.ReadState(ctx, nil, []byte("RewardHistory"), []byte(addrA.String()), []byte("1"), []byte("10"))
+	require.NoError(err)
+	require.EqualValues(3, height)
+	rewards := &rewardingpb.AccountRewards{}
+	require.NoError(proto.Unmarshal(data, rewards))
+	require.Len(rewards.Rewards, 1)
+	require.True(proto.Equal(epoch1A, rewards.Rewards[0]))
+	data, _, err = p.ReadState(ctx, nil, []byte("ClaimHistory"), []byte(addrB.String()), []byte("0"), []byte("10"))
+	require.NoError(err)
+	require.NoError(proto.Unmarshal(data, claims))
+	require.Len(claims.Claims, 1)
+	require.Equal("5", claims.Claims[0].Amount)
+	for _, args := range [][][]byte{
+		{},
+		{[]byte("epoch")},
+	} {
+		_, _, err = p.ReadState(ctx, nil, []byte("RewardDistributions"), args...)
+		require.Error(err)
+	}
+	data, _, err = p.ReadState(ctx, nil, []byte("RewardDistributions"), []byte("1"))
+	require.NoError(err)
+	distributions := &rewardingpb.RewardDistributions{}
+	require.NoError(proto.Unmarshal(data, distributions))
+	require.Len(distributions.Distributions, 1)
+	require.True(proto.Equal(distributionC, distributions.Distributions[0]))
+}
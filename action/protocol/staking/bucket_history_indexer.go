@@ -0,0 +1,616 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/staking/stakingpb"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// StakingBucketHistoryNamespace is a namespace to store the bucket events with block height
+const StakingBucketHistoryNamespace = "stakingBucketHistory"
+
+// prefixes of the counting indexes of bucket events
+const (
+	bucketEventsPrefix    byte = 'b'
+	voterEventsPrefix     byte = 'v'
+	candidateEventsPrefix byte = 'c'
+)
+
+type (
+	// BucketEvent is an event which changed a vote bucket
+	BucketEvent struct {
+		Type        string
+		BucketIndex uint64
+		// Sender is the sender of the action
+		Sender address.Address
+		// Voter is the owner of the bucket after the event, which is nil if the receipt log does not carry it
+		Voter address.Address
+		// Candidate is the owner of the candidate voted after the event, which is nil if the receipt log does not
+		// carry it
+		Candidate address.Address
+		// PreviousCandidate is the owner of the candidate voted before the candidate is changed
+		PreviousCandidate address.Address
+		// Amount is the amount staked or deposited by the event, nil for other events
+		Amount      *big.Int
+		BlockHeight uint64
+		ActionHash  hash.Hash256
+		Timestamp   time.Time
+	}
+
+	// BucketHistoryIndexer is an indexer to store the events of vote buckets by bucket index, voter and candidate
+	BucketHistoryIndexer struct {
+		mutex   sync.RWMutex
+		height  uint64
+		kvStore db.KVStoreWithRange
+		batch   batch.KVStoreBatch
+		dirty   map[string]db.CountingIndex
+	}
+)
+
+var (
+	bucketEventTopics map[hash.Hash256]string
+)
+
+func init() {
+	bucketEventTopics = make(map[hash.Hash256]string)
+	for _, topic := range []string{
+		HandleCreateStake,
+		HandleUnstake,
+		HandleWithdrawStake,
+		HandleChangeCandidate,
+		HandleTransferStake,
+		HandleDepositToStake,
+		HandleRestake,
+		HandleCandidateRegister,
+		HandleSplitStake,
+		HandleMergeStake,
+	} {
+		// receipt log topics before and after Fairbank migration
+		bucketEventTopics[hash.Hash256b([]byte(topic))] = topic
+		bucketEventTopics[hash.BytesToHash256([]byte(topic))] = topic
+	}
+}
+
+// NewBucketHistoryIndexer creates a new BucketHistoryIndexer
+func NewBucketHistoryIndexer(kv db.KVStore) (*BucketHistoryIndexer, error) {
+	if kv == nil {
+		return nil, ErrMissingField
+	}
+	kvRange, ok := kv.(db.KVStoreWithRange)
+	if !ok {
+		return nil, errors.New("indexer can only be created from KVStoreWithRange")
+	}
+	return &BucketHistoryIndexer{
+		kvStore: kvRange,
+		batch:   batch.NewBatch(),
+		dirty:   make(map[string]db.CountingIndex),
+	}, nil
+}
+
+// Start starts the indexer
+func (bhi *BucketHistoryIndexer) Start(ctx context.Context) error {
+	if err := bhi.kvStore.Start(ctx); err != nil {
+		return err
+	}
+	ret, err := bhi.kvStore.Get(StakingBucketHistoryNamespace, []byte(indexerHeightKey))
+	switch errors.Cause(err) {
+	case nil:
+		bhi.height = byteutil.BytesToUint64BigEndian(ret)
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		bhi.height = 0
+	default:
+		return err
+	}
+	return nil
+}
+
+// Stop stops the indexer
+func (bhi *BucketHistoryIndexer) Stop(ctx context.Context) error {
+	return bhi.kvStore.Stop(ctx)
+}
+
+// ReadsReceipts returns true, since the bucket events are indexed from the receipts of the block
+func (bhi *BucketHistoryIndexer) ReadsReceipts() bool {
+	return true
+}
+
+// Height returns the height of the last indexed block
+func (bhi *BucketHistoryIndexer) Height() (uint64, error) {
+	bhi.mutex.RLock()
+	defer bhi.mutex.RUnlock()
+	return bhi.height, nil
+}
+
+// PutBlock indexes the bucket events of the successful staking actions in the block
+func (bhi *BucketHistoryIndexer) PutBlock(_ context.Context, blk *block.Block) error {
+	bhi.mutex.Lock()
+	defer bhi.mutex.Unlock()
+
+	// the block to be indexed must be exactly current top + 1, otherwise counting index would not work correctly
+	height := blk.Height()
+	if height != bhi.height+1 {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, bhi.height+1)
+	}
+	events, err := bucketEventsFromBlock(blk)
+	if err != nil {
+		return err
+	}
+	if len(events) > 0 {
+		pbEvents := &stakingpb.BucketEvents{}
+		for _, e := range events {
+			pb, err := e.toProto()
+			if err != nil {
+				return err
+			}
+			value, err := proto.Marshal(pb)
+			if err != nil {
+				return err
+			}
+			for _, key := range e.indexKeys() {
+				index, err := bhi.getIndexForKey(key)
+				if err != nil {
+					return err
+				}
+				if err := index.Add(value, true); err != nil {
+					return err
+				}
+			}
+			pbEvents.Events = append(pbEvents.Events, pb)
+		}
+		value, err := proto.Marshal(pbEvents)
+		if err != nil {
+			return err
+		}
+		bhi.batch.Put(StakingBucketHistoryNamespace, byteutil.Uint64ToBytesBigEndian(height), value, "failed to put bucket events at height %d", height)
+	}
+	bhi.batch.Put(StakingBucketHistoryNamespace, []byte(indexerHeightKey), byteutil.Uint64ToBytesBigEndian(height), "failed to put indexer height")
+	if err := bhi.commit(); err != nil {
+		return err
+	}
+	bhi.height = height
+	return nil
+}
+
+// DeleteTipBlock deletes the bucket events of the tip block
+func (bhi *BucketHistoryIndexer) DeleteTipBlock(blk *block.Block) error {
+	bhi.mutex.Lock()
+	defer bhi.mutex.Unlock()
+
+	height := blk.Height()
+	if height != bhi.height {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, bhi.height)
+	}
+	heightKey := byteutil.Uint64ToBytesBigEndian(height)
+	value, err := bhi.kvStore.Get(StakingBucketHistoryNamespace, heightKey)
+	switch errors.Cause(err) {
+	case nil:
+		pbEvents := &stakingpb.BucketEvents{}
+		if err := proto.Unmarshal(value, pbEvents); err != nil {
+			return err
+		}
+		count := make(map[string]uint64)
+		for _, pb := range pbEvents.Events {
+			e, err := bucketEventFromProto(pb)
+			if err != nil {
+				return err
+			}
+			for _, key := range e.indexKeys() {
+				count[string(key)]++
+			}
+		}
+		for key, n := range count {
+			index, err := db.GetCountingIndex(bhi.kvStore, []byte(key))
+			if err != nil {
+				return err
+			}
+			if err := index.Revert(n); err != nil {
+				return err
+			}
+		}
+		bhi.batch.Delete(StakingBucketHistoryNamespace, heightKey, "failed to delete bucket events at height %d", height)
+	case db.ErrNotExist, db.ErrBucketNotExist:
+	default:
+		return err
+	}
+	bhi.batch.Put(StakingBucketHistoryNamespace, []byte(indexerHeightKey), byteutil.Uint64ToBytesBigEndian(height-1), "failed to put indexer height")
+	if err := bhi.kvStore.WriteBatch(bhi.batch); err != nil {
+		return err
+	}
+	bhi.height = height - 1
+	return nil
+}
+
+// GetBucketHistory returns the events of a bucket[offset, offset+limit), and the height of the indexer
+func (bhi *BucketHistoryIndexer) GetBucketHistory(index uint64, offset, limit uint32) (*stakingpb.BucketEvents, uint64, error) {
+	return bhi.getEvents(bucketEventsKey(index), offset, limit)
+}
+
+// GetEventsByVoter returns the events of the buckets owned by a voter[offset, offset+limit), and the height of the
+// indexer
+func (bhi *BucketHistoryIndexer) GetEventsByVoter(voter address.Address, offset, limit uint32) (*stakingpb.BucketEvents, uint64, error) {
+	return bhi.getEvents(addressEventsKey(voterEventsPrefix, voter), offset, limit)
+}
+
+// GetEventsByCandidate returns the events of the buckets voting for a candidate[offset, offset+limit), and the height
+// of the indexer
+func (bhi *BucketHistoryIndexer) GetEventsByCandidate(candidate address.Address, offset, limit uint32) (*stakingpb.BucketEvents, uint64, error) {
+	return bhi.getEvents(addressEventsKey(candidateEventsPrefix, candidate), offset, limit)
+}
+
+func (bhi *BucketHistoryIndexer) getEvents(key []byte, offset, limit uint32) (*stakingpb.BucketEvents, uint64, error) {
+	bhi.mutex.RLock()
+	defer bhi.mutex.RUnlock()
+
+	events := &stakingpb.BucketEvents{}
+	index, err := db.GetCountingIndex(bhi.kvStore, key)
+	switch errors.Cause(err) {
+	case nil:
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		return events, bhi.height, nil
+	default:
+		return nil, bhi.height, err
+	}
+	total := index.Size()
+	if uint64(offset) >= total || limit == 0 {
+		return events, bhi.height, nil
+	}
+	count := uint64(limit)
+	if uint64(offset)+count > total {
+		count = total - uint64(offset)
+	}
+	values, err := index.Range(uint64(offset), count)
+	if err != nil {
+		return nil, bhi.height, err
+	}
+	for _, value := range values {
+		pb := &stakingpb.BucketEvent{}
+		if err := proto.Unmarshal(value, pb); err != nil {
+			return nil, bhi.height, err
+		}
+		events.Events = append(events.Events, pb)
+	}
+	return events, bhi.height, nil
+}
+
+// getIndexForKey returns the counting index for a key in batch mode, which is committed by commit()
+func (bhi *BucketHistoryIndexer) getIndexForKey(key []byte) (db.CountingIndex, error) {
+	index, ok := bhi.dirty[string(key)]
+	if ok {
+		return index, nil
+	}
+	index, err := db.NewCountingIndexNX(bhi.kvStore, key)
+	if err != nil {
+		return nil, err
+	}
+	if err := index.UseBatch(bhi.batch); err != nil {
+		return nil, err
+	}
+	bhi.dirty[string(key)] = index
+	return index, nil
+}
+
+func (bhi *BucketHistoryIndexer) commit() error {
+	var commitErr error
+	for k, v := range bhi.dirty {
+		if commitErr == nil {
+			if err := v.Finalize(); err != nil {
+				commitErr = err
+			}
+		}
+		delete(bhi.dirty, k)
+	}
+	if commitErr != nil {
+		return commitErr
+	}
+	return bhi.kvStore.WriteBatch(bhi.batch)
+}
+
+// bucketEventsFromBlock returns the bucket events of the successful staking actions in the block, the receipts of
+// which have to be present in the block
+func bucketEventsFromBlock(blk *block.Block) ([]*BucketEvent, error) {
+	receipts := make(map[hash.Hash256]*action.Receipt, len(blk.Receipts))
+	for _, r := range blk.Receipts {
+		receipts[r.ActionHash] = r
+	}
+	var events []*BucketEvent
+	for _, selp := range blk.Actions {
+		switch selp.Action().(type) {
+		case *action.CreateStake, *action.Unstake, *action.WithdrawStake, *action.ChangeCandidate,
+			*action.TransferStake, *action.DepositToStake, *action.Restake, *action.CandidateRegister,
+			*action.SplitStake, *action.MergeStake:
+		default:
+			continue
+		}
+		actHash := selp.Hash()
+		r, ok := receipts[actHash]
+		if !ok {
+			return nil, errors.Errorf("failed to find receipt of staking action %x", actHash)
+		}
+		if r.Status != uint64(iotextypes.ReceiptStatus_Success) {
+			continue
+		}
+		sender, err := address.FromBytes(selp.SrcPubkey().Hash())
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range r.Logs() {
+			logEvents, err := bucketEventsFromLog(selp.Action(), sender, l)
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range logEvents {
+				e.Timestamp = blk.Timestamp()
+				events = append(events, e)
+			}
+		}
+	}
+	return events, nil
+}
+
+// bucketEventsFromLog returns the bucket events from the staking receipt log of an action. A bucket split or merge
+// changes more than one bucket, the log of which carries the indexes of all the buckets followed by the candidates.
+func bucketEventsFromLog(act action.Action, sender address.Address, l *action.Log) ([]*BucketEvent, error) {
+	if l.Address == stakingProtocolAddr() && len(l.Topics) > 0 {
+		switch topic := bucketEventTopics[l.Topics[0]]; topic {
+		case HandleSplitStake, HandleMergeStake:
+			return bucketOpEventsFromLog(topic, act, sender, l)
+		}
+	}
+	e, err := bucketEventFromLog(act, sender, l)
+	if err != nil || e == nil {
+		return nil, err
+	}
+	return []*BucketEvent{e}, nil
+}
+
+func bucketOpEventsFromLog(topic string, act action.Action, sender address.Address, l *action.Log) ([]*BucketEvent, error) {
+	// split: bucket index, new bucket index, previous candidate, candidate
+	// merge: bucket index, merged bucket indexes..., candidate
+	numCands := 1
+	if topic == HandleSplitStake {
+		numCands = 2
+	}
+	if len(l.Topics) < 2+numCands || l.Topics[0] != hash.BytesToHash256([]byte(topic)) {
+		return nil, errors.Errorf("invalid %s log of action %x", topic, l.ActionHash)
+	}
+	indexes := l.Topics[1 : len(l.Topics)-numCands]
+	cands := make([]address.Address, 0, numCands)
+	for _, t := range l.Topics[len(l.Topics)-numCands:] {
+		addr, err := address.FromBytes(t[12:])
+		if err != nil {
+			return nil, err
+		}
+		cands = append(cands, addr)
+	}
+	events := make([]*BucketEvent, 0, len(indexes))
+	for i, t := range indexes {
+		e := &BucketEvent{
+			Type:        topic,
+			BucketIndex: byteutil.BytesToUint64BigEndian(t[24:]),
+			Sender:      sender,
+			Voter:       sender,
+			Candidate:   cands[0],
+			BlockHeight: l.BlockHeight,
+			ActionHash:  l.ActionHash,
+		}
+		if topic == HandleSplitStake && i == 1 {
+			// the new bucket split out of the bucket
+			e.PreviousCandidate, e.Candidate = cands[0], cands[1]
+			if ss, ok := act.(*action.SplitStake); ok {
+				e.Amount = ss.Amount()
+			}
+		}
+		events = append(events, e)
+	}
+	if topic == HandleSplitStake && len(events) != 2 {
+		return nil, errors.Errorf("invalid %s log of action %x", topic, l.ActionHash)
+	}
+	return events, nil
+}
+
+// bucketEventFromLog returns the bucket event from the staking receipt log of an action, or nil if the log is not
+// one of a bucket event. Receipt logs after Fairbank migration carry the bucket index, the voter and the candidate
+// in the topics, while the ones before carry their hashes only.
+func bucketEventFromLog(act action.Action, sender address.Address, l *action.Log) (*BucketEvent, error) {
+	if l.Address != stakingProtocolAddr() || len(l.Topics) == 0 {
+		return nil, nil
+	}
+	topic, ok := bucketEventTopics[l.Topics[0]]
+	if !ok {
+		return nil, nil
+	}
+	postFairbank := l.Topics[0] == hash.BytesToHash256([]byte(topic))
+	e := &BucketEvent{
+		Type:        topic,
+		Sender:      sender,
+		BlockHeight: l.BlockHeight,
+		ActionHash:  l.ActionHash,
+	}
+	// addrs are the addresses in the topics after the bucket index
+	var addrs []address.Address
+	if postFairbank {
+		if len(l.Topics) < 2 {
+			return nil, errors.Errorf("invalid %s log of action %x", topic, l.ActionHash)
+		}
+		e.BucketIndex = byteutil.BytesToUint64BigEndian(l.Topics[1][24:])
+		for _, t := range l.Topics[2:] {
+			addr, err := address.FromBytes(t[12:])
+			if err != nil {
+				return nil, err
+			}
+			addrs = append(addrs, addr)
+		}
+	}
+	switch act := act.(type) {
+	case *action.CreateStake:
+		e.Voter = sender
+		e.Amount = act.Amount()
+		if postFairbank && len(addrs) == 1 {
+			e.Candidate = addrs[0]
+		}
+	case *action.CandidateRegister:
+		e.Voter = act.OwnerAddress()
+		if e.Voter == nil {
+			e.Voter = sender
+		}
+		e.Candidate = e.Voter
+		e.Amount = act.Amount()
+	case *action.Unstake, *action.WithdrawStake, *action.Restake:
+		e.Voter = sender
+		if postFairbank && len(addrs) == 1 {
+			e.Candidate = addrs[0]
+		}
+	case *action.ChangeCandidate:
+		e.Voter = sender
+		if postFairbank && len(addrs) == 2 {
+			e.PreviousCandidate, e.Candidate = addrs[0], addrs[1]
+		}
+	case *action.TransferStake:
+		e.Voter = act.VoterAddress()
+		if postFairbank && len(addrs) == 2 {
+			e.Candidate = addrs[1]
+		}
+	case *action.DepositToStake:
+		e.Amount = act.Amount()
+		if postFairbank && len(addrs) == 2 {
+			e.Voter, e.Candidate = addrs[0], addrs[1]
+		}
+	default:
+		return nil, nil
+	}
+	if !postFairbank {
+		switch act := act.(type) {
+		case *action.CreateStake, *action.CandidateRegister:
+			if len(l.Data) != 8 {
+				return nil, errors.Errorf("invalid %s log of action %x", topic, l.ActionHash)
+			}
+			e.BucketIndex = byteutil.BytesToUint64BigEndian(l.Data)
+		case interface{ BucketIndex() uint64 }:
+			e.BucketIndex = act.BucketIndex()
+		}
+	}
+	return e, nil
+}
+
+// indexKeys returns the keys of the counting indexes the event is added to
+func (e *BucketEvent) indexKeys() [][]byte {
+	keys := [][]byte{bucketEventsKey(e.BucketIndex)}
+	voters := []address.Address{e.Sender}
+	if e.Voter != nil && !address.Equal(e.Voter, e.Sender) {
+		voters = append(voters, e.Voter)
+	}
+	for _, voter := range voters {
+		keys = append(keys, addressEventsKey(voterEventsPrefix, voter))
+	}
+	if e.Candidate != nil {
+		keys = append(keys, addressEventsKey(candidateEventsPrefix, e.Candidate))
+	}
+	if e.PreviousCandidate != nil && (e.Candidate == nil || !address.Equal(e.PreviousCandidate, e.Candidate)) {
+		keys = append(keys, addressEventsKey(candidateEventsPrefix, e.PreviousCandidate))
+	}
+	return keys
+}
+
+func (e *BucketEvent) toProto() (*stakingpb.BucketEvent, error) {
+	ts, err := ptypes.TimestampProto(e.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	pb := &stakingpb.BucketEvent{
+		Type:        e.Type,
+		BucketIndex: e.BucketIndex,
+		BlockHeight: e.BlockHeight,
+		ActionHash:  e.ActionHash[:],
+		Timestamp:   ts,
+	}
+	if e.Sender != nil {
+		pb.Sender = e.Sender.String()
+	}
+	if e.Voter != nil {
+		pb.Voter = e.Voter.String()
+	}
+	if e.Candidate != nil {
+		pb.Candidate = e.Candidate.String()
+	}
+	if e.PreviousCandidate != nil {
+		pb.PreviousCandidate = e.PreviousCandidate.String()
+	}
+	if e.Amount != nil {
+		pb.Amount = e.Amount.String()
+	}
+	return pb, nil
+}
+
+func bucketEventFromProto(pb *stakingpb.BucketEvent) (*BucketEvent, error) {
+	ts, err := ptypes.Timestamp(pb.GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	e := &BucketEvent{
+		Type:        pb.GetType(),
+		BucketIndex: pb.GetBucketIndex(),
+		BlockHeight: pb.GetBlockHeight(),
+		ActionHash:  hash.BytesToHash256(pb.GetActionHash()),
+		Timestamp:   ts,
+	}
+	for _, v := range []struct {
+		str  string
+		addr *address.Address
+	}{
+		{pb.GetSender(), &e.Sender},
+		{pb.GetVoter(), &e.Voter},
+		{pb.GetCandidate(), &e.Candidate},
+		{pb.GetPreviousCandidate(), &e.PreviousCandidate},
+	} {
+		if v.str == "" {
+			continue
+		}
+		if *v.addr, err = address.FromString(v.str); err != nil {
+			return nil, err
+		}
+	}
+	if pb.GetAmount() != "" {
+		amount, ok := new(big.Int).SetString(pb.GetAmount(), 10)
+		if !ok {
+			return nil, errors.Errorf("invalid amount %s", pb.GetAmount())
+		}
+		e.Amount = amount
+	}
+	return e, nil
+}
+
+func bucketEventsKey(index uint64) []byte {
+	return append([]byte{bucketEventsPrefix}, byteutil.Uint64ToBytesBigEndian(index)...)
+}
+
+func addressEventsKey(prefix byte, addr address.Address) []byte {
+	return append([]byte{prefix}, addr.Bytes()...)
+}
+
+func stakingProtocolAddr() string {
+	h := hash.Hash160b([]byte(protocolID))
+	addr, _ := address.FromBytes(h[:])
+	return addr.String()
+}
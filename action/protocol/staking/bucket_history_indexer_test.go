@@ -0,0 +1,351 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/staking/stakingpb"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/unit"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+// testStakingReceipt returns the receipt of a staking action, with the receipt log after Fairbank migration
+func testStakingReceipt(selp action.SealedEnvelope, height uint64, status iotextypes.ReceiptStatus, topic string, index uint64, addrs ...address.Address) *action.Receipt {
+	actHash := selp.Hash()
+	ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{ActionHash: actHash})
+	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: height})
+	rLog := newReceiptLog(stakingProtocolAddr(), topic, true)
+	topics := [][]byte{byteutil.Uint64ToBytesBigEndian(index)}
+	for _, addr := range addrs {
+		topics = append(topics, addr.Bytes())
+	}
+	rLog.AddTopics(topics...)
+	r := &action.Receipt{
+		Status:      uint64(status),
+		BlockHeight: height,
+		ActionHash:  actHash,
+	}
+	return r.AddLogs(rLog.Build(ctx, nil))
+}
+
+func testBucketEventsBlock(height uint64, selps []action.SealedEnvelope, receipts []*action.Receipt, producer crypto.PrivateKey) (*block.Block, error) {
+	blk, err := block.NewTestingBuilder().
+		SetHeight(height).
+		SetTimeStamp(time.Unix(int64(height), 0)).
+		AddActions(selps...).
+		SetReceipts(receipts).
+		SignAndBuild(producer)
+	if err != nil {
+		return nil, err
+	}
+	return &blk, nil
+}
+
+func TestBucketHistoryIndexer(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+
+	testPath, err := testutil.PathOfTempFile("bucket-history")
+	r.NoError(err)
+	defer testutil.CleanupPath(t, testPath)
+	cfg := config.Default.DB
+	cfg.DbPath = testPath
+	indexer, err := NewBucketHistoryIndexer(db.NewBoltDB(cfg))
+	r.NoError(err)
+	r.NoError(indexer.Start(ctx))
+	defer func() {
+		r.NoError(indexer.Stop(ctx))
+	}()
+	_, err = NewBucketHistoryIndexer(nil)
+	r.Equal(ErrMissingField, err)
+
+	var (
+		voter1, voter2, voter3 = identityset.Address(1), identityset.Address(2), identityset.Address(3)
+		key1, key2, key3       = identityset.PrivateKey(1), identityset.PrivateKey(2), identityset.PrivateKey(3)
+		cand1, cand2           = identityset.Address(10), identityset.Address(11)
+		amount                 = unit.ConvertIotxToRau(100)
+		gasPrice               = big.NewInt(unit.Qev)
+		producer               = identityset.PrivateKey(27)
+	)
+
+	// block 1: voter1 creates bucket 0 for cand1, a failed create stake and a transfer are not indexed
+	create, err := testutil.SignedCreateStake(1, "cand1", amount.String(), 7, true, nil, 100000, gasPrice, key1)
+	r.NoError(err)
+	failed, err := testutil.SignedCreateStake(2, "cand1", amount.String(), 7, true, nil, 100000, gasPrice, key1)
+	r.NoError(err)
+	tsf, err := testutil.SignedTransfer(voter2.String(), key1, 3, big.NewInt(1), nil, 100000, gasPrice)
+	r.NoError(err)
+	blk1, err := testBucketEventsBlock(1, []action.SealedEnvelope{create, failed, tsf}, []*action.Receipt{
+		testStakingReceipt(create, 1, iotextypes.ReceiptStatus_Success, HandleCreateStake, 0, cand1),
+		testStakingReceipt(failed, 1, iotextypes.ReceiptStatus_ErrNotEnoughBalance, HandleCreateStake, 1, cand1),
+		{Status: uint64(iotextypes.ReceiptStatus_Success), BlockHeight: 1, ActionHash: tsf.Hash()},
+	}, producer)
+	r.NoError(err)
+
+	// block 2: voter2 deposits to bucket 0, voter1 changes the candidate to cand2 and transfers it to voter3
+	deposit, err := testutil.SignedDepositToStake(1, 0, amount.String(), nil, 100000, gasPrice, key2)
+	r.NoError(err)
+	change, err := testutil.SignedChangeCandidate(4, "cand2", 0, nil, 100000, gasPrice, key1)
+	r.NoError(err)
+	transfer, err := testutil.SignedTransferStake(5, voter3.String(), 0, nil, 100000, gasPrice, key1)
+	r.NoError(err)
+	blk2, err := testBucketEventsBlock(2, []action.SealedEnvelope{deposit, change, transfer}, []*action.Receipt{
+		testStakingReceipt(deposit, 2, iotextypes.ReceiptStatus_Success, HandleDepositToStake, 0, voter1, cand1),
+		testStakingReceipt(change, 2, iotextypes.ReceiptStatus_Success, HandleChangeCandidate, 0, cand1, cand2),
+		testStakingReceipt(transfer, 2, iotextypes.ReceiptStatus_Success, HandleTransferStake, 0, voter3, cand2),
+	}, producer)
+	r.NoError(err)
+
+	// block 3: voter3 unstakes bucket 0
+	unstake, err := testutil.SignedReclaimStake(false, 1, 0, nil, 100000, gasPrice, key3)
+	r.NoError(err)
+	blk3, err := testBucketEventsBlock(3, []action.SealedEnvelope{unstake}, []*action.Receipt{
+		testStakingReceipt(unstake, 3, iotextypes.ReceiptStatus_Success, HandleUnstake, 0, cand2),
+	}, producer)
+	r.NoError(err)
+
+	// the receipts of the staking actions must be present
+	noReceipt, err := testBucketEventsBlock(1, []action.SealedEnvelope{create}, nil, producer)
+	r.NoError(err)
+	r.Error(indexer.PutBlock(ctx, noReceipt))
+	r.Equal(db.ErrInvalid, errors.Cause(indexer.PutBlock(ctx, blk2)))
+	for _, blk := range []*block.Block{blk1, blk2, blk3} {
+		r.NoError(indexer.PutBlock(ctx, blk))
+	}
+	height, err := indexer.Height()
+	r.NoError(err)
+	r.EqualValues(3, height)
+
+	checkEvents := func(events *stakingpb.BucketEvents, types ...string) {
+		r.Equal(len(types), len(events.Events))
+		for i := range types {
+			r.Equal(types[i], events.Events[i].Type)
+			r.Zero(events.Events[i].BucketIndex)
+		}
+	}
+	events, height, err := indexer.GetBucketHistory(0, 0, 10)
+	r.NoError(err)
+	r.EqualValues(3, height)
+	checkEvents(events, HandleCreateStake, HandleDepositToStake, HandleChangeCandidate, HandleTransferStake, HandleUnstake)
+	createHash := create.Hash()
+	r.True(proto.Equal(&stakingpb.BucketEvent{
+		Type:        HandleCreateStake,
+		Sender:      voter1.String(),
+		Voter:       voter1.String(),
+		Candidate:   cand1.String(),
+		Amount:      amount.String(),
+		BlockHeight: 1,
+		ActionHash:  createHash[:],
+		Timestamp:   events.Events[0].Timestamp,
+	}, events.Events[0]))
+	r.EqualValues(1, events.Events[0].Timestamp.Seconds)
+	r.Equal(voter2.String(), events.Events[1].Sender)
+	r.Equal(voter1.String(), events.Events[1].Voter)
+	r.Equal(cand1.String(), events.Events[2].PreviousCandidate)
+	r.Equal(cand2.String(), events.Events[2].Candidate)
+	r.Equal(voter3.String(), events.Events[3].Voter)
+
+	// pagination
+	events, _, err = indexer.GetBucketHistory(0, 1, 2)
+	r.NoError(err)
+	checkEvents(events, HandleDepositToStake, HandleChangeCandidate)
+	events, _, err = indexer.GetBucketHistory(0, 4, 2)
+	r.NoError(err)
+	checkEvents(events, HandleUnstake)
+	events, _, err = indexer.GetBucketHistory(0, 5, 2)
+	r.NoError(err)
+	checkEvents(events)
+	events, _, err = indexer.GetBucketHistory(1, 0, 10)
+	r.NoError(err)
+	checkEvents(events)
+
+	// voters and candidates
+	for _, v := range []struct {
+		addr  address.Address
+		types []string
+	}{
+		{voter1, []string{HandleCreateStake, HandleDepositToStake, HandleChangeCandidate, HandleTransferStake}},
+		{voter2, []string{HandleDepositToStake}},
+		{voter3, []string{HandleTransferStake, HandleUnstake}},
+	} {
+		events, _, err = indexer.GetEventsByVoter(v.addr, 0, 10)
+		r.NoError(err)
+		checkEvents(events, v.types...)
+	}
+	for _, v := range []struct {
+		addr  address.Address
+		types []string
+	}{
+		{cand1, []string{HandleCreateStake, HandleDepositToStake, HandleChangeCandidate}},
+		{cand2, []string{HandleChangeCandidate, HandleTransferStake, HandleUnstake}},
+	} {
+		events, _, err = indexer.GetEventsByCandidate(v.addr, 0, 10)
+		r.NoError(err)
+		checkEvents(events, v.types...)
+	}
+
+	// delete the tip block
+	r.Equal(db.ErrInvalid, errors.Cause(indexer.DeleteTipBlock(blk2)))
+	r.NoError(indexer.DeleteTipBlock(blk3))
+	height, err = indexer.Height()
+	r.NoError(err)
+	r.EqualValues(2, height)
+	events, height, err = indexer.GetEventsByVoter(voter3, 0, 10)
+	r.NoError(err)
+	r.EqualValues(2, height)
+	checkEvents(events, HandleTransferStake)
+	events, _, err = indexer.GetBucketHistory(0, 0, 10)
+	r.NoError(err)
+	checkEvents(events, HandleCreateStake, HandleDepositToStake, HandleChangeCandidate, HandleTransferStake)
+
+	// the height persists after restart
+	r.NoError(indexer.Stop(ctx))
+	r.NoError(indexer.Start(ctx))
+	height, err = indexer.Height()
+	r.NoError(err)
+	r.EqualValues(2, height)
+	r.NoError(indexer.PutBlock(ctx, blk3))
+	events, _, err = indexer.GetEventsByCandidate(cand2, 0, 10)
+	r.NoError(err)
+	checkEvents(events, HandleChangeCandidate, HandleTransferStake, HandleUnstake)
+
+	// read state
+	_, _, err = readStateBucketHistory(nil, &iotexapi.ReadStakingDataRequest_BucketHistory{})
+	r.Error(err)
+	events, height, err = readStateBucketHistory(indexer, &iotexapi.ReadStakingDataRequest_BucketHistory{
+		Index:      0,
+		Pagination: &iotexapi.PaginationParam{Offset: 1, Limit: 1},
+	})
+	r.NoError(err)
+	r.EqualValues(3, height)
+	checkEvents(events, HandleDepositToStake)
+	_, _, err = readStateBucketEventsByVoter(indexer, &iotexapi.ReadStakingDataRequest_BucketEventsByVoter{
+		VoterAddress: "invalid",
+	})
+	r.Error(err)
+	events, _, err = readStateBucketEventsByVoter(indexer, &iotexapi.ReadStakingDataRequest_BucketEventsByVoter{
+		VoterAddress: voter3.String(),
+		Pagination:   &iotexapi.PaginationParam{Offset: 0, Limit: 10},
+	})
+	r.NoError(err)
+	checkEvents(events, HandleTransferStake, HandleUnstake)
+}
+
+func TestBucketEventFromLegacyLog(t *testing.T) {
+	r := require.New(t)
+
+	voter, cand := identityset.Address(1), identityset.Address(10)
+	create, err := testutil.SignedCreateStake(1, "cand1", "100", 7, true, nil, 100000, big.NewInt(0), identityset.PrivateKey(1))
+	r.NoError(err)
+	actHash := create.Hash()
+	ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{ActionHash: actHash})
+	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: 5})
+
+	// the receipt log before Fairbank migration carries the bucket index in data
+	rLog := newReceiptLog(stakingProtocolAddr(), HandleCreateStake, false)
+	rLog.AddAddress(cand)
+	rLog.AddAddress(voter)
+	rLog.SetData(byteutil.Uint64ToBytesBigEndian(3))
+	e, err := bucketEventFromLog(create.Action(), voter, rLog.Build(ctx, nil))
+	r.NoError(err)
+	r.Equal(HandleCreateStake, e.Type)
+	r.EqualValues(3, e.BucketIndex)
+	r.EqualValues(5, e.BlockHeight)
+	r.Equal(actHash, e.ActionHash)
+	r.True(address.Equal(voter, e.Voter))
+	r.Nil(e.Candidate)
+	r.Equal(big.NewInt(100), e.Amount)
+
+	unstake, err := testutil.SignedReclaimStake(false, 2, 4, nil, 100000, big.NewInt(0), identityset.PrivateKey(1))
+	r.NoError(err)
+	rLog = newReceiptLog(stakingProtocolAddr(), HandleUnstake, false)
+	e, err = bucketEventFromLog(unstake.Action(), voter, rLog.Build(ctx, nil))
+	r.NoError(err)
+	r.EqualValues(4, e.BucketIndex)
+
+	// logs of other protocols and candidate update are not bucket events
+	rLog = newReceiptLog(stakingProtocolAddr(), HandleCandidateUpdate, true)
+	e, err = bucketEventFromLog(unstake.Action(), voter, rLog.Build(ctx, nil))
+	r.NoError(err)
+	r.Nil(e)
+	e, err = bucketEventFromLog(unstake.Action(), voter, &action.Log{
+		Address: identityset.Address(2).String(),
+		Topics:  action.Topics{hash.BytesToHash256([]byte(HandleUnstake))},
+	})
+	r.NoError(err)
+	r.Nil(e)
+}
+
+func TestBucketEventsFromBucketOpLog(t *testing.T) {
+	r := require.New(t)
+
+	voter, prevCand, cand := identityset.Address(1), identityset.Address(10), identityset.Address(11)
+	ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{})
+	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: 5})
+
+	// split emits the events of the bucket and the new bucket
+	split, err := action.NewSplitStake(1, "cand1", 3, "100", nil, 100000, big.NewInt(0))
+	r.NoError(err)
+	rLog := newReceiptLog(stakingProtocolAddr(), HandleSplitStake, true)
+	rLog.AddTopics(byteutil.Uint64ToBytesBigEndian(3), byteutil.Uint64ToBytesBigEndian(8), prevCand.Bytes(), cand.Bytes())
+	events, err := bucketEventsFromLog(split, voter, rLog.Build(ctx, nil))
+	r.NoError(err)
+	r.Len(events, 2)
+	r.EqualValues(3, events[0].BucketIndex)
+	r.True(address.Equal(prevCand, events[0].Candidate))
+	r.Nil(events[0].Amount)
+	r.EqualValues(8, events[1].BucketIndex)
+	r.True(address.Equal(voter, events[1].Voter))
+	r.True(address.Equal(prevCand, events[1].PreviousCandidate))
+	r.True(address.Equal(cand, events[1].Candidate))
+	r.Equal(big.NewInt(100), events[1].Amount)
+	for _, e := range events {
+		r.Equal(HandleSplitStake, e.Type)
+		r.EqualValues(5, e.BlockHeight)
+	}
+
+	// merge emits the events of the bucket and the merged buckets
+	merge, err := action.NewMergeStake(1, 3, []uint64{4, 6}, nil, 100000, big.NewInt(0))
+	r.NoError(err)
+	rLog = newReceiptLog(stakingProtocolAddr(), HandleMergeStake, true)
+	rLog.AddTopics(byteutil.Uint64ToBytesBigEndian(3), byteutil.Uint64ToBytesBigEndian(4), byteutil.Uint64ToBytesBigEndian(6), cand.Bytes())
+	events, err = bucketEventsFromLog(merge, voter, rLog.Build(ctx, nil))
+	r.NoError(err)
+	r.Len(events, 3)
+	for i, index := range []uint64{3, 4, 6} {
+		r.Equal(HandleMergeStake, events[i].Type)
+		r.Equal(index, events[i].BucketIndex)
+		r.True(address.Equal(voter, events[i].Voter))
+		r.True(address.Equal(cand, events[i].Candidate))
+	}
+
+	// malformed log
+	rLog = newReceiptLog(stakingProtocolAddr(), HandleSplitStake, true)
+	rLog.AddTopics(byteutil.Uint64ToBytesBigEndian(3), prevCand.Bytes(), cand.Bytes())
+	_, err = bucketEventsFromLog(split, voter, rLog.Build(ctx, nil))
+	r.Error(err)
+}
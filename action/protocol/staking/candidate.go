@@ -21,16 +21,21 @@ import (
 	"github.com/iotexproject/iotex-core/state"
 )
 
+// MaxRewardDistributionRatio is the reward distribution ratio to share the whole epoch reward with voters, the ratio
+// is measured in basis points
+const MaxRewardDistributionRatio = 10000
+
 type (
 	// Candidate represents the candidate
 	Candidate struct {
-		Owner              address.Address
-		Operator           address.Address
-		Reward             address.Address
-		Name               string
-		Votes              *big.Int
-		SelfStakeBucketIdx uint64
-		SelfStake          *big.Int
+		Owner                   address.Address
+		Operator                address.Address
+		Reward                  address.Address
+		Name                    string
+		Votes                   *big.Int
+		SelfStakeBucketIdx      uint64
+		SelfStake               *big.Int
+		RewardDistributionRatio uint32
 	}
 
 	// CandidateList is a list of candidates which is sortable
@@ -46,13 +51,14 @@ type (
 // Clone returns a copy
 func (d *Candidate) Clone() *Candidate {
 	return &Candidate{
-		Owner:              d.Owner,
-		Operator:           d.Operator,
-		Reward:             d.Reward,
-		Name:               d.Name,
-		Votes:              new(big.Int).Set(d.Votes),
-		SelfStakeBucketIdx: d.SelfStakeBucketIdx,
-		SelfStake:          new(big.Int).Set(d.SelfStake),
+		Owner:                   d.Owner,
+		Operator:                d.Operator,
+		Reward:                  d.Reward,
+		Name:                    d.Name,
+		Votes:                   new(big.Int).Set(d.Votes),
+		SelfStakeBucketIdx:      d.SelfStakeBucketIdx,
+		SelfStake:               new(big.Int).Set(d.SelfStake),
+		RewardDistributionRatio: d.RewardDistributionRatio,
 	}
 }
 
@@ -60,6 +66,7 @@ func (d *Candidate) Clone() *Candidate {
 func (d *Candidate) Equal(c *Candidate) bool {
 	return d.Name == c.Name &&
 		d.SelfStakeBucketIdx == c.SelfStakeBucketIdx &&
+		d.RewardDistributionRatio == c.RewardDistributionRatio &&
 		address.Equal(d.Owner, c.Owner) &&
 		address.Equal(d.Operator, c.Operator) &&
 		address.Equal(d.Reward, c.Reward) &&
@@ -92,6 +99,10 @@ func (d *Candidate) Validate() error {
 	if d.SelfStake == nil {
 		return ErrInvalidAmount
 	}
+
+	if d.RewardDistributionRatio > MaxRewardDistributionRatio {
+		return ErrInvalidRewardDistributionRatio
+	}
 	return nil
 }
 
@@ -181,13 +192,14 @@ func (d *Candidate) toProto() (*stakingpb.Candidate, error) {
 	}
 
 	return &stakingpb.Candidate{
-		OwnerAddress:       d.Owner.String(),
-		OperatorAddress:    d.Operator.String(),
-		RewardAddress:      d.Reward.String(),
-		Name:               d.Name,
-		Votes:              d.Votes.String(),
-		SelfStakeBucketIdx: d.SelfStakeBucketIdx,
-		SelfStake:          d.SelfStake.String(),
+		OwnerAddress:            d.Owner.String(),
+		OperatorAddress:         d.Operator.String(),
+		RewardAddress:           d.Reward.String(),
+		Name:                    d.Name,
+		Votes:                   d.Votes.String(),
+		SelfStakeBucketIdx:      d.SelfStakeBucketIdx,
+		SelfStake:               d.SelfStake.String(),
+		RewardDistributionRatio: d.RewardDistributionRatio,
 	}, nil
 }
 
@@ -224,6 +236,7 @@ func (d *Candidate) fromProto(pb *stakingpb.Candidate) error {
 	if !ok {
 		return ErrInvalidAmount
 	}
+	d.RewardDistributionRatio = pb.GetRewardDistributionRatio()
 	return nil
 }
 
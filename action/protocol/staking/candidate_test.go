@@ -43,13 +43,14 @@ func TestSer(t *testing.T) {
 			SelfStake:          big.NewInt(2100000000),
 		},
 		&Candidate{
-			Owner:              identityset.Address(7),
-			Operator:           identityset.Address(8),
-			Reward:             identityset.Address(9),
-			Name:               "testname3",
-			Votes:              big.NewInt(3000),
-			SelfStakeBucketIdx: 2,
-			SelfStake:          big.NewInt(3100000000),
+			Owner:                   identityset.Address(7),
+			Operator:                identityset.Address(8),
+			Reward:                  identityset.Address(9),
+			Name:                    "testname3",
+			Votes:                   big.NewInt(3000),
+			SelfStakeBucketIdx:      2,
+			SelfStake:               big.NewInt(3100000000),
+			RewardDistributionRatio: 2500,
 		},
 	}
 
@@ -73,9 +74,15 @@ func TestClone(t *testing.T) {
 		SelfStake:          big.NewInt(2100000000),
 	}
 	r.NoError(d.Validate())
+	d.RewardDistributionRatio = MaxRewardDistributionRatio + 1
+	r.Equal(ErrInvalidRewardDistributionRatio, d.Validate())
+	d.RewardDistributionRatio = MaxRewardDistributionRatio
 
 	d2 := d.Clone()
 	r.True(d.Equal(d2))
+	d2.RewardDistributionRatio--
+	r.False(d.Equal(d2))
+	d2.RewardDistributionRatio++
 	d.AddVote(big.NewInt(100))
 	r.False(d.Equal(d2))
 	r.NoError(d.Collision(d2))
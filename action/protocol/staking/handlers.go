@@ -35,6 +35,8 @@ const (
 	HandleRestake           = "restake"
 	HandleCandidateRegister = "candidateRegister"
 	HandleCandidateUpdate   = "candidateUpdate"
+	HandleSplitStake        = "splitStake"
+	HandleMergeStake        = "mergeStake"
 )
 
 const _withdrawWaitingTime = 14 * 24 * time.Hour // to maintain backward compatibility with r0.11 code
@@ -297,7 +299,14 @@ func (p *Protocol) handleChangeCandidate(ctx context.Context, act *action.Change
 
 	bucket, fetchErr := p.fetchBucket(csm, actionCtx.Caller, act.BucketIndex(), true, false)
 	if fetchErr != nil {
-		return log, fetchErr
+		// check whether the payload contains a valid staking authorization of the bucket owner
+		if fetchErr.ReceiptStatus() != uint64(iotextypes.ReceiptStatus_ErrUnauthorizedOperator) ||
+			!p.handleStakingAuthorization(actionCtx, blkCtx, act, act.Payload(), bucket) {
+			return log, fetchErr
+		}
+		if bucket, fetchErr = p.fetchBucket(csm, bucket.Owner, act.BucketIndex(), true, false); fetchErr != nil {
+			return log, fetchErr
+		}
 	}
 	log.AddTopics(byteutil.Uint64ToBytesBigEndian(bucket.Index), bucket.Candidate.Bytes(), candidate.Owner.Bytes())
 
@@ -430,13 +439,57 @@ func (p *Protocol) handleConsignmentTransfer(
 		con.TransfereeNonce() == actCtx.Nonce
 }
 
+func (p *Protocol) handleStakingAuthorization(
+	actCtx protocol.ActionCtx,
+	blkCtx protocol.BlockCtx,
+	act action.Action,
+	payload []byte,
+	bucket *VoteBucket) bool {
+	if p.hu.IsPre(config.Iceland, blkCtx.BlockHeight) || len(payload) == 0 {
+		return false
+	}
+
+	auth, err := action.NewStakingAuthorization(payload)
+	if err != nil {
+		return false
+	}
+
+	// a staking authorization is valid if:
+	// (1) signer owns the bucket
+	// (2) designated operator matches the action caller
+	// (3) designated asset ID matches bucket index
+	// (4) nonce matches the action caller's nonce
+	// (5) the authorization has not expired
+	// (6) designated operation and parameters match the action
+	return address.Equal(auth.Authorizer(), bucket.Owner) &&
+		address.Equal(auth.Operator(), actCtx.Caller) &&
+		auth.AssetID() == bucket.Index &&
+		auth.OperatorNonce() == actCtx.Nonce &&
+		blkCtx.BlockHeight <= auth.Expiry() &&
+		auth.Authorizes(act)
+}
+
 func (p *Protocol) handleDepositToStake(ctx context.Context, act *action.DepositToStake, csm CandidateStateManager,
 ) (*receiptLog, []*action.TransactionLog, error) {
 	actionCtx := protocol.MustGetActionCtx(ctx)
 	blkCtx := protocol.MustGetBlockCtx(ctx)
 	log := newReceiptLog(p.addr.String(), HandleDepositToStake, blkCtx.BlockHeight >= p.hu.FbkMigrationBlockHeight())
 
-	depositor, fetchErr := fetchCaller(ctx, csm, act.Amount())
+	// the deposit is funded by the bucket owner if the payload contains a valid staking authorization
+	depositorAddr := actionCtx.Caller
+	if bucket, err := getBucket(csm, act.BucketIndex()); err == nil &&
+		p.handleStakingAuthorization(actionCtx, blkCtx, act, act.Payload(), bucket) {
+		depositorAddr = bucket.Owner
+	}
+	var (
+		depositor *state.Account
+		fetchErr  ReceiptError
+	)
+	if address.Equal(depositorAddr, actionCtx.Caller) {
+		depositor, fetchErr = fetchCaller(ctx, csm, act.Amount())
+	} else if _, fetchErr = fetchCaller(ctx, csm, big.NewInt(0)); fetchErr == nil {
+		depositor, fetchErr = fetchAuthorizer(csm, depositorAddr, act.Amount())
+	}
 	if fetchErr != nil {
 		return log, nil, fetchErr
 	}
@@ -508,20 +561,20 @@ func (p *Protocol) handleDepositToStake(ctx context.Context, act *action.Deposit
 	// update depositor balance
 	if err := depositor.SubBalance(act.Amount()); err != nil {
 		return log, nil, &handleError{
-			err:           errors.Wrapf(err, "failed to update the balance of depositor %s", actionCtx.Caller.String()),
+			err:           errors.Wrapf(err, "failed to update the balance of depositor %s", depositorAddr.String()),
 			failureStatus: iotextypes.ReceiptStatus_ErrNotEnoughBalance,
 		}
 	}
 	// put updated depositor's account state to trie
-	if err := accountutil.StoreAccount(csm, actionCtx.Caller, depositor); err != nil {
-		return log, nil, errors.Wrapf(err, "failed to store account %s", actionCtx.Caller.String())
+	if err := accountutil.StoreAccount(csm, depositorAddr, depositor); err != nil {
+		return log, nil, errors.Wrapf(err, "failed to store account %s", depositorAddr.String())
 	}
 	log.AddAddress(actionCtx.Caller)
 
 	return log, []*action.TransactionLog{
 		{
 			Type:      iotextypes.TransactionLogType_DEPOSIT_TO_BUCKET,
-			Sender:    actionCtx.Caller.String(),
+			Sender:    depositorAddr.String(),
 			Recipient: address.StakingBucketPoolAddr,
 			Amount:    act.Amount(),
 		},
@@ -541,7 +594,11 @@ func (p *Protocol) handleRestake(ctx context.Context, act *action.Restake, csm C
 
 	bucket, fetchErr := p.fetchBucket(csm, actionCtx.Caller, act.BucketIndex(), true, true)
 	if fetchErr != nil {
-		return log, fetchErr
+		// check whether the payload contains a valid staking authorization of the bucket owner
+		if fetchErr.ReceiptStatus() != uint64(iotextypes.ReceiptStatus_ErrUnauthorizedOperator) ||
+			!p.handleStakingAuthorization(actionCtx, blkCtx, act, act.Payload(), bucket) {
+			return log, fetchErr
+		}
 	}
 	log.AddTopics(byteutil.Uint64ToBytesBigEndian(bucket.Index), bucket.Candidate.Bytes())
 
@@ -605,6 +662,194 @@ func (p *Protocol) handleRestake(ctx context.Context, act *action.Restake, csm C
 	return log, nil
 }
 
+func (p *Protocol) handleSplitStake(ctx context.Context, act *action.SplitStake, csm CandidateStateManager,
+) (*receiptLog, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	log := newReceiptLog(p.addr.String(), HandleSplitStake, blkCtx.BlockHeight >= p.hu.FbkMigrationBlockHeight())
+
+	_, fetchErr := fetchCaller(ctx, csm, big.NewInt(0))
+	if fetchErr != nil {
+		return log, fetchErr
+	}
+
+	candidate := csm.GetByName(act.Candidate())
+	if candidate == nil {
+		return log, errCandNotExist
+	}
+
+	bucket, fetchErr := p.fetchBucket(csm, actionCtx.Caller, act.BucketIndex(), true, false)
+	if fetchErr != nil {
+		return log, fetchErr
+	}
+
+	prevCandidate := csm.GetByOwner(bucket.Candidate)
+	if prevCandidate == nil {
+		return log, errCandNotExist
+	}
+
+	if bucket.isUnstaked() {
+		return log, &handleError{
+			err:           errors.New("split an unstaked bucket not allowed"),
+			failureStatus: iotextypes.ReceiptStatus_ErrInvalidBucketType,
+		}
+	}
+
+	// both buckets after split have to meet the minimum stake amount
+	amount := act.Amount()
+	remaining := new(big.Int).Sub(bucket.StakedAmount, amount)
+	if amount.Cmp(p.config.MinStakeAmount) == -1 || remaining.Cmp(p.config.MinStakeAmount) == -1 {
+		return log, &handleError{
+			err:           errors.Errorf("failed to split %s out of bucket %d", amount, bucket.Index),
+			failureStatus: iotextypes.ReceiptStatus_ErrInvalidBucketAmount,
+		}
+	}
+
+	prevWeightedVotes := p.calculateVoteWeight(bucket, false)
+	// update bucket
+	bucket.StakedAmount = remaining
+	if err := updateBucket(csm, act.BucketIndex(), bucket); err != nil {
+		return log, errors.Wrapf(err, "failed to update bucket for voter %s", bucket.Owner.String())
+	}
+
+	// create the new bucket with the same duration, stake start time and auto-stake flag
+	newBucket := NewVoteBucket(candidate.Owner, bucket.Owner, amount, 0, blkCtx.BlockTimeStamp, bucket.AutoStake)
+	newBucket.StakedDuration = bucket.StakedDuration
+	newBucket.StakeStartTime = bucket.StakeStartTime
+	newIndex, err := putBucketAndIndex(csm, newBucket)
+	if err != nil {
+		return log, err
+	}
+	log.AddTopics(byteutil.Uint64ToBytesBigEndian(bucket.Index), byteutil.Uint64ToBytesBigEndian(newIndex), bucket.Candidate.Bytes(), candidate.Owner.Bytes())
+
+	// update candidates
+	if err := prevCandidate.SubVote(prevWeightedVotes); err != nil {
+		return log, &handleError{
+			err:           errors.Wrapf(err, "failed to subtract vote for candidate %s", prevCandidate.Owner.String()),
+			failureStatus: iotextypes.ReceiptStatus_ErrNotEnoughBalance,
+		}
+	}
+	if err := prevCandidate.AddVote(p.calculateVoteWeight(bucket, false)); err != nil {
+		return log, &handleError{
+			err:           errors.Wrapf(err, "failed to add vote for candidate %s", prevCandidate.Owner.String()),
+			failureStatus: iotextypes.ReceiptStatus_ErrInvalidBucketAmount,
+		}
+	}
+	if address.Equal(prevCandidate.Owner, candidate.Owner) {
+		candidate = prevCandidate
+	} else if err := csm.Upsert(prevCandidate); err != nil {
+		return log, csmErrorToHandleError(prevCandidate.Owner.String(), err)
+	}
+	if err := candidate.AddVote(p.calculateVoteWeight(newBucket, false)); err != nil {
+		return log, &handleError{
+			err:           errors.Wrapf(err, "failed to add vote for candidate %s", candidate.Owner.String()),
+			failureStatus: iotextypes.ReceiptStatus_ErrInvalidBucketAmount,
+		}
+	}
+	if err := csm.Upsert(candidate); err != nil {
+		return log, csmErrorToHandleError(candidate.Owner.String(), err)
+	}
+
+	// update bucket pool, the amount stays the same while the count of buckets increases
+	if err := csm.DebitBucketPool(big.NewInt(0), true); err != nil {
+		return log, &handleError{
+			err:           errors.Wrapf(err, "failed to update staking bucket pool %s", err.Error()),
+			failureStatus: iotextypes.ReceiptStatus_ErrWriteAccount,
+		}
+	}
+
+	log.AddAddress(actionCtx.Caller)
+	return log, nil
+}
+
+func (p *Protocol) handleMergeStake(ctx context.Context, act *action.MergeStake, csm CandidateStateManager,
+) (*receiptLog, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	log := newReceiptLog(p.addr.String(), HandleMergeStake, blkCtx.BlockHeight >= p.hu.FbkMigrationBlockHeight())
+
+	_, fetchErr := fetchCaller(ctx, csm, big.NewInt(0))
+	if fetchErr != nil {
+		return log, fetchErr
+	}
+
+	bucket, fetchErr := p.fetchBucket(csm, actionCtx.Caller, act.BucketIndex(), true, false)
+	if fetchErr != nil {
+		return log, fetchErr
+	}
+	if bucket.isUnstaked() {
+		return log, &handleError{
+			err:           errors.New("merge into an unstaked bucket not allowed"),
+			failureStatus: iotextypes.ReceiptStatus_ErrInvalidBucketType,
+		}
+	}
+
+	candidate := csm.GetByOwner(bucket.Candidate)
+	if candidate == nil {
+		return log, errCandNotExist
+	}
+
+	topics := [][]byte{byteutil.Uint64ToBytesBigEndian(bucket.Index)}
+	prevWeightedVotes := p.calculateVoteWeight(bucket, false)
+	for _, index := range act.MergedBuckets() {
+		merged, fetchErr := p.fetchBucket(csm, actionCtx.Caller, index, true, false)
+		if fetchErr != nil {
+			return log, fetchErr
+		}
+		if merged.isUnstaked() ||
+			!address.Equal(merged.Candidate, bucket.Candidate) ||
+			merged.StakedDuration != bucket.StakedDuration ||
+			merged.AutoStake != bucket.AutoStake {
+			return log, &handleError{
+				err:           errors.Errorf("bucket %d cannot be merged into bucket %d", index, bucket.Index),
+				failureStatus: iotextypes.ReceiptStatus_ErrInvalidBucketType,
+			}
+		}
+		prevWeightedVotes.Add(prevWeightedVotes, p.calculateVoteWeight(merged, false))
+
+		// the merged bucket matures no earlier than any of the buckets
+		bucket.StakedAmount.Add(bucket.StakedAmount, merged.StakedAmount)
+		if merged.StakeStartTime.After(bucket.StakeStartTime) {
+			bucket.StakeStartTime = merged.StakeStartTime
+		}
+		if err := delBucketAndIndex(csm, merged.Owner, merged.Candidate, index); err != nil {
+			return log, errors.Wrapf(err, "failed to delete bucket %d", index)
+		}
+		// update bucket pool, the amount stays the same while the count of buckets decreases
+		if err := csm.CreditBucketPool(big.NewInt(0)); err != nil {
+			return log, &handleError{
+				err:           errors.Wrapf(err, "failed to update staking bucket pool %s", err.Error()),
+				failureStatus: iotextypes.ReceiptStatus_ErrWriteAccount,
+			}
+		}
+		topics = append(topics, byteutil.Uint64ToBytesBigEndian(index))
+	}
+	if err := updateBucket(csm, act.BucketIndex(), bucket); err != nil {
+		return log, errors.Wrapf(err, "failed to update bucket for voter %s", bucket.Owner.String())
+	}
+	log.AddTopics(append(topics, bucket.Candidate.Bytes())...)
+
+	// update candidate
+	if err := candidate.SubVote(prevWeightedVotes); err != nil {
+		return log, &handleError{
+			err:           errors.Wrapf(err, "failed to subtract vote for candidate %s", bucket.Candidate.String()),
+			failureStatus: iotextypes.ReceiptStatus_ErrNotEnoughBalance,
+		}
+	}
+	if err := candidate.AddVote(p.calculateVoteWeight(bucket, false)); err != nil {
+		return log, &handleError{
+			err:           errors.Wrapf(err, "failed to add vote for candidate %s", candidate.Owner.String()),
+			failureStatus: iotextypes.ReceiptStatus_ErrInvalidBucketAmount,
+		}
+	}
+	if err := csm.Upsert(candidate); err != nil {
+		return log, csmErrorToHandleError(candidate.Owner.String(), err)
+	}
+
+	log.AddAddress(actionCtx.Caller)
+	return log, nil
+}
+
 func (p *Protocol) handleCandidateRegister(ctx context.Context, act *action.CandidateRegister, csm CandidateStateManager,
 ) (*receiptLog, []*action.TransactionLog, error) {
 	actCtx := protocol.MustGetActionCtx(ctx)
@@ -656,13 +901,14 @@ func (p *Protocol) handleCandidateRegister(ctx context.Context, act *action.Cand
 	log.AddTopics(byteutil.Uint64ToBytesBigEndian(bucketIdx), owner.Bytes())
 
 	c = &Candidate{
-		Owner:              owner,
-		Operator:           act.OperatorAddress(),
-		Reward:             act.RewardAddress(),
-		Name:               act.Name(),
-		Votes:              p.calculateVoteWeight(bucket, true),
-		SelfStakeBucketIdx: bucketIdx,
-		SelfStake:          act.Amount(),
+		Owner:                   owner,
+		Operator:                act.OperatorAddress(),
+		Reward:                  act.RewardAddress(),
+		Name:                    act.Name(),
+		Votes:                   p.calculateVoteWeight(bucket, true),
+		SelfStakeBucketIdx:      bucketIdx,
+		SelfStake:               act.Amount(),
+		RewardDistributionRatio: act.RewardDistributionRatio(),
 	}
 
 	if err := csm.Upsert(c); err != nil {
@@ -742,6 +988,10 @@ func (p *Protocol) handleCandidateUpdate(ctx context.Context, act *action.Candid
 	if act.RewardAddress() != nil {
 		c.Reward = act.RewardAddress()
 	}
+
+	if ratio, ok := act.RewardDistributionRatio(); ok {
+		c.RewardDistributionRatio = ratio
+	}
 	log.AddTopics(c.Owner.Bytes())
 
 	if err := csm.Upsert(c); err != nil {
@@ -841,6 +1091,24 @@ func fetchCaller(ctx context.Context, sr protocol.StateReader, amount *big.Int)
 	return caller, nil
 }
 
+func fetchAuthorizer(sr protocol.StateReader, authorizer address.Address, amount *big.Int) (*state.Account, ReceiptError) {
+	acct, err := accountutil.LoadAccount(sr, hash.BytesToHash160(authorizer.Bytes()))
+	if err != nil {
+		return nil, &handleError{
+			err:           errors.Wrapf(err, "failed to load the account of authorizer %s", authorizer.String()),
+			failureStatus: iotextypes.ReceiptStatus_Failure,
+		}
+	}
+	// check authorizer's balance
+	if amount.Cmp(acct.Balance) == 1 {
+		return nil, &handleError{
+			err:           errors.Wrapf(state.ErrNotEnoughBalance, "authorizer %s balance not enough", authorizer.String()),
+			failureStatus: iotextypes.ReceiptStatus_ErrNotEnoughBalance,
+		}
+	}
+	return acct, nil
+}
+
 func csmErrorToHandleError(caller string, err error) error {
 	hErr := &handleError{
 		err: errors.Wrapf(err, "failed to put candidate %s", caller),
@@ -886,7 +1154,8 @@ func BucketIndexFromReceiptLog(log *iotextypes.Log) (uint64, bool) {
 	case hash.BytesToHash256([]byte(HandleCreateStake)), hash.BytesToHash256([]byte(HandleUnstake)),
 		hash.BytesToHash256([]byte(HandleWithdrawStake)), hash.BytesToHash256([]byte(HandleChangeCandidate)),
 		hash.BytesToHash256([]byte(HandleTransferStake)), hash.BytesToHash256([]byte(HandleDepositToStake)),
-		hash.BytesToHash256([]byte(HandleRestake)), hash.BytesToHash256([]byte(HandleCandidateRegister)):
+		hash.BytesToHash256([]byte(HandleRestake)), hash.BytesToHash256([]byte(HandleCandidateRegister)),
+		hash.BytesToHash256([]byte(HandleSplitStake)), hash.BytesToHash256([]byte(HandleMergeStake)):
 		return byteutil.BytesToUint64BigEndian(log.Topics[1][24:]), true
 	default:
 		return 0, false
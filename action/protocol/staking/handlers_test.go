@@ -28,6 +28,7 @@ import (
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/pkg/unit"
+	"github.com/iotexproject/iotex-core/state"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/testutil/testdb"
 )
@@ -70,7 +71,7 @@ func TestProtocol_HandleCreateStake(t *testing.T) {
 	require.NoError(err)
 
 	// create protocol
-	p, err := NewProtocol(depositGas, genesis.Default.Staking, nil, genesis.Default.GreenlandBlockHeight)
+	p, err := NewProtocol(depositGas, genesis.Default.Staking, nil, nil, genesis.Default.GreenlandBlockHeight)
 	require.NoError(err)
 
 	// set up candidate
@@ -909,6 +910,90 @@ func TestProtocol_HandleCandidateUpdate(t *testing.T) {
 	}
 }
 
+func TestProtocol_HandleRewardDistributionRatio(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		caller   = identityset.Address(27)
+		height   = genesis.Default.IcelandBlockHeight
+		gasLimit = uint64(1000000)
+		gasPrice = big.NewInt(1)
+	)
+	newCtx := func(act action.Action, nonce, blkHeight uint64) context.Context {
+		intrinsic, err := act.(interface{ IntrinsicGas() (uint64, error) }).IntrinsicGas()
+		require.NoError(err)
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       caller,
+			GasPrice:     gasPrice,
+			IntrinsicGas: intrinsic,
+			Nonce:        nonce,
+		})
+		return protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight:    blkHeight,
+			BlockTimeStamp: time.Now(),
+			GasLimit:       gasLimit,
+		})
+	}
+
+	tests := []struct {
+		blkHeight   uint64
+		ratio       uint32
+		updateRatio uint32
+		err         error
+	}{
+		// ratio above the maximum
+		{height, MaxRewardDistributionRatio + 1, 0, ErrInvalidRewardDistributionRatio},
+		// ratio cannot be set before Iceland
+		{height - 1, 1000, 0, ErrInvalidRewardDistributionRatio},
+		// success
+		{height, 1000, 0, nil},
+		{height, 0, 2500, nil},
+	}
+	for i, test := range tests {
+		sm, p, _, _ := initAll(t, ctrl)
+		require.NoError(setupAccount(sm, caller, 1201000))
+		cr, err := action.NewCandidateRegister(1, "test", identityset.Address(28).String(), identityset.Address(29).String(), "", "1200000000000000000000000", 10000, false, nil, gasLimit, gasPrice)
+		require.NoError(err)
+		cr.SetRewardDistributionRatio(test.ratio)
+		ctx := newCtx(cr, 1, test.blkHeight)
+		require.Equal(test.err, errors.Cause(p.Validate(ctx, cr, sm)), "case %d", i)
+		if test.err != nil {
+			continue
+		}
+		r, err := p.Handle(ctx, cr, sm)
+		require.NoError(err)
+		require.Equal(uint64(iotextypes.ReceiptStatus_Success), r.Status)
+		candidate, _, err := getCandidate(sm, caller)
+		require.NoError(err)
+		require.Equal(test.ratio, candidate.RewardDistributionRatio)
+
+		// the ratio is kept if the update does not set it
+		cu, err := action.NewCandidateUpdate(2, "test", "", "", gasLimit, gasPrice)
+		require.NoError(err)
+		ctx = newCtx(cu, 2, test.blkHeight)
+		require.NoError(p.Validate(ctx, cu, sm))
+		r, err = p.Handle(ctx, cu, sm)
+		require.NoError(err)
+		require.Equal(uint64(iotextypes.ReceiptStatus_Success), r.Status)
+		candidate, _, err = getCandidate(sm, caller)
+		require.NoError(err)
+		require.Equal(test.ratio, candidate.RewardDistributionRatio)
+
+		cu.SetRewardDistributionRatio(test.updateRatio)
+		ctx = newCtx(cu, 3, test.blkHeight)
+		require.NoError(p.Validate(ctx, cu, sm))
+		require.Equal(ErrInvalidRewardDistributionRatio, errors.Cause(p.Validate(newCtx(cu, 3, height-1), cu, sm)))
+		r, err = p.Handle(ctx, cu, sm)
+		require.NoError(err)
+		require.Equal(uint64(iotextypes.ReceiptStatus_Success), r.Status)
+		csm, err := NewCandidateStateManager(sm, false)
+		require.NoError(err)
+		require.Equal(test.updateRatio, csm.GetByOwner(caller).RewardDistributionRatio)
+	}
+}
+
 func TestProtocol_HandleUnstake(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
@@ -2052,6 +2137,332 @@ func TestProtocol_HandleConsignmentTransfer(t *testing.T) {
 	}
 }
 
+func TestProtocol_HandleStakingAuthorization(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		owner    = identityset.Address(32)
+		ownerKey = identityset.PrivateKey(32)
+		operator = identityset.Address(1)
+		height   = genesis.Default.IcelandBlockHeight
+		gasPrice = big.NewInt(unit.Qev)
+		gasLimit = uint64(10000)
+		restake  = func(payload []byte) action.Action {
+			act, err := action.NewRestake(1, 0, 2, true, payload, gasLimit, gasPrice)
+			require.NoError(err)
+			return act
+		}
+		deposit = func(payload []byte) action.Action {
+			act, err := action.NewDepositToStake(1, 0, "10000000000000000000", payload, gasLimit, gasPrice)
+			require.NoError(err)
+			return act
+		}
+		changeCandidate = func(payload []byte) action.Action {
+			act, err := action.NewChangeCandidate(1, "test1", 0, payload, gasLimit, gasPrice)
+			require.NoError(err)
+			return act
+		}
+	)
+
+	tests := []struct {
+		newAction func([]byte) action.Action
+		blkHeight uint64
+		// authorization fields
+		signer    crypto.PrivateKey
+		operator  address.Address
+		authorize action.Action
+		nonce     uint64
+		expiry    uint64
+		status    iotextypes.ReceiptStatus
+	}{
+		// before Iceland
+		{restake, height - 1, ownerKey, operator, restake(nil), 1, height, iotextypes.ReceiptStatus_ErrUnauthorizedOperator},
+		// signer is not bucket owner
+		{restake, height, identityset.PrivateKey(31), operator, restake(nil), 1, height, iotextypes.ReceiptStatus_ErrUnauthorizedOperator},
+		// operator is not action caller
+		{restake, height, ownerKey, identityset.Address(3), restake(nil), 1, height, iotextypes.ReceiptStatus_ErrUnauthorizedOperator},
+		// operator nonce does not match
+		{restake, height, ownerKey, operator, restake(nil), 2, height, iotextypes.ReceiptStatus_ErrUnauthorizedOperator},
+		// authorization expired
+		{restake, height, ownerKey, operator, restake(nil), 1, height - 1, iotextypes.ReceiptStatus_ErrUnauthorizedOperator},
+		// operation does not match
+		{restake, height, ownerKey, operator, changeCandidate(nil), 1, height, iotextypes.ReceiptStatus_ErrUnauthorizedOperator},
+		// parameters do not match
+		{changeCandidate, height, ownerKey, operator, func() action.Action {
+			act, err := action.NewChangeCandidate(1, "test2", 0, nil, gasLimit, gasPrice)
+			require.NoError(err)
+			return act
+		}(), 1, height, iotextypes.ReceiptStatus_ErrUnauthorizedOperator},
+		// success
+		{restake, height, ownerKey, operator, restake(nil), 1, height, iotextypes.ReceiptStatus_Success},
+		{changeCandidate, height, ownerKey, operator, changeCandidate(nil), 1, height + 1, iotextypes.ReceiptStatus_Success},
+		{deposit, height, ownerKey, operator, deposit(nil), 1, height, iotextypes.ReceiptStatus_Success},
+	}
+	for i, test := range tests {
+		sm, p, cand1, cand2 := initAll(t, ctrl)
+		initBalance := int64(1000)
+		require.NoError(setupAccount(sm, operator, initBalance))
+		initCreateStake(t, sm, owner, initBalance, gasPrice, gasLimit, 1, test.blkHeight, time.Now(), gasLimit, p, cand2, "100000000000000000000", true)
+		ownerAcct, err := accountutil.LoadAccount(sm, hash.BytesToHash160(owner.Bytes()))
+		require.NoError(err)
+		ownerBalance := ownerAcct.Balance
+
+		msg, err := action.NewAuthorizeMsg("Ethereum", test.operator.String(), test.authorize, test.nonce, test.expiry)
+		require.NoError(err)
+		h, err := action.MsgHash("Ethereum", msg)
+		require.NoError(err)
+		sig, err := test.signer.Sign(h)
+		require.NoError(err)
+		payload, err := action.NewAuthorizeJSON("Ethereum", test.operator.String(), hex.EncodeToString(sig), test.authorize, test.nonce, test.expiry)
+		require.NoError(err)
+
+		act := test.newAction(payload)
+		intrinsic, err := act.(interface{ IntrinsicGas() (uint64, error) }).IntrinsicGas()
+		require.NoError(err)
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       operator,
+			GasPrice:     gasPrice,
+			IntrinsicGas: intrinsic,
+			Nonce:        1,
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight:    test.blkHeight,
+			BlockTimeStamp: time.Now(),
+			GasLimit:       gasLimit,
+		})
+		r, err := p.Handle(ctx, act, sm)
+		require.NoError(err)
+		require.Equal(uint64(test.status), r.Status, "case %d", i)
+		if test.status != iotextypes.ReceiptStatus_Success {
+			continue
+		}
+
+		bucket, err := getBucket(sm, 0)
+		require.NoError(err)
+		require.Equal(owner, bucket.Owner)
+		switch act.(type) {
+		case *action.Restake:
+			require.Equal(2*24*time.Hour, bucket.StakedDuration)
+		case *action.ChangeCandidate:
+			require.Equal(cand1.Owner, bucket.Candidate)
+		case *action.DepositToStake:
+			// the deposit is funded by the bucket owner
+			require.Equal("110000000000000000000", bucket.StakedAmount.String())
+			ownerAcct, err = accountutil.LoadAccount(sm, hash.BytesToHash160(owner.Bytes()))
+			require.NoError(err)
+			require.Equal(new(big.Int).Sub(ownerBalance, unit.ConvertIotxToRau(10)), ownerAcct.Balance)
+			operatorAcct, err := accountutil.LoadAccount(sm, hash.BytesToHash160(operator.Bytes()))
+			require.NoError(err)
+			require.Equal(1, unit.ConvertIotxToRau(initBalance).Cmp(operatorAcct.Balance))
+			require.Equal(-1, new(big.Int).Sub(unit.ConvertIotxToRau(initBalance), unit.ConvertIotxToRau(1)).Cmp(operatorAcct.Balance))
+		}
+	}
+}
+
+func TestProtocol_HandleSplitStake(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		owner    = identityset.Address(32)
+		height   = genesis.Default.IcelandBlockHeight
+		gasPrice = big.NewInt(unit.Qev)
+		gasLimit = uint64(10000)
+	)
+
+	tests := []struct {
+		blkHeight uint64
+		staked    string
+		amount    string
+		candName  string
+		err       error
+		status    iotextypes.ReceiptStatus
+	}{
+		// split amount less than minimum
+		{height, "300000000000000000000", "10000000000000000000", "test1", ErrInvalidAmount, iotextypes.ReceiptStatus_Success},
+		// remaining amount less than minimum
+		{height, "150000000000000000000", "100000000000000000000", "test1", nil, iotextypes.ReceiptStatus_ErrInvalidBucketAmount},
+		// candidate does not exist
+		{height, "300000000000000000000", "100000000000000000000", "test3", nil, iotextypes.ReceiptStatus_ErrCandidateNotExist},
+		// not enabled before Iceland
+		{height - 1, "300000000000000000000", "100000000000000000000", "test1", ErrBucketOpNotEnabled, iotextypes.ReceiptStatus_Success},
+		// success
+		{height, "300000000000000000000", "100000000000000000000", "test1", nil, iotextypes.ReceiptStatus_Success},
+		{height, "300000000000000000000", "100000000000000000000", "test2", nil, iotextypes.ReceiptStatus_Success},
+	}
+	for i, test := range tests {
+		sm, p, cand1, cand2 := initAll(t, ctrl)
+		// bucket 1 and 2 are the self-staking buckets of the candidates
+		for j := uint64(1); j <= 3; j++ {
+			initCreateStake(t, sm, identityset.Address(33), 1000, gasPrice, gasLimit, j, test.blkHeight, time.Now(), gasLimit, p, cand1, "100000000000000000000", true)
+		}
+		initCreateStake(t, sm, owner, 1000, gasPrice, gasLimit, 1, test.blkHeight, time.Now(), gasLimit, p, cand2, test.staked, true)
+
+		act, err := action.NewSplitStake(2, test.candName, 3, test.amount, nil, gasLimit, gasPrice)
+		require.NoError(err)
+		// never enabled without a block in the context
+		require.Equal(ErrBucketOpNotEnabled, errors.Cause(p.Validate(context.Background(), act, sm)))
+		intrinsic, err := act.IntrinsicGas()
+		require.NoError(err)
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       owner,
+			GasPrice:     gasPrice,
+			IntrinsicGas: intrinsic,
+			Nonce:        2,
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight:    test.blkHeight,
+			BlockTimeStamp: time.Now(),
+			GasLimit:       gasLimit,
+		})
+		require.Equal(test.err, errors.Cause(p.Validate(ctx, act, sm)), "case %d", i)
+		if test.err != nil {
+			continue
+		}
+		r, err := p.Handle(ctx, act, sm)
+		require.NoError(err)
+		require.Equal(uint64(test.status), r.Status, "case %d", i)
+		if test.status != iotextypes.ReceiptStatus_Success {
+			continue
+		}
+
+		bucket, err := getBucket(sm, 3)
+		require.NoError(err)
+		newBucket, err := getBucket(sm, 4)
+		require.NoError(err)
+		require.Equal("200000000000000000000", bucket.StakedAmount.String())
+		require.Equal(cand2.Owner, bucket.Candidate)
+		require.Equal(test.amount, newBucket.StakedAmount.String())
+		require.Equal(owner, newBucket.Owner)
+		require.Equal(bucket.StakedDuration, newBucket.StakedDuration)
+		require.Equal(bucket.StakeStartTime, newBucket.StakeStartTime)
+		require.Equal(bucket.AutoStake, newBucket.AutoStake)
+
+		// the votes are the sum of the weights of both buckets
+		csm, err := NewCandidateStateManager(sm, false)
+		require.NoError(err)
+		otherVotes := new(big.Int)
+		for j := uint64(0); j < 3; j++ {
+			b, err := getBucket(sm, j)
+			require.NoError(err)
+			otherVotes.Add(otherVotes, p.calculateVoteWeight(b, false))
+		}
+		weight := new(big.Int).Add(p.calculateVoteWeight(bucket, false), p.calculateVoteWeight(newBucket, false))
+		if test.candName == cand1.Name {
+			require.Equal(cand1.Owner, newBucket.Candidate)
+			require.Equal(p.calculateVoteWeight(bucket, false), csm.GetByOwner(cand2.Owner).Votes)
+			require.Equal(otherVotes.Add(otherVotes, p.calculateVoteWeight(newBucket, false)), csm.GetByOwner(cand1.Owner).Votes)
+		} else {
+			require.Equal(cand2.Owner, newBucket.Candidate)
+			require.Equal(weight, csm.GetByOwner(cand2.Owner).Votes)
+		}
+		indices, _, err := getVoterBucketIndices(sm, owner)
+		require.NoError(err)
+		require.Equal(BucketIndices{3, 4}, *indices)
+	}
+}
+
+func TestProtocol_HandleMergeStake(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		owner    = identityset.Address(32)
+		height   = genesis.Default.IcelandBlockHeight
+		gasPrice = big.NewInt(unit.Qev)
+		gasLimit = uint64(10000)
+	)
+
+	tests := []struct {
+		blkHeight uint64
+		buckets   []uint64
+		err       error
+		status    iotextypes.ReceiptStatus
+	}{
+		// no bucket to merge
+		{height, []uint64{}, ErrInvalidMergedBuckets, iotextypes.ReceiptStatus_Success},
+		// duplicate buckets
+		{height, []uint64{4, 3}, ErrInvalidMergedBuckets, iotextypes.ReceiptStatus_Success},
+		{height, []uint64{4, 4}, ErrInvalidMergedBuckets, iotextypes.ReceiptStatus_Success},
+		// bucket has to be merged by its owner
+		{height, []uint64{0}, nil, iotextypes.ReceiptStatus_ErrUnauthorizedOperator},
+		// bucket votes for a different candidate
+		{height, []uint64{4, 6}, nil, iotextypes.ReceiptStatus_ErrInvalidBucketType},
+		// bucket does not exist
+		{height, []uint64{7}, nil, iotextypes.ReceiptStatus_ErrInvalidBucketIndex},
+		// not enabled before Iceland
+		{height - 1, []uint64{4, 5}, ErrBucketOpNotEnabled, iotextypes.ReceiptStatus_Success},
+		// success
+		{height, []uint64{4, 5}, nil, iotextypes.ReceiptStatus_Success},
+	}
+	for i, test := range tests {
+		sm, p, cand1, cand2 := initAll(t, ctrl)
+		// bucket 1 and 2 are the self-staking buckets of the candidates
+		for j := uint64(1); j <= 3; j++ {
+			initCreateStake(t, sm, identityset.Address(33), 1000, gasPrice, gasLimit, j, test.blkHeight, time.Now(), gasLimit, p, cand2, "100000000000000000000", true)
+		}
+		for j, cand := range []*Candidate{cand2, cand2, cand2, cand1} {
+			initCreateStake(t, sm, owner, 1000, gasPrice, gasLimit, uint64(j+1), test.blkHeight, time.Now(), gasLimit, p, cand, "100000000000000000000", true)
+		}
+
+		act, err := action.NewMergeStake(5, 3, test.buckets, nil, gasLimit, gasPrice)
+		require.NoError(err)
+		// never enabled without a block in the context
+		require.Equal(ErrBucketOpNotEnabled, errors.Cause(p.Validate(context.Background(), act, sm)))
+		intrinsic, err := act.IntrinsicGas()
+		require.NoError(err)
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       owner,
+			GasPrice:     gasPrice,
+			IntrinsicGas: intrinsic,
+			Nonce:        5,
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight:    test.blkHeight,
+			BlockTimeStamp: time.Now(),
+			GasLimit:       gasLimit,
+		})
+		require.Equal(test.err, errors.Cause(p.Validate(ctx, act, sm)), "case %d", i)
+		if test.err != nil {
+			continue
+		}
+		r, err := p.Handle(ctx, act, sm)
+		require.NoError(err)
+		require.Equal(uint64(test.status), r.Status, "case %d", i)
+		if test.status != iotextypes.ReceiptStatus_Success {
+			continue
+		}
+
+		bucket, err := getBucket(sm, 3)
+		require.NoError(err)
+		require.Equal(owner, bucket.Owner)
+		csm, err := NewCandidateStateManager(sm, false)
+		require.NoError(err)
+		require.Equal("300000000000000000000", bucket.StakedAmount.String())
+		for _, index := range test.buckets {
+			_, err = getBucket(sm, index)
+			require.Equal(state.ErrStateNotExist, errors.Cause(err))
+		}
+		indices, _, err := getVoterBucketIndices(sm, owner)
+		require.NoError(err)
+		require.Equal(BucketIndices{3, 6}, *indices)
+		indices, _, err = getCandBucketIndices(sm, cand2.Owner)
+		require.NoError(err)
+		require.Equal(BucketIndices{0, 1, 2, 3}, *indices)
+		votes := p.calculateVoteWeight(bucket, false)
+		for j := uint64(0); j < 3; j++ {
+			b, err := getBucket(sm, j)
+			require.NoError(err)
+			votes.Add(votes, p.calculateVoteWeight(b, false))
+		}
+		require.Equal(votes, csm.GetByOwner(cand2.Owner).Votes)
+	}
+}
+
 func TestProtocol_HandleRestake(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
@@ -2582,7 +2993,7 @@ func initAll(t *testing.T, ctrl *gomock.Controller) (protocol.StateManager, *Pro
 	require.NoError(err)
 
 	// create protocol
-	p, err := NewProtocol(depositGas, genesis.Default.Staking, nil, genesis.Default.GreenlandBlockHeight)
+	p, err := NewProtocol(depositGas, genesis.Default.Staking, nil, nil, genesis.Default.GreenlandBlockHeight)
 	require.NoError(err)
 
 	// set up candidate
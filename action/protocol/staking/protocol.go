@@ -71,6 +71,7 @@ type (
 		config             Configuration
 		hu                 config.HeightUpgrade
 		candBucketsIndexer *CandidatesBucketsIndexer
+		historyIndexer     *BucketHistoryIndexer
 		voteReviser        *VoteReviser
 	}
 
@@ -88,7 +89,13 @@ type (
 )
 
 // NewProtocol instantiates the protocol of staking
-func NewProtocol(depositGas DepositGas, cfg genesis.Staking, candBucketsIndexer *CandidatesBucketsIndexer, reviseHeights ...uint64) (*Protocol, error) {
+func NewProtocol(
+	depositGas DepositGas,
+	cfg genesis.Staking,
+	candBucketsIndexer *CandidatesBucketsIndexer,
+	historyIndexer *BucketHistoryIndexer,
+	reviseHeights ...uint64,
+) (*Protocol, error) {
 	h := hash.Hash160b([]byte(protocolID))
 	addr, err := address.FromBytes(h[:])
 	if err != nil {
@@ -127,10 +134,27 @@ func NewProtocol(depositGas DepositGas, cfg genesis.Staking, candBucketsIndexer
 		},
 		depositGas:         depositGas,
 		candBucketsIndexer: candBucketsIndexer,
+		historyIndexer:     historyIndexer,
 		voteReviser:        voteReviser,
 	}, nil
 }
 
+// FindProtocol finds the registered protocol from registry
+func FindProtocol(registry *protocol.Registry) *Protocol {
+	if registry == nil {
+		return nil
+	}
+	p, ok := registry.Find(protocolID)
+	if !ok {
+		return nil
+	}
+	sp, ok := p.(*Protocol)
+	if !ok {
+		log.S().Panic("fail to cast staking protocol")
+	}
+	return sp
+}
+
 // Start starts the protocol
 func (p *Protocol) Start(ctx context.Context, sr protocol.StateReader) (interface{}, error) {
 	bcCtx := protocol.MustGetBlockchainCtx(ctx)
@@ -187,13 +211,14 @@ func (p *Protocol) CreateGenesisStates(
 			return err
 		}
 		c := &Candidate{
-			Owner:              owner,
-			Operator:           operator,
-			Reward:             reward,
-			Name:               bc.Name,
-			Votes:              p.calculateVoteWeight(bucket, true),
-			SelfStakeBucketIdx: bucketIdx,
-			SelfStake:          selfStake,
+			Owner:                   owner,
+			Operator:                operator,
+			Reward:                  reward,
+			Name:                    bc.Name,
+			Votes:                   p.calculateVoteWeight(bucket, true),
+			SelfStakeBucketIdx:      bucketIdx,
+			SelfStake:               selfStake,
+			RewardDistributionRatio: bc.RewardDistributionRatio,
 		}
 
 		// put in statedb and cand center
@@ -318,6 +343,10 @@ func (p *Protocol) handle(ctx context.Context, act action.Action, csm CandidateS
 		rLog, err = p.handleChangeCandidate(ctx, act, csm)
 	case *action.TransferStake:
 		rLog, err = p.handleTransferStake(ctx, act, csm)
+	case *action.SplitStake:
+		rLog, err = p.handleSplitStake(ctx, act, csm)
+	case *action.MergeStake:
+		rLog, err = p.handleMergeStake(ctx, act, csm)
 	case *action.DepositToStake:
 		rLog, tLogs, err = p.handleDepositToStake(ctx, act, csm)
 	case *action.Restake:
@@ -360,6 +389,10 @@ func (p *Protocol) Validate(ctx context.Context, act action.Action, sr protocol.
 		return p.validateChangeCandidate(ctx, act)
 	case *action.TransferStake:
 		return p.validateTransferStake(ctx, act)
+	case *action.SplitStake:
+		return p.validateSplitStake(ctx, act)
+	case *action.MergeStake:
+		return p.validateMergeStake(ctx, act)
 	case *action.DepositToStake:
 		return p.validateDepositToStake(ctx, act)
 	case *action.Restake:
@@ -391,6 +424,23 @@ func (p *Protocol) ActiveCandidates(ctx context.Context, sr protocol.StateReader
 
 // ReadState read the state on blockchain via protocol
 func (p *Protocol) ReadState(ctx context.Context, sr protocol.StateReader, method []byte, args ...[]byte) ([]byte, uint64, error) {
+	switch string(method) {
+	case ReadStakingCalculation:
+		csr, err := ConstructBaseView(sr)
+		if err != nil {
+			return nil, 0, err
+		}
+		resp, height, err := readStateStakingCalculation(ctx, csr, p.config, args...)
+		if err != nil {
+			return nil, height, err
+		}
+		data, err := proto.Marshal(resp)
+		if err != nil {
+			return nil, height, err
+		}
+		return data, height, nil
+	}
+
 	m := iotexapi.ReadStakingDataMethod{}
 	if err := proto.Unmarshal(method, &m); err != nil {
 		return nil, uint64(0), errors.Wrap(err, "failed to unmarshal method name")
@@ -445,6 +495,12 @@ func (p *Protocol) ReadState(ctx context.Context, sr protocol.StateReader, metho
 		resp, height, err = readStateCandidateByAddress(ctx, csr, r.GetCandidateByAddress())
 	case iotexapi.ReadStakingDataMethod_TOTAL_STAKING_AMOUNT:
 		resp, height, err = readStateTotalStakingAmount(ctx, csr, r.GetTotalStakingAmount())
+	case iotexapi.ReadStakingDataMethod_BUCKET_HISTORY:
+		resp, height, err = readStateBucketHistory(p.historyIndexer, r.GetBucketHistory())
+	case iotexapi.ReadStakingDataMethod_BUCKET_EVENTS_BY_VOTER:
+		resp, height, err = readStateBucketEventsByVoter(p.historyIndexer, r.GetBucketEventsByVoter())
+	case iotexapi.ReadStakingDataMethod_BUCKET_EVENTS_BY_CANDIDATE:
+		resp, height, err = readStateBucketEventsByCandidate(csr, p.historyIndexer, r.GetBucketEventsByCandidate())
 	default:
 		err = errors.New("corresponding method isn't found")
 	}
@@ -9,16 +9,19 @@ package staking
 import (
 	"context"
 	"math/big"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/staking/stakingpb"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/pkg/unit"
 	"github.com/iotexproject/iotex-core/state"
@@ -83,7 +86,7 @@ func TestProtocol(t *testing.T) {
 	}
 
 	// test loading with no candidate in stateDB
-	stk, err := NewProtocol(nil, genesis.Default.Staking, nil, genesis.Default.GreenlandBlockHeight)
+	stk, err := NewProtocol(nil, genesis.Default.Staking, nil, nil, genesis.Default.GreenlandBlockHeight)
 	r.NotNil(stk)
 	r.NoError(err)
 	buckets, _, err := getAllBuckets(sm)
@@ -188,7 +191,7 @@ func TestCreatePreStates(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	sm := testdb.NewMockStateManager(ctrl)
-	p, err := NewProtocol(nil, genesis.Default.Staking, nil, genesis.Default.GreenlandBlockHeight)
+	p, err := NewProtocol(nil, genesis.Default.Staking, nil, nil, genesis.Default.GreenlandBlockHeight)
 	require.NoError(err)
 	ctx := protocol.WithBlockCtx(
 		protocol.WithBlockchainCtx(
@@ -232,3 +235,107 @@ func TestCreatePreStates(t *testing.T) {
 	_, err = sm.State(total, protocol.NamespaceOption(StakingNameSpace), protocol.KeyOption(bucketPoolAddrKey))
 	require.NoError(err)
 }
+
+func TestProtocol_ReadStakingCalculation(t *testing.T) {
+	r := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	sm := testdb.NewMockStateManager(ctrl)
+	_, err := sm.PutState(
+		&totalBucketCount{count: 0},
+		protocol.NamespaceOption(StakingNameSpace),
+		protocol.KeyOption(TotalBucketKey),
+	)
+	r.NoError(err)
+	p, err := NewProtocol(depositGas, genesis.Default.Staking, nil, nil, genesis.Default.GreenlandBlockHeight)
+	r.NoError(err)
+
+	minSelfStake := p.config.RegistrationConsts.MinSelfStake
+	for i, v := range []struct {
+		name      string
+		votes     int64
+		selfStake *big.Int
+	}{
+		{"alice", 1000, minSelfStake},
+		{"bob", 2000, minSelfStake},
+		{"carol", 5000, big.NewInt(0)},
+	} {
+		r.NoError(putCandidate(sm, &Candidate{
+			Owner:              identityset.Address(i),
+			Operator:           identityset.Address(i + 10),
+			Reward:             identityset.Address(i + 20),
+			Name:               v.name,
+			Votes:              unit.ConvertIotxToRau(v.votes),
+			SelfStakeBucketIdx: uint64(i),
+			SelfStake:          v.selfStake,
+		}))
+	}
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{
+		Genesis: genesis.Default,
+	})
+	v, err := p.Start(ctx, sm)
+	r.NoError(err)
+	r.NoError(sm.WriteView(protocolID, v))
+
+	calculate := func(args ...string) (*stakingpb.StakingCalculation, error) {
+		input := make([][]byte, len(args))
+		for i := range args {
+			input[i] = []byte(args[i])
+		}
+		data, _, err := p.ReadState(ctx, sm, []byte(ReadStakingCalculation), input...)
+		if err != nil {
+			return nil, err
+		}
+		resp := &stakingpb.StakingCalculation{}
+		r.NoError(proto.Unmarshal(data, resp))
+		return resp, nil
+	}
+	for _, args := range [][]string{
+		{"100000000000000000000", "0", "false"},
+		{"invalid", "0", "false", "alice"},
+		{"1", "0", "false", "alice"},
+		{"100000000000000000000", "-1", "false", "alice"},
+		{"100000000000000000000", "0", "invalid", "alice"},
+		{"100000000000000000000", "0", "false", "unknown"},
+	} {
+		_, err = calculate(args...)
+		r.Error(err)
+	}
+
+	withdrawWaitingPeriod := uint64(p.config.WithdrawWaitingPeriod.Seconds())
+	for _, c := range []struct {
+		amount     int64
+		duration   uint32
+		autoStake  bool
+		candidate  string
+		totalVotes int64
+		rank       uint32
+	}{
+		{1500, 0, false, "alice", 2500, 1},
+		{100, 0, false, "alice", 1100, 2},
+		{100, 0, true, "bob", 2100, 1},
+		{100, 0, false, "carol", 5100, 0},
+	} {
+		amount := unit.ConvertIotxToRau(c.amount)
+		resp, err := calculate(amount.String(), strconv.FormatUint(uint64(c.duration), 10), strconv.FormatBool(c.autoStake), c.candidate)
+		r.NoError(err)
+		r.Equal(amount.String(), resp.VoteWeight)
+		r.Equal(unit.ConvertIotxToRau(c.totalVotes).String(), resp.CandidateTotalVotes)
+		r.Equal(c.rank, resp.CandidateRank)
+		r.Zero(resp.UnstakeAfter)
+		r.Equal(withdrawWaitingPeriod, resp.WithdrawWaitingPeriod)
+		r.Equal(withdrawWaitingPeriod, resp.WithdrawAfter)
+	}
+
+	// the projected vote weight follows the on-chain formula
+	amount := unit.ConvertIotxToRau(100)
+	resp, err := calculate(amount.String(), "91", "true", "alice")
+	r.NoError(err)
+	weight := calculateVoteWeight(p.config.VoteWeightCalConsts, NewVoteBucket(identityset.Address(0), identityset.Address(0), amount, 91, time.Now(), true), false)
+	r.Equal(1, weight.Cmp(amount))
+	r.Equal(weight.String(), resp.VoteWeight)
+	r.Equal(new(big.Int).Add(unit.ConvertIotxToRau(1000), weight).String(), resp.CandidateTotalVotes)
+	stakedDuration := uint64((91 * 24 * time.Hour).Seconds())
+	r.Equal(stakedDuration, resp.UnstakeAfter)
+	r.Equal(stakedDuration+withdrawWaitingPeriod, resp.WithdrawAfter)
+}
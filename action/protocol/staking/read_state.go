@@ -9,6 +9,9 @@ package staking
 import (
 	"context"
 	"math/big"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -17,10 +20,15 @@ import (
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/staking/stakingpb"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/state"
 )
 
+// ReadStakingCalculation is the ReadState method to project the vote weight of a stake, which takes 4 arguments: the
+// amount, the duration in days, whether auto-stake is enabled, and the candidate name
+const ReadStakingCalculation = "StakingCalculation"
+
 func readStateBuckets(ctx context.Context, sr protocol.StateReader,
 	req *iotexapi.ReadStakingDataRequest_VoteBuckets) (*iotextypes.VoteBucketList, uint64, error) {
 	all, height, err := getAllBuckets(sr)
@@ -163,6 +171,100 @@ func readStateTotalStakingAmount(ctx context.Context, csr CandidateStateReader,
 	return &meta, h, nil
 }
 
+func readStateBucketHistory(indexer *BucketHistoryIndexer,
+	req *iotexapi.ReadStakingDataRequest_BucketHistory) (*stakingpb.BucketEvents, uint64, error) {
+	if indexer == nil {
+		return nil, 0, errors.New("bucket history indexer is not enabled")
+	}
+	return indexer.GetBucketHistory(req.GetIndex(), req.GetPagination().GetOffset(), req.GetPagination().GetLimit())
+}
+
+func readStateBucketEventsByVoter(indexer *BucketHistoryIndexer,
+	req *iotexapi.ReadStakingDataRequest_BucketEventsByVoter) (*stakingpb.BucketEvents, uint64, error) {
+	if indexer == nil {
+		return nil, 0, errors.New("bucket history indexer is not enabled")
+	}
+	voter, err := address.FromString(req.GetVoterAddress())
+	if err != nil {
+		return nil, 0, err
+	}
+	return indexer.GetEventsByVoter(voter, req.GetPagination().GetOffset(), req.GetPagination().GetLimit())
+}
+
+func readStateBucketEventsByCandidate(csr CandidateStateReader, indexer *BucketHistoryIndexer,
+	req *iotexapi.ReadStakingDataRequest_BucketEventsByCandidate) (*stakingpb.BucketEvents, uint64, error) {
+	if indexer == nil {
+		return nil, 0, errors.New("bucket history indexer is not enabled")
+	}
+	c := csr.GetCandidateByName(req.GetCandName())
+	if c == nil {
+		height, err := indexer.Height()
+		return &stakingpb.BucketEvents{}, height, err
+	}
+	return indexer.GetEventsByCandidate(c.Owner, req.GetPagination().GetOffset(), req.GetPagination().GetLimit())
+}
+
+func readStateStakingCalculation(ctx context.Context, csr CandidateStateReader, cfg Configuration,
+	args ...[]byte) (*stakingpb.StakingCalculation, uint64, error) {
+	if len(args) != 4 {
+		return nil, 0, errors.Errorf("invalid number of arguments %d", len(args))
+	}
+	amount, ok := new(big.Int).SetString(string(args[0]), 10)
+	if !ok || amount.Sign() <= 0 {
+		return nil, 0, errors.Errorf("invalid amount %s", args[0])
+	}
+	if amount.Cmp(cfg.MinStakeAmount) == -1 {
+		return nil, 0, errors.Errorf("amount %s is less than the minimum stake amount %s", amount, cfg.MinStakeAmount)
+	}
+	duration, err := strconv.ParseUint(string(args[1]), 10, 32)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "invalid duration")
+	}
+	autoStake, err := strconv.ParseBool(string(args[2]))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "invalid auto-stake")
+	}
+	c := csr.GetCandidateByName(string(args[3]))
+	if c == nil {
+		return nil, 0, errors.Errorf("candidate %s does not exist", args[3])
+	}
+
+	bucket := NewVoteBucket(c.Owner, c.Owner, amount, uint32(duration), time.Unix(0, 0), autoStake)
+	weight := calculateVoteWeight(cfg.VoteWeightCalConsts, bucket, false)
+	totalVotes := new(big.Int).Add(c.Votes, weight)
+
+	// the rank among the candidates qualified to be active, 0 if the candidate is not qualified
+	var rank uint32
+	if c.SelfStake.Cmp(cfg.RegistrationConsts.MinSelfStake) >= 0 {
+		projected := c.Clone()
+		projected.Votes = totalVotes
+		list := CandidateList{projected}
+		for _, cand := range csr.AllCandidates() {
+			if cand.Owner.String() != c.Owner.String() && cand.SelfStake.Cmp(cfg.RegistrationConsts.MinSelfStake) >= 0 {
+				list = append(list, cand)
+			}
+		}
+		sort.Sort(list)
+		for i := range list {
+			if list[i] == projected {
+				rank = uint32(i + 1)
+				break
+			}
+		}
+	}
+
+	// the countdown of the staked duration starts once auto-stake is disabled, or upon staking otherwise
+	unstakeAfter := bucket.StakedDuration
+	return &stakingpb.StakingCalculation{
+		VoteWeight:            weight.String(),
+		CandidateTotalVotes:   totalVotes.String(),
+		CandidateRank:         rank,
+		UnstakeAfter:          uint64(unstakeAfter.Seconds()),
+		WithdrawWaitingPeriod: uint64(cfg.WithdrawWaitingPeriod.Seconds()),
+		WithdrawAfter:         uint64((unstakeAfter + cfg.WithdrawWaitingPeriod).Seconds()),
+	}, csr.Height(), nil
+}
+
 func toIoTeXTypesVoteBucketList(buckets []*VoteBucket) (*iotextypes.VoteBucketList, error) {
 	res := iotextypes.VoteBucketList{
 		Buckets: make([]*iotextypes.VoteBucket, 0, len(buckets)),
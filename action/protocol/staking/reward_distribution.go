@@ -0,0 +1,88 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"math/big"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// VoterReward is the reward a bucket receives from the candidate it votes for
+type VoterReward struct {
+	BucketIndex uint64
+	Voter       address.Address
+	Amount      *big.Int
+}
+
+// DistributeReward splits the reward of a candidate by its reward distribution ratio. The voters' share is split
+// across the active buckets voting for the candidate pro rata by vote weight, the self-staking bucket excluded, and
+// the rest of the reward, including the rounding dust, remains with the candidate.
+func (p *Protocol) DistributeReward(
+	sr protocol.StateReader,
+	name string,
+	amount *big.Int,
+) (uint32, *big.Int, []*VoterReward, error) {
+	csr, err := ConstructBaseView(sr)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	c := csr.GetCandidateByName(name)
+	if c == nil || c.RewardDistributionRatio == 0 || amount.Sign() <= 0 {
+		return 0, amount, nil, nil
+	}
+
+	indices, _, err := getCandBucketIndices(sr, c.Owner)
+	if err != nil {
+		if errors.Cause(err) == state.ErrStateNotExist {
+			return c.RewardDistributionRatio, amount, nil, nil
+		}
+		return 0, nil, nil, err
+	}
+	buckets, err := getBucketsWithIndices(sr, *indices)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	totalWeight := big.NewInt(0)
+	voted := make([]*VoteBucket, 0, len(buckets))
+	weights := make([]*big.Int, 0, len(buckets))
+	for _, b := range buckets {
+		if b == nil || b.isUnstaked() || b.Index == c.SelfStakeBucketIdx {
+			continue
+		}
+		weight := p.calculateVoteWeight(b, false)
+		voted = append(voted, b)
+		weights = append(weights, weight)
+		totalWeight.Add(totalWeight, weight)
+	}
+	if totalWeight.Sign() == 0 {
+		return c.RewardDistributionRatio, amount, nil, nil
+	}
+
+	toVoters := new(big.Int).Mul(amount, big.NewInt(int64(c.RewardDistributionRatio)))
+	toVoters.Div(toVoters, big.NewInt(MaxRewardDistributionRatio))
+	remaining := new(big.Int).Set(amount)
+	rewards := make([]*VoterReward, 0, len(voted))
+	for i, b := range voted {
+		share := new(big.Int).Mul(toVoters, weights[i])
+		share.Div(share, totalWeight)
+		if share.Sign() == 0 {
+			continue
+		}
+		rewards = append(rewards, &VoterReward{
+			BucketIndex: b.Index,
+			Voter:       b.Owner,
+			Amount:      share,
+		})
+		remaining.Sub(remaining, share)
+	}
+	return c.RewardDistributionRatio, remaining, rewards, nil
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil/testdb"
+)
+
+func TestDistributeReward(t *testing.T) {
+	r := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	sm := testdb.NewMockStateManager(ctrl)
+	_, err := sm.PutState(
+		&totalBucketCount{count: 0},
+		protocol.NamespaceOption(StakingNameSpace),
+		protocol.KeyOption(TotalBucketKey),
+	)
+	r.NoError(err)
+	p, err := NewProtocol(depositGas, genesis.Default.Staking, nil, nil, genesis.Default.GreenlandBlockHeight)
+	r.NoError(err)
+
+	owner := identityset.Address(1)
+	now := time.Now()
+	selfStake := NewVoteBucket(owner, owner, big.NewInt(1200000), 91, now, true)
+	selfStakeIdx, err := putBucketAndIndex(sm, selfStake)
+	r.NoError(err)
+	for _, voter := range []int{2, 3} {
+		_, err = putBucketAndIndex(sm, NewVoteBucket(owner, identityset.Address(voter), big.NewInt(100), 7, now, true))
+		r.NoError(err)
+	}
+	unstaked := NewVoteBucket(owner, identityset.Address(4), big.NewInt(100), 7, now, true)
+	unstaked.UnstakeStartTime = now.Add(time.Hour)
+	_, err = putBucketAndIndex(sm, unstaked)
+	r.NoError(err)
+
+	cand := &Candidate{
+		Owner:              owner,
+		Operator:           identityset.Address(5),
+		Reward:             identityset.Address(6),
+		Name:               "delegate",
+		Votes:              big.NewInt(0),
+		SelfStakeBucketIdx: selfStakeIdx,
+		SelfStake:          big.NewInt(1200000),
+	}
+	r.NoError(putCandidate(sm, cand))
+	noVoter := &Candidate{
+		Owner:                   identityset.Address(7),
+		Operator:                identityset.Address(8),
+		Reward:                  identityset.Address(9),
+		Name:                    "novoter",
+		Votes:                   big.NewInt(0),
+		SelfStakeBucketIdx:      100,
+		SelfStake:               big.NewInt(0),
+		RewardDistributionRatio: 5000,
+	}
+	r.NoError(putCandidate(sm, noVoter))
+	start := func() {
+		ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{
+			Genesis: genesis.Default,
+		})
+		v, err := p.Start(ctx, sm)
+		r.NoError(err)
+		r.NoError(sm.WriteView(protocolID, v))
+	}
+	start()
+
+	// candidate not sharing reward, or unknown
+	for _, name := range []string{"delegate", "unknown"} {
+		ratio, remaining, rewards, err := p.DistributeReward(sm, name, big.NewInt(1001))
+		r.NoError(err)
+		r.Zero(ratio)
+		r.Equal(big.NewInt(1001), remaining)
+		r.Empty(rewards)
+	}
+
+	// candidate sharing reward but without voter
+	ratio, remaining, rewards, err := p.DistributeReward(sm, "novoter", big.NewInt(1001))
+	r.NoError(err)
+	r.Equal(uint32(5000), ratio)
+	r.Equal(big.NewInt(1001), remaining)
+	r.Empty(rewards)
+
+	// share half of the reward with the 2 active voter buckets, the self-staking bucket is excluded
+	cand.RewardDistributionRatio = 5000
+	r.NoError(putCandidate(sm, cand))
+	start()
+	ratio, remaining, rewards, err = p.DistributeReward(sm, "delegate", big.NewInt(1001))
+	r.NoError(err)
+	r.Equal(uint32(5000), ratio)
+	r.Equal(big.NewInt(501), remaining)
+	r.Len(rewards, 2)
+	for i, voter := range []int{2, 3} {
+		r.Equal(uint64(i+1), rewards[i].BucketIndex)
+		r.Equal(identityset.Address(voter).String(), rewards[i].Voter.String())
+		r.Equal(big.NewInt(250), rewards[i].Amount)
+	}
+}
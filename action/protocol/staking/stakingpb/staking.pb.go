@@ -9,7 +9,7 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.23.0
+// 	protoc-gen-go v1.25.0
 // 	protoc        v3.12.4
 // source: staking.proto
 
@@ -198,13 +198,14 @@ type Candidate struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OwnerAddress       string `protobuf:"bytes,1,opt,name=ownerAddress,proto3" json:"ownerAddress,omitempty"`
-	OperatorAddress    string `protobuf:"bytes,2,opt,name=operatorAddress,proto3" json:"operatorAddress,omitempty"`
-	RewardAddress      string `protobuf:"bytes,3,opt,name=rewardAddress,proto3" json:"rewardAddress,omitempty"`
-	Name               string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Votes              string `protobuf:"bytes,5,opt,name=votes,proto3" json:"votes,omitempty"`
-	SelfStakeBucketIdx uint64 `protobuf:"varint,6,opt,name=selfStakeBucketIdx,proto3" json:"selfStakeBucketIdx,omitempty"`
-	SelfStake          string `protobuf:"bytes,7,opt,name=selfStake,proto3" json:"selfStake,omitempty"`
+	OwnerAddress            string `protobuf:"bytes,1,opt,name=ownerAddress,proto3" json:"ownerAddress,omitempty"`
+	OperatorAddress         string `protobuf:"bytes,2,opt,name=operatorAddress,proto3" json:"operatorAddress,omitempty"`
+	RewardAddress           string `protobuf:"bytes,3,opt,name=rewardAddress,proto3" json:"rewardAddress,omitempty"`
+	Name                    string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Votes                   string `protobuf:"bytes,5,opt,name=votes,proto3" json:"votes,omitempty"`
+	SelfStakeBucketIdx      uint64 `protobuf:"varint,6,opt,name=selfStakeBucketIdx,proto3" json:"selfStakeBucketIdx,omitempty"`
+	SelfStake               string `protobuf:"bytes,7,opt,name=selfStake,proto3" json:"selfStake,omitempty"`
+	RewardDistributionRatio uint32 `protobuf:"varint,8,opt,name=rewardDistributionRatio,proto3" json:"rewardDistributionRatio,omitempty"`
 }
 
 func (x *Candidate) Reset() {
@@ -288,6 +289,13 @@ func (x *Candidate) GetSelfStake() string {
 	return ""
 }
 
+func (x *Candidate) GetRewardDistributionRatio() uint32 {
+	if x != nil {
+		return x.RewardDistributionRatio
+	}
+	return 0
+}
+
 type Candidates struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -390,6 +398,259 @@ func (x *TotalAmount) GetCount() uint64 {
 	return 0
 }
 
+type BucketEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type              string               `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	BucketIndex       uint64               `protobuf:"varint,2,opt,name=bucketIndex,proto3" json:"bucketIndex,omitempty"`
+	Sender            string               `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Voter             string               `protobuf:"bytes,4,opt,name=voter,proto3" json:"voter,omitempty"`
+	Candidate         string               `protobuf:"bytes,5,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Amount            string               `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockHeight       uint64               `protobuf:"varint,7,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	ActionHash        []byte               `protobuf:"bytes,8,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+	Timestamp         *timestamp.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PreviousCandidate string               `protobuf:"bytes,10,opt,name=previousCandidate,proto3" json:"previousCandidate,omitempty"`
+}
+
+func (x *BucketEvent) Reset() {
+	*x = BucketEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_staking_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BucketEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BucketEvent) ProtoMessage() {}
+
+func (x *BucketEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_staking_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BucketEvent.ProtoReflect.Descriptor instead.
+func (*BucketEvent) Descriptor() ([]byte, []int) {
+	return file_staking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BucketEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BucketEvent) GetBucketIndex() uint64 {
+	if x != nil {
+		return x.BucketIndex
+	}
+	return 0
+}
+
+func (x *BucketEvent) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *BucketEvent) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+func (x *BucketEvent) GetCandidate() string {
+	if x != nil {
+		return x.Candidate
+	}
+	return ""
+}
+
+func (x *BucketEvent) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *BucketEvent) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *BucketEvent) GetActionHash() []byte {
+	if x != nil {
+		return x.ActionHash
+	}
+	return nil
+}
+
+func (x *BucketEvent) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *BucketEvent) GetPreviousCandidate() string {
+	if x != nil {
+		return x.PreviousCandidate
+	}
+	return ""
+}
+
+type BucketEvents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*BucketEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *BucketEvents) Reset() {
+	*x = BucketEvents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_staking_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BucketEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BucketEvents) ProtoMessage() {}
+
+func (x *BucketEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_staking_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BucketEvents.ProtoReflect.Descriptor instead.
+func (*BucketEvents) Descriptor() ([]byte, []int) {
+	return file_staking_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BucketEvents) GetEvents() []*BucketEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type StakingCalculation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoteWeight            string `protobuf:"bytes,1,opt,name=voteWeight,proto3" json:"voteWeight,omitempty"`
+	CandidateTotalVotes   string `protobuf:"bytes,2,opt,name=candidateTotalVotes,proto3" json:"candidateTotalVotes,omitempty"`
+	CandidateRank         uint32 `protobuf:"varint,3,opt,name=candidateRank,proto3" json:"candidateRank,omitempty"`
+	UnstakeAfter          uint64 `protobuf:"varint,4,opt,name=unstakeAfter,proto3" json:"unstakeAfter,omitempty"`
+	WithdrawWaitingPeriod uint64 `protobuf:"varint,5,opt,name=withdrawWaitingPeriod,proto3" json:"withdrawWaitingPeriod,omitempty"`
+	WithdrawAfter         uint64 `protobuf:"varint,6,opt,name=withdrawAfter,proto3" json:"withdrawAfter,omitempty"`
+}
+
+func (x *StakingCalculation) Reset() {
+	*x = StakingCalculation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_staking_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingCalculation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingCalculation) ProtoMessage() {}
+
+func (x *StakingCalculation) ProtoReflect() protoreflect.Message {
+	mi := &file_staking_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingCalculation.ProtoReflect.Descriptor instead.
+func (*StakingCalculation) Descriptor() ([]byte, []int) {
+	return file_staking_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StakingCalculation) GetVoteWeight() string {
+	if x != nil {
+		return x.VoteWeight
+	}
+	return ""
+}
+
+func (x *StakingCalculation) GetCandidateTotalVotes() string {
+	if x != nil {
+		return x.CandidateTotalVotes
+	}
+	return ""
+}
+
+func (x *StakingCalculation) GetCandidateRank() uint32 {
+	if x != nil {
+		return x.CandidateRank
+	}
+	return 0
+}
+
+func (x *StakingCalculation) GetUnstakeAfter() uint64 {
+	if x != nil {
+		return x.UnstakeAfter
+	}
+	return 0
+}
+
+func (x *StakingCalculation) GetWithdrawWaitingPeriod() uint64 {
+	if x != nil {
+		return x.WithdrawWaitingPeriod
+	}
+	return 0
+}
+
+func (x *StakingCalculation) GetWithdrawAfter() uint64 {
+	if x != nil {
+		return x.WithdrawAfter
+	}
+	return 0
+}
+
 var File_staking_proto protoreflect.FileDescriptor
 
 var file_staking_proto_rawDesc = []byte{
@@ -424,7 +685,7 @@ var file_staking_proto_rawDesc = []byte{
 	0x6e, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
 	0x22, 0x29, 0x0a, 0x0d, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65,
 	0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x22, 0xf7, 0x01, 0x0a, 0x09,
+	0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x22, 0xb1, 0x02, 0x0a, 0x09,
 	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x77, 0x6e,
 	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a,
@@ -440,15 +701,61 @@ var file_staking_proto_rawDesc = []byte{
 	0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x65, 0x6c, 0x66, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x42, 0x75,
 	0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x66, 0x53,
 	0x74, 0x61, 0x6b, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x66,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x22, 0x42, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
-	0x67, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63,
-	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x3b, 0x0a, 0x0b, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22,
+	0x42, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x34, 0x0a,
+	0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x3b, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xd1, 0x02, 0x0a, 0x0b, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x22, 0x3e, 0x0a, 0x0c, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62,
+	0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x8c, 0x02, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x76,
+	0x6f, 0x74, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x76, 0x6f, 0x74, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x61, 0x6e, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x75, 0x6e, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x15, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -463,25 +770,30 @@ func file_staking_proto_rawDescGZIP() []byte {
 	return file_staking_proto_rawDescData
 }
 
-var file_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_staking_proto_goTypes = []interface{}{
 	(*Bucket)(nil),              // 0: stakingpb.Bucket
 	(*BucketIndices)(nil),       // 1: stakingpb.BucketIndices
 	(*Candidate)(nil),           // 2: stakingpb.Candidate
 	(*Candidates)(nil),          // 3: stakingpb.Candidates
 	(*TotalAmount)(nil),         // 4: stakingpb.TotalAmount
-	(*timestamp.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*BucketEvent)(nil),         // 5: stakingpb.BucketEvent
+	(*BucketEvents)(nil),        // 6: stakingpb.BucketEvents
+	(*StakingCalculation)(nil),  // 7: stakingpb.StakingCalculation
+	(*timestamp.Timestamp)(nil), // 8: google.protobuf.Timestamp
 }
 var file_staking_proto_depIdxs = []int32{
-	5, // 0: stakingpb.Bucket.createTime:type_name -> google.protobuf.Timestamp
-	5, // 1: stakingpb.Bucket.stakeStartTime:type_name -> google.protobuf.Timestamp
-	5, // 2: stakingpb.Bucket.unstakeStartTime:type_name -> google.protobuf.Timestamp
+	8, // 0: stakingpb.Bucket.createTime:type_name -> google.protobuf.Timestamp
+	8, // 1: stakingpb.Bucket.stakeStartTime:type_name -> google.protobuf.Timestamp
+	8, // 2: stakingpb.Bucket.unstakeStartTime:type_name -> google.protobuf.Timestamp
 	2, // 3: stakingpb.Candidates.candidates:type_name -> stakingpb.Candidate
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	8, // 4: stakingpb.BucketEvent.timestamp:type_name -> google.protobuf.Timestamp
+	5, // 5: stakingpb.BucketEvents.events:type_name -> stakingpb.BucketEvent
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_staking_proto_init() }
@@ -550,6 +862,42 @@ func file_staking_proto_init() {
 				return nil
 			}
 		}
+		file_staking_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BucketEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_staking_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BucketEvents); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_staking_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingCalculation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -557,7 +905,7 @@ func file_staking_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_staking_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
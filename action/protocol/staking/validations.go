@@ -12,19 +12,24 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/config"
 )
 
 // Errors
 var (
-	ErrNilAction           = errors.New("action is nil")
-	ErrInvalidAmount       = errors.New("invalid staking amount")
-	ErrInvalidCanName      = errors.New("invalid candidate name")
-	ErrInvalidOwner        = errors.New("invalid owner address")
-	ErrInvalidOperator     = errors.New("invalid operator address")
-	ErrInvalidReward       = errors.New("invalid reward address")
-	ErrInvalidSelfStkIndex = errors.New("invalid self-staking bucket index")
-	ErrMissingField        = errors.New("missing data field")
-	ErrTypeAssertion       = errors.New("failed type assertion")
+	ErrNilAction                      = errors.New("action is nil")
+	ErrInvalidAmount                  = errors.New("invalid staking amount")
+	ErrInvalidCanName                 = errors.New("invalid candidate name")
+	ErrInvalidOwner                   = errors.New("invalid owner address")
+	ErrInvalidOperator                = errors.New("invalid operator address")
+	ErrInvalidReward                  = errors.New("invalid reward address")
+	ErrInvalidSelfStkIndex            = errors.New("invalid self-staking bucket index")
+	ErrInvalidRewardDistributionRatio = errors.New("invalid reward distribution ratio")
+	ErrInvalidMergedBuckets           = errors.New("invalid buckets to merge")
+	ErrBucketOpNotEnabled             = errors.New("bucket split and merge are not enabled")
+	ErrMissingField                   = errors.New("missing data field")
+	ErrTypeAssertion                  = errors.New("failed type assertion")
 )
 
 func (p *Protocol) validateCreateStake(ctx context.Context, act *action.CreateStake) error {
@@ -56,6 +61,36 @@ func (p *Protocol) validateTransferStake(ctx context.Context, act *action.Transf
 	return nil
 }
 
+func (p *Protocol) validateSplitStake(ctx context.Context, act *action.SplitStake) error {
+	if !p.isBucketOpEnabled(ctx) {
+		return ErrBucketOpNotEnabled
+	}
+	if !isValidCandidateName(act.Candidate()) {
+		return ErrInvalidCanName
+	}
+	if act.Amount() == nil || act.Amount().Cmp(p.config.MinStakeAmount) == -1 {
+		return errors.Wrap(ErrInvalidAmount, "split amount is less than the minimum requirement")
+	}
+	return nil
+}
+
+func (p *Protocol) validateMergeStake(ctx context.Context, act *action.MergeStake) error {
+	if !p.isBucketOpEnabled(ctx) {
+		return ErrBucketOpNotEnabled
+	}
+	if len(act.MergedBuckets()) == 0 {
+		return errors.Wrap(ErrInvalidMergedBuckets, "no bucket to merge")
+	}
+	indices := map[uint64]bool{act.BucketIndex(): true}
+	for _, index := range act.MergedBuckets() {
+		if indices[index] {
+			return errors.Wrapf(ErrInvalidMergedBuckets, "bucket %d is duplicated", index)
+		}
+		indices[index] = true
+	}
+	return nil
+}
+
 func (p *Protocol) validateDepositToStake(ctx context.Context, act *action.DepositToStake) error {
 	return nil
 }
@@ -72,7 +107,7 @@ func (p *Protocol) validateCandidateRegister(ctx context.Context, act *action.Ca
 	if act.Amount().Cmp(p.config.RegistrationConsts.MinSelfStake) < 0 {
 		return errors.Wrap(ErrInvalidAmount, "self staking amount is not valid")
 	}
-	return nil
+	return p.validateRewardDistributionRatio(ctx, act.RewardDistributionRatio(), act.RewardDistributionRatio() > 0)
 }
 
 func (p *Protocol) validateCandidateUpdate(ctx context.Context, act *action.CandidateUpdate) error {
@@ -81,9 +116,32 @@ func (p *Protocol) validateCandidateUpdate(ctx context.Context, act *action.Cand
 			return ErrInvalidCanName
 		}
 	}
+	ratio, ok := act.RewardDistributionRatio()
+	return p.validateRewardDistributionRatio(ctx, ratio, ok)
+}
+
+// validateRewardDistributionRatio checks the reward distribution ratio set by a candidate action, which is not allowed
+// before the epoch reward is distributed to voters at Iceland
+func (p *Protocol) validateRewardDistributionRatio(ctx context.Context, ratio uint32, set bool) error {
+	if !set {
+		return nil
+	}
+	if ratio > MaxRewardDistributionRatio {
+		return ErrInvalidRewardDistributionRatio
+	}
+	if blkCtx, ok := protocol.GetBlockCtx(ctx); ok && !p.hu.IsPost(config.Iceland, blkCtx.BlockHeight) {
+		return errors.Wrap(ErrInvalidRewardDistributionRatio, "reward distribution ratio cannot be set before Iceland")
+	}
 	return nil
 }
 
+// isBucketOpEnabled checks whether bucket split and merge are enabled at the height of the block being validated,
+// which are never enabled if there is no block in the context
+func (p *Protocol) isBucketOpEnabled(ctx context.Context) bool {
+	blkCtx, ok := protocol.GetBlockCtx(ctx)
+	return ok && p.hu.IsPost(config.Iceland, blkCtx.BlockHeight)
+}
+
 // IsValidCandidateName check if a candidate name string is valid.
 func isValidCandidateName(s string) bool {
 	if len(s) == 0 || len(s) > 12 {
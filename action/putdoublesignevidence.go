@@ -0,0 +1,113 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+// PutDoubleSignEvidence represents putting the evidence of a delegate endorsing two different blocks at the same
+// height on chain.
+type PutDoubleSignEvidence struct {
+	AbstractAction
+
+	topic        iotextypes.ConsensusVote_Topic
+	headers      [][]byte
+	endorsements []*endorsement.Endorsement
+}
+
+// NewPutDoubleSignEvidence instantiates a putting double sign evidence action struct.
+func NewPutDoubleSignEvidence(
+	nonce uint64,
+	topic iotextypes.ConsensusVote_Topic,
+	headers [][]byte,
+	endorsements []*endorsement.Endorsement,
+) *PutDoubleSignEvidence {
+	return &PutDoubleSignEvidence{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: 0,
+			gasPrice: big.NewInt(0),
+		},
+		topic:        topic,
+		headers:      headers,
+		endorsements: endorsements,
+	}
+}
+
+// LoadProto converts a proto message into put double sign evidence action.
+func (r *PutDoubleSignEvidence) LoadProto(pbAct *iotextypes.PutDoubleSignEvidence) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if r == nil {
+		return errors.New("nil action to load proto")
+	}
+	*r = PutDoubleSignEvidence{}
+
+	r.topic = iotextypes.ConsensusVote_Topic(pbAct.Topic)
+	r.headers = pbAct.Headers
+	for _, enPb := range pbAct.Endorsements {
+		en := &endorsement.Endorsement{}
+		if err := en.LoadProto(enPb); err != nil {
+			return errors.Wrap(err, "failed to load endorsement")
+		}
+		r.endorsements = append(r.endorsements, en)
+	}
+	return nil
+}
+
+// Proto converts put double sign evidence action into a proto message.
+func (r *PutDoubleSignEvidence) Proto() *iotextypes.PutDoubleSignEvidence {
+	pbAct := &iotextypes.PutDoubleSignEvidence{
+		Topic:   uint32(r.topic),
+		Headers: r.headers,
+	}
+	for _, en := range r.endorsements {
+		enPb, err := en.Proto()
+		if err != nil {
+			log.L().Panic("Error when converting an endorsement to proto", zap.Error(err))
+		}
+		pbAct.Endorsements = append(pbAct.Endorsements, enPb)
+	}
+	return pbAct
+}
+
+// Topic returns the topic of the endorsements.
+func (r *PutDoubleSignEvidence) Topic() iotextypes.ConsensusVote_Topic { return r.topic }
+
+// Headers returns the serialized headers of the endorsed blocks.
+func (r *PutDoubleSignEvidence) Headers() [][]byte { return r.headers }
+
+// Endorsements returns the endorsements of the blocks.
+func (r *PutDoubleSignEvidence) Endorsements() []*endorsement.Endorsement { return r.endorsements }
+
+// Serialize returns the byte representation of put double sign evidence action.
+func (r *PutDoubleSignEvidence) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(r.Proto()))
+}
+
+// IntrinsicGas returns the intrinsic gas of a put double sign evidence action
+func (r *PutDoubleSignEvidence) IntrinsicGas() (uint64, error) {
+	return 0, nil
+}
+
+// Cost returns the total cost of a put double sign evidence action
+func (r *PutDoubleSignEvidence) Cost() (*big.Int, error) {
+	return big.NewInt(0), nil
+}
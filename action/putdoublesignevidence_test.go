@@ -0,0 +1,53 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestPutDoubleSignEvidence(t *testing.T) {
+	require := require.New(t)
+	ts := time.Unix(1600000000, 0)
+	pk := identityset.PrivateKey(32).PublicKey()
+	headers := [][]byte{[]byte("header1"), []byte("header2")}
+	endorsements := []*endorsement.Endorsement{
+		endorsement.NewEndorsement(ts, pk, []byte("signature1")),
+		endorsement.NewEndorsement(ts, pk, []byte("signature2")),
+	}
+	r := NewPutDoubleSignEvidence(0, iotextypes.ConsensusVote_LOCK, headers, endorsements)
+	require.Equal(uint64(0), r.Nonce())
+	igas, err := r.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(uint64(0), igas)
+	cost, err := r.Cost()
+	require.NoError(err)
+	require.Equal(0, big.NewInt(0).Cmp(cost))
+
+	pb := r.Proto()
+	require.NotNil(pb)
+	clone := &PutDoubleSignEvidence{}
+	require.NoError(clone.LoadProto(pb))
+	require.Equal(iotextypes.ConsensusVote_LOCK, clone.Topic())
+	require.Equal(headers, clone.Headers())
+	require.Len(clone.Endorsements(), 2)
+	for i, en := range clone.Endorsements() {
+		require.Equal(endorsements[i].Timestamp(), en.Timestamp())
+		require.Equal(endorsements[i].Endorser().Bytes(), en.Endorser().Bytes())
+		require.Equal(endorsements[i].Signature(), en.Signature())
+	}
+	require.Equal(r.Serialize(), clone.Serialize())
+	require.Error(clone.LoadProto(nil))
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+// Encoding types of a sealed envelope
+const (
+	// IotexProtobuf signs the hash of the protobuf serialized envelope
+	IotexProtobuf = uint32(iotextypes.Encoding_IOTEX_PROTOBUF)
+	// EthereumRLP signs the EIP-155 hash of the equivalent Ethereum transaction, such that the action can be
+	// signed by Ethereum tooling
+	EthereumRLP = uint32(iotextypes.Encoding_ETHEREUM_RLP)
+)
+
+var (
+	// ErrInvalidEncoding indicates the error of sealed envelope encoding
+	ErrInvalidEncoding = errors.New("invalid encoding")
+)
+
+// DecodeRawTx decodes a RLP encoded and EIP-155 signed Ethereum transaction into a sealed envelope of
+// execution, which has to be signed for the given EVM network ID
+func DecodeRawTx(rawData []byte, evmNetworkID uint32) (SealedEnvelope, error) {
+	if evmNetworkID == 0 {
+		return SealedEnvelope{}, errors.Wrap(ErrInvalidEncoding, "EVM network ID is not set")
+	}
+	tx := types.Transaction{}
+	if err := rlp.DecodeBytes(rawData, &tx); err != nil {
+		return SealedEnvelope{}, errors.Wrap(ErrInvalidEncoding, err.Error())
+	}
+	chainID := big.NewInt(int64(evmNetworkID))
+	if !tx.Protected() || tx.ChainId().Cmp(chainID) != 0 {
+		return SealedEnvelope{}, errors.Wrapf(ErrInvalidEncoding, "transaction is not signed for network %d", chainID)
+	}
+	// convert V = chainID * 2 + 35 + recovery ID back to the recovery ID
+	v, r, s := tx.RawSignatureValues()
+	recID := new(big.Int).Sub(v, new(big.Int).Add(new(big.Int).Lsh(chainID, 1), big.NewInt(35)))
+	if !recID.IsUint64() || recID.Uint64() > 1 || r.BitLen() > 256 || s.BitLen() > 256 {
+		return SealedEnvelope{}, errors.Wrap(ErrInvalidEncoding, "invalid signature values")
+	}
+	sig := append(common.LeftPadBytes(r.Bytes(), 32), common.LeftPadBytes(s.Bytes(), 32)...)
+	sig = append(sig, byte(recID.Uint64()))
+
+	contract := EmptyAddress
+	if to := tx.To(); to != nil {
+		addr, err := address.FromBytes(to.Bytes())
+		if err != nil {
+			return SealedEnvelope{}, err
+		}
+		contract = addr.String()
+	}
+	exec, err := NewExecution(contract, tx.Nonce(), tx.Value(), tx.Gas(), tx.GasPrice(), tx.Data())
+	if err != nil {
+		return SealedEnvelope{}, err
+	}
+	elp := (&EnvelopeBuilder{}).
+		SetVersion(version.ProtocolVersion).
+		SetNonce(tx.Nonce()).
+		SetGasLimit(tx.Gas()).
+		SetGasPrice(tx.GasPrice()).
+		SetAction(exec).Build()
+	sealed := SealedEnvelope{
+		Envelope:     elp,
+		encoding:     EthereumRLP,
+		evmNetworkID: evmNetworkID,
+		signature:    sig,
+	}
+	if sealed.txHash, err = rlpTxHash(&sealed.Envelope, evmNetworkID, sig); err != nil {
+		return SealedEnvelope{}, err
+	}
+	h, err := sealed.envelopeHash()
+	if err != nil {
+		return SealedEnvelope{}, err
+	}
+	if sealed.srcPubkey, err = crypto.RecoverPubkey(h[:], sig); err != nil {
+		return SealedEnvelope{}, errors.Wrap(ErrInvalidEncoding, err.Error())
+	}
+	sealed.payload.SetEnvelopeContext(sealed)
+	return sealed, nil
+}
+
+// rlpTx converts the envelope to the equivalent unsigned Ethereum transaction. Only executions of the legacy
+// envelope version are convertible, otherwise fields out of the Ethereum transaction won't be signed
+func rlpTx(elp *Envelope) (*types.Transaction, error) {
+	if elp.version != version.ProtocolVersion {
+		return nil, errors.Wrapf(ErrInvalidEncoding, "envelope version %d cannot be encoded in RLP", elp.version)
+	}
+	exec, ok := elp.Action().(*Execution)
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidEncoding, "action %T cannot be encoded in RLP", elp.Action())
+	}
+	if exec.Contract() == EmptyAddress {
+		return types.NewContractCreation(elp.nonce, exec.Amount(), elp.gasLimit, elp.GasPrice(), exec.Data()), nil
+	}
+	addr, err := address.FromString(exec.Contract())
+	if err != nil {
+		return nil, err
+	}
+	return types.NewTransaction(elp.nonce, common.BytesToAddress(addr.Bytes()), exec.Amount(), elp.gasLimit, elp.GasPrice(), exec.Data()), nil
+}
+
+func rlpSigner(evmNetworkID uint32) types.Signer {
+	return types.NewEIP155Signer(big.NewInt(int64(evmNetworkID)))
+}
+
+// rlpSigningHash returns the EIP-155 hash to be signed of the envelope
+func rlpSigningHash(elp *Envelope, evmNetworkID uint32) (hash.Hash256, error) {
+	tx, err := rlpTx(elp)
+	if err != nil {
+		return hash.ZeroHash256, err
+	}
+	return hash.BytesToHash256(rlpSigner(evmNetworkID).Hash(tx).Bytes()), nil
+}
+
+// rlpTxHash returns the Ethereum transaction hash of the envelope and signature
+func rlpTxHash(elp *Envelope, evmNetworkID uint32, sig []byte) (hash.Hash256, error) {
+	if len(sig) != 65 || sig[64] > 1 {
+		return hash.ZeroHash256, errors.Wrapf(ErrInvalidEncoding, "invalid signature %x", sig)
+	}
+	tx, err := rlpTx(elp)
+	if err != nil {
+		return hash.ZeroHash256, err
+	}
+	if tx, err = tx.WithSignature(rlpSigner(evmNetworkID), sig); err != nil {
+		return hash.ZeroHash256, err
+	}
+	return hash.BytesToHash256(tx.Hash().Bytes()), nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+const _testEVMNetworkID = 4689
+
+func signRawTx(t *testing.T, tx *types.Transaction, networkID uint32) []byte {
+	sk := identityset.PrivateKey(27).EcdsaPrivateKey().(*ecdsa.PrivateKey)
+	tx, err := types.SignTx(tx, types.NewEIP155Signer(big.NewInt(int64(networkID))), sk)
+	require.NoError(t, err)
+	raw, err := rlp.EncodeToBytes(tx)
+	require.NoError(t, err)
+	return raw
+}
+
+func TestDecodeRawTx(t *testing.T) {
+	require := require.New(t)
+
+	to := common.BytesToAddress(identityset.Address(28).Bytes())
+	for _, tx := range []*types.Transaction{
+		types.NewTransaction(3, to, big.NewInt(10), 100000, big.NewInt(1000), []byte{1, 2, 3}),
+		types.NewContractCreation(4, big.NewInt(0), 200000, big.NewInt(1000), []byte{4, 5, 6}),
+	} {
+		raw := signRawTx(t, tx, _testEVMNetworkID)
+		selp, err := DecodeRawTx(raw, _testEVMNetworkID)
+		require.NoError(err)
+		require.Equal(EthereumRLP, selp.Encoding())
+		require.EqualValues(_testEVMNetworkID, selp.EVMNetworkID())
+		require.Equal(identityset.PrivateKey(27).PublicKey().Bytes(), selp.SrcPubkey().Bytes())
+		require.Equal(tx.Nonce(), selp.Nonce())
+		require.Equal(tx.Gas(), selp.GasLimit())
+		require.Equal(tx.GasPrice(), selp.GasPrice())
+		exec, ok := selp.Action().(*Execution)
+		require.True(ok)
+		require.Equal(tx.Value(), exec.Amount())
+		require.Equal(tx.Data(), exec.Data())
+		if tx.To() == nil {
+			require.Equal(EmptyAddress, exec.Contract())
+		} else {
+			require.Equal(identityset.Address(28).String(), exec.Contract())
+		}
+		require.NoError(Verify(selp))
+		// the action hash is the Ethereum transaction hash
+		h := hash.BytesToHash256(types.NewEIP155Signer(big.NewInt(_testEVMNetworkID)).Hash(tx).Bytes())
+		require.NotEqual(h, selp.Hash())
+		signed := types.Transaction{}
+		require.NoError(rlp.DecodeBytes(raw, &signed))
+		require.Equal(hash.BytesToHash256(signed.Hash().Bytes()), selp.Hash())
+
+		// the encoding and EVM network ID are kept in proto
+		pb := selp.Proto()
+		require.Equal(iotextypes.Encoding_ETHEREUM_RLP, pb.Encoding)
+		require.EqualValues(_testEVMNetworkID, pb.EvmNetworkID)
+		loaded := SealedEnvelope{}
+		require.NoError(loaded.LoadProto(pb))
+		require.Equal(EthereumRLP, loaded.Encoding())
+		require.Equal(selp.EVMNetworkID(), loaded.EVMNetworkID())
+		require.Equal(selp.Hash(), loaded.Hash())
+		require.NoError(Verify(loaded))
+
+		// the signature is not over another EVM network ID
+		pb.EvmNetworkID++
+		require.NoError(loaded.LoadProto(pb))
+		require.NotEqual(selp.Hash(), loaded.Hash())
+		require.Error(Verify(loaded))
+		pb.EvmNetworkID = 0
+		require.Equal(ErrInvalidEncoding, errors.Cause(loaded.LoadProto(pb)))
+	}
+
+	// signed for another network
+	tx := types.NewTransaction(3, to, big.NewInt(10), 100000, big.NewInt(1000), nil)
+	_, err := DecodeRawTx(signRawTx(t, tx, _testEVMNetworkID+1), _testEVMNetworkID)
+	require.Equal(ErrInvalidEncoding, errors.Cause(err))
+	// not replay protected
+	tx, err = types.SignTx(tx, types.HomesteadSigner{}, identityset.PrivateKey(27).EcdsaPrivateKey().(*ecdsa.PrivateKey))
+	require.NoError(err)
+	raw, err := rlp.EncodeToBytes(tx)
+	require.NoError(err)
+	_, err = DecodeRawTx(raw, _testEVMNetworkID)
+	require.Equal(ErrInvalidEncoding, errors.Cause(err))
+	_, err = DecodeRawTx([]byte{1, 2, 3}, _testEVMNetworkID)
+	require.Equal(ErrInvalidEncoding, errors.Cause(err))
+}
+
+func TestRLPEncodingLoadProto(t *testing.T) {
+	require := require.New(t)
+
+	tsf, err := NewTransfer(1, big.NewInt(10), identityset.Address(28).String(), nil, 100000, big.NewInt(1000))
+	require.NoError(err)
+	elp := (&EnvelopeBuilder{}).SetNonce(1).SetGasLimit(100000).SetGasPrice(big.NewInt(1000)).SetAction(tsf).Build()
+	selp, err := Sign(elp, identityset.PrivateKey(27))
+	require.NoError(err)
+	pb := selp.Proto()
+	require.NoError(selp.LoadProto(pb))
+	require.Equal(IotexProtobuf, selp.Encoding())
+
+	// a transfer cannot be RLP encoded
+	pb.Encoding = iotextypes.Encoding_ETHEREUM_RLP
+	pb.EvmNetworkID = _testEVMNetworkID
+	require.Equal(ErrInvalidEncoding, errors.Cause(selp.LoadProto(pb)))
+
+	// unknown encoding
+	pb.Encoding = 2
+	require.Equal(ErrInvalidEncoding, errors.Cause(selp.LoadProto(pb)))
+}
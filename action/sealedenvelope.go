@@ -14,15 +14,43 @@ import (
 type SealedEnvelope struct {
 	Envelope
 
-	srcPubkey crypto.PublicKey
-	signature []byte
+	encoding     uint32
+	evmNetworkID uint32
+	srcPubkey    crypto.PublicKey
+	signature    []byte
+	// txHash is the Ethereum transaction hash of an EthereumRLP encoded envelope, which is computed when the
+	// envelope is decoded
+	txHash hash.Hash256
 }
 
-// Hash returns the hash value of SealedEnvelope.
+// Hash returns the hash value of SealedEnvelope. It is the Ethereum transaction hash for an EthereumRLP
+// encoded envelope, such that Ethereum tooling can track the action.
 func (sealed *SealedEnvelope) Hash() hash.Hash256 {
+	if sealed.encoding == EthereumRLP {
+		return sealed.txHash
+	}
 	return hash.Hash256b(byteutil.Must(proto.Marshal(sealed.Proto())))
 }
 
+// Encoding returns the encoding of the envelope which the signature is over
+func (sealed *SealedEnvelope) Encoding() uint32 { return sealed.encoding }
+
+// EVMNetworkID returns the EIP-155 chain ID which an EthereumRLP encoded envelope is signed for, and 0 for
+// other encodings
+func (sealed *SealedEnvelope) EVMNetworkID() uint32 { return sealed.evmNetworkID }
+
+// envelopeHash returns the hash of the envelope which the signature is over
+func (sealed *SealedEnvelope) envelopeHash() (hash.Hash256, error) {
+	switch sealed.encoding {
+	case IotexProtobuf:
+		return sealed.Envelope.Hash(), nil
+	case EthereumRLP:
+		return rlpSigningHash(&sealed.Envelope, sealed.evmNetworkID)
+	default:
+		return hash.ZeroHash256, errors.Wrapf(ErrInvalidEncoding, "unknown encoding %d", sealed.encoding)
+	}
+}
+
 // SrcPubkey returns the source public key
 func (sealed *SealedEnvelope) SrcPubkey() crypto.PublicKey { return sealed.srcPubkey }
 
@@ -35,11 +63,16 @@ func (sealed *SealedEnvelope) Signature() []byte {
 
 // Proto converts it to it's proto scheme.
 func (sealed *SealedEnvelope) Proto() *iotextypes.Action {
-	return &iotextypes.Action{
+	pbAct := &iotextypes.Action{
 		Core:         sealed.Envelope.Proto(),
 		SenderPubKey: sealed.srcPubkey.Bytes(),
 		Signature:    sealed.signature,
 	}
+	if sealed.encoding == EthereumRLP {
+		pbAct.Encoding = iotextypes.Encoding_ETHEREUM_RLP
+		pbAct.EvmNetworkID = sealed.evmNetworkID
+	}
+	return pbAct
 }
 
 // LoadProto loads from proto scheme.
@@ -62,6 +95,21 @@ func (sealed *SealedEnvelope) LoadProto(pbAct *iotextypes.Action) error {
 	if err := sealed.Envelope.LoadProto(pbAct.GetCore()); err != nil {
 		return err
 	}
+	switch encoding := pbAct.GetEncoding(); encoding {
+	case iotextypes.Encoding_IOTEX_PROTOBUF:
+	case iotextypes.Encoding_ETHEREUM_RLP:
+		if pbAct.GetEvmNetworkID() == 0 {
+			return errors.Wrap(ErrInvalidEncoding, "EVM network ID is missing")
+		}
+		sealed.evmNetworkID = pbAct.GetEvmNetworkID()
+		// make sure the envelope is exactly what is signed
+		if sealed.txHash, err = rlpTxHash(&sealed.Envelope, sealed.evmNetworkID, sealed.signature); err != nil {
+			return err
+		}
+		sealed.encoding = EthereumRLP
+	default:
+		return errors.Wrapf(ErrInvalidEncoding, "unknown encoding %d", encoding)
+	}
 
 	sealed.payload.SetEnvelopeContext(*sealed)
 	return nil
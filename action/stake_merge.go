@@ -0,0 +1,115 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+const (
+	// MergeStakePayloadGas represents the MergeStake payload gas per uint
+	MergeStakePayloadGas = uint64(100)
+	// MergeStakeBaseIntrinsicGas represents the base intrinsic gas for MergeStake
+	MergeStakeBaseIntrinsicGas = uint64(10000)
+	// MergeStakePerBucketGas represents the MergeStake gas per merged bucket
+	MergeStakePerBucketGas = uint64(10000)
+)
+
+// MergeStake defines the action of merging buckets into a bucket. The merged buckets have to be owned by the owner of
+// the bucket, vote for the same candidate, and have the same duration and auto-stake flag as the bucket
+type MergeStake struct {
+	AbstractAction
+
+	bucketIndex   uint64
+	mergedBuckets []uint64
+	payload       []byte
+}
+
+// NewMergeStake returns a MergeStake instance
+func NewMergeStake(
+	nonce uint64,
+	bucketIndex uint64,
+	mergedBuckets []uint64,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*MergeStake, error) {
+	return &MergeStake{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		bucketIndex:   bucketIndex,
+		mergedBuckets: mergedBuckets,
+		payload:       payload,
+	}, nil
+}
+
+// BucketIndex returns the index of the bucket the buckets are merged into
+func (ms *MergeStake) BucketIndex() uint64 { return ms.bucketIndex }
+
+// MergedBuckets returns the indexes of the buckets to be merged
+func (ms *MergeStake) MergedBuckets() []uint64 { return ms.mergedBuckets }
+
+// Payload returns the payload bytes
+func (ms *MergeStake) Payload() []byte { return ms.payload }
+
+// Serialize returns a raw byte stream of the MergeStake struct
+func (ms *MergeStake) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(ms.Proto()))
+}
+
+// Proto converts MergeStake to protobuf
+func (ms *MergeStake) Proto() *iotextypes.StakeMerge {
+	return &iotextypes.StakeMerge{
+		BucketIndex:         ms.bucketIndex,
+		MergedBucketIndexes: ms.mergedBuckets,
+		Payload:             ms.payload,
+	}
+}
+
+// LoadProto loads MergeStake from protobuf
+func (ms *MergeStake) LoadProto(pbAct *iotextypes.StakeMerge) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+
+	ms.bucketIndex = pbAct.GetBucketIndex()
+	ms.mergedBuckets = pbAct.GetMergedBucketIndexes()
+	ms.payload = pbAct.GetPayload()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a MergeStake, which grows with the number of merged buckets
+func (ms *MergeStake) IntrinsicGas() (uint64, error) {
+	payloadSize := uint64(len(ms.Payload()))
+	gas, err := calculateIntrinsicGas(MergeStakeBaseIntrinsicGas, MergeStakePayloadGas, payloadSize)
+	if err != nil {
+		return 0, err
+	}
+	return calculateIntrinsicGas(gas, MergeStakePerBucketGas, uint64(len(ms.mergedBuckets)))
+}
+
+// Cost returns the total cost of a MergeStake
+func (ms *MergeStake) Cost() (*big.Int, error) {
+	intrinsicGas, err := ms.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the MergeStake")
+	}
+	mergeStakeFee := big.NewInt(0).Mul(ms.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas))
+	return mergeStakeFee, nil
+}
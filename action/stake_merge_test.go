@@ -0,0 +1,43 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeStake(t *testing.T) {
+	require := require.New(t)
+
+	ms, err := NewMergeStake(1, 3, []uint64{4, 7}, []byte("payload"), 50000, big.NewInt(10))
+	require.NoError(err)
+	require.EqualValues(3, ms.BucketIndex())
+	require.Equal([]uint64{4, 7}, ms.MergedBuckets())
+	require.Equal([]byte("payload"), ms.Payload())
+	gas, err := ms.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(MergeStakeBaseIntrinsicGas+7*MergeStakePayloadGas+2*MergeStakePerBucketGas, gas)
+	cost, err := ms.Cost()
+	require.NoError(err)
+	require.Equal(new(big.Int).SetUint64(gas*10), cost)
+
+	ms2 := &MergeStake{}
+	require.NoError(ms2.LoadProto(ms.Proto()))
+	require.Equal(ms.BucketIndex(), ms2.BucketIndex())
+	require.Equal(ms.MergedBuckets(), ms2.MergedBuckets())
+	require.Equal(ms.Payload(), ms2.Payload())
+	require.Error(ms2.LoadProto(nil))
+
+	// the action is carried by the envelope
+	elp := (&EnvelopeBuilder{}).SetNonce(1).SetGasLimit(50000).SetAction(ms).Build()
+	elp2 := Envelope{}
+	require.NoError(elp2.LoadProto(elp.Proto()))
+	require.Equal(ms.Serialize(), elp2.Action().(*MergeStake).Serialize())
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+const (
+	// SplitStakePayloadGas represents the SplitStake payload gas per uint
+	SplitStakePayloadGas = uint64(100)
+	// SplitStakeBaseIntrinsicGas represents the base intrinsic gas for SplitStake
+	SplitStakeBaseIntrinsicGas = uint64(10000)
+)
+
+// SplitStake defines the action of splitting an amount out of a bucket into a new bucket voting for the candidate.
+// The new bucket has the same owner, duration, stake start time and auto-stake flag as the bucket, so the amount can
+// be re-delegated without waiting out the unstake period
+type SplitStake struct {
+	AbstractAction
+
+	candidateName string
+	bucketIndex   uint64
+	amount        *big.Int
+	payload       []byte
+}
+
+// NewSplitStake returns a SplitStake instance
+func NewSplitStake(
+	nonce uint64,
+	candName string,
+	bucketIndex uint64,
+	amount string,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*SplitStake, error) {
+	splitAmount, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidAmount, "amount %s", amount)
+	}
+
+	return &SplitStake{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		candidateName: candName,
+		bucketIndex:   bucketIndex,
+		amount:        splitAmount,
+		payload:       payload,
+	}, nil
+}
+
+// Candidate returns the name of the candidate the new bucket votes for
+func (ss *SplitStake) Candidate() string { return ss.candidateName }
+
+// BucketIndex returns bucket index
+func (ss *SplitStake) BucketIndex() uint64 { return ss.bucketIndex }
+
+// Amount returns the amount split out of the bucket
+func (ss *SplitStake) Amount() *big.Int { return ss.amount }
+
+// Payload returns the payload bytes
+func (ss *SplitStake) Payload() []byte { return ss.payload }
+
+// Serialize returns a raw byte stream of the SplitStake struct
+func (ss *SplitStake) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(ss.Proto()))
+}
+
+// Proto converts SplitStake to protobuf
+func (ss *SplitStake) Proto() *iotextypes.StakeSplit {
+	act := &iotextypes.StakeSplit{
+		CandidateName: ss.candidateName,
+		BucketIndex:   ss.bucketIndex,
+		Payload:       ss.payload,
+	}
+
+	if ss.amount != nil {
+		act.Amount = ss.amount.String()
+	}
+	return act
+}
+
+// LoadProto loads SplitStake from protobuf
+func (ss *SplitStake) LoadProto(pbAct *iotextypes.StakeSplit) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+
+	ss.candidateName = pbAct.GetCandidateName()
+	ss.bucketIndex = pbAct.GetBucketIndex()
+	ss.payload = pbAct.GetPayload()
+	ss.amount = nil
+	if len(pbAct.GetAmount()) > 0 {
+		var ok bool
+		if ss.amount, ok = new(big.Int).SetString(pbAct.GetAmount(), 10); !ok {
+			return errors.Errorf("invalid amount %s", pbAct.GetAmount())
+		}
+	}
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a SplitStake
+func (ss *SplitStake) IntrinsicGas() (uint64, error) {
+	payloadSize := uint64(len(ss.Payload()))
+	return calculateIntrinsicGas(SplitStakeBaseIntrinsicGas, SplitStakePayloadGas, payloadSize)
+}
+
+// Cost returns the total cost of a SplitStake
+func (ss *SplitStake) Cost() (*big.Int, error) {
+	intrinsicGas, err := ss.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the SplitStake")
+	}
+	splitStakeFee := big.NewInt(0).Mul(ss.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas))
+	return splitStakeFee, nil
+}
+
+// SanityCheck validates the variables in the action
+func (ss *SplitStake) SanityCheck() error {
+	if ss.Amount() == nil || ss.Amount().Sign() <= 0 {
+		return errors.Wrap(ErrInvalidAmount, "negative value")
+	}
+
+	return ss.AbstractAction.SanityCheck()
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+)
+
+func TestSplitStake(t *testing.T) {
+	require := require.New(t)
+
+	_, err := NewSplitStake(1, "candidate", 3, "abc", nil, 10000, big.NewInt(0))
+	require.Equal(ErrInvalidAmount, errors.Cause(err))
+
+	ss, err := NewSplitStake(1, "candidate", 3, "100", []byte("payload"), 10000, big.NewInt(10))
+	require.NoError(err)
+	require.NoError(ss.SanityCheck())
+	require.Equal("candidate", ss.Candidate())
+	require.EqualValues(3, ss.BucketIndex())
+	require.Equal(big.NewInt(100), ss.Amount())
+	require.Equal([]byte("payload"), ss.Payload())
+	gas, err := ss.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(SplitStakeBaseIntrinsicGas+7*SplitStakePayloadGas, gas)
+	cost, err := ss.Cost()
+	require.NoError(err)
+	require.Equal(new(big.Int).SetUint64(gas*10), cost)
+
+	ss2 := &SplitStake{}
+	require.NoError(ss2.LoadProto(ss.Proto()))
+	require.Equal(ss.Candidate(), ss2.Candidate())
+	require.Equal(ss.BucketIndex(), ss2.BucketIndex())
+	require.Equal(ss.Amount(), ss2.Amount())
+	require.Equal(ss.Payload(), ss2.Payload())
+
+	// the action is carried by the envelope
+	elp := (&EnvelopeBuilder{}).SetNonce(1).SetGasLimit(10000).SetAction(ss).Build()
+	elp2 := Envelope{}
+	require.NoError(elp2.LoadProto(elp.Proto()))
+	require.Equal(ss.Serialize(), elp2.Action().(*SplitStake).Serialize())
+
+	require.Error(ss2.LoadProto(nil))
+	require.Error(ss2.LoadProto(&iotextypes.StakeSplit{Amount: "abc"}))
+	require.NoError(ss2.LoadProto(&iotextypes.StakeSplit{}))
+	require.Equal(ErrInvalidAmount, errors.Cause(ss2.SanityCheck()))
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-address/address"
+)
+
+const (
+	_authorize = "This is to certify I authorize said operator to perform said operation on said bucket on IoTeX blockchain"
+)
+
+// Staking operations that a bucket owner can authorize
+const (
+	AuthorizeRestake         = "restake"
+	AuthorizeDepositToStake  = "deposit"
+	AuthorizeChangeCandidate = "changeCandidate"
+)
+
+type (
+	// a staking authorization is a staking action signed and paid by an operator, the payload of which contains an
+	// embedded message signed by the bucket owner
+	//
+	// operator: the entity/address to submit the staking action on behalf of the bucket owner
+	// authorizer: the owner of the bucket to be operated on
+	//
+	// the embedded message clearly identifies (1) the operation and its parameters, (2) the bucket, (3) the operator,
+	// (4) nonce of the operator, and (5) the last block height the authorization is valid at. The staking action is
+	// considered authorized by the bucket owner by verifying that:
+	// (1) the signature is valid
+	// (2) signer matches the actual owner of the bucket
+	// (3) operation, bucket and parameters in the message match those of the staking action
+	// (4) operator in the message matches the action caller
+	// (5) nonce in the message matches operator's nonce on blockchain, so the authorization cannot be replayed
+	// (6) the authorization has not expired at the current block height
+
+	// StakingAuthorization represents a staking authorization
+	StakingAuthorization interface {
+		Authorizer() address.Address
+		Operator() address.Address
+		AssetID() uint64
+		OperatorNonce() uint64
+		Expiry() uint64
+		Authorizes(Action) bool
+	}
+
+	// AuthorizeMsgEther is the staking authorization message format of Ethereum
+	AuthorizeMsgEther struct {
+		BucketIdx int    `json:"bucket"`
+		Operation string `json:"operation"`
+		Candidate string `json:"candidate,omitempty"`
+		Amount    string `json:"amount,omitempty"`
+		Duration  uint32 `json:"duration,omitempty"`
+		AutoStake bool   `json:"autoStake,omitempty"`
+		Operator  string `json:"operator"`
+		Nonce     int    `json:"nonce"`
+		Expiry    int    `json:"expiry"`
+		Authorize string `json:"authorize"`
+	}
+
+	authorization struct {
+		msg      AuthorizeMsgEther
+		signer   address.Address
+		operator address.Address
+	}
+)
+
+// NewStakingAuthorization creates a staking authorization from data, which uses the same JSON format as consignment
+func NewStakingAuthorization(data []byte) (StakingAuthorization, error) {
+	c := ConsignJSON{}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+
+	switch c.Type {
+	case "Ethereum":
+		return processAuthorizationEther(c)
+	default:
+		return nil, ErrNotSupported
+	}
+}
+
+func processAuthorizationEther(c ConsignJSON) (StakingAuthorization, error) {
+	// parse embedded msg
+	msg := AuthorizeMsgEther{}
+	if err := json.Unmarshal([]byte(c.Msg), &msg); err != nil {
+		return nil, err
+	}
+	if msg.Authorize != _authorize {
+		return nil, errors.New("authorize text does not match")
+	}
+
+	// verify signature
+	sig, err := hex.DecodeString(c.Sig)
+	if err != nil {
+		return nil, err
+	}
+	pk, err := RecoverPubkeyFromEccSig(c.Type, []byte(c.Msg), sig)
+	if err != nil {
+		return nil, err
+	}
+
+	auth := authorization{msg: msg}
+	auth.signer, err = address.FromBytes(pk.Hash())
+	if err != nil {
+		return nil, err
+	}
+	auth.operator, err = address.FromString(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	return &auth, nil
+}
+
+func (a *authorization) Authorizer() address.Address {
+	return a.signer
+}
+
+func (a *authorization) Operator() address.Address {
+	return a.operator
+}
+
+func (a *authorization) AssetID() uint64 {
+	return uint64(a.msg.BucketIdx)
+}
+
+func (a *authorization) OperatorNonce() uint64 {
+	return uint64(a.msg.Nonce)
+}
+
+func (a *authorization) Expiry() uint64 {
+	return uint64(a.msg.Expiry)
+}
+
+// Authorizes checks whether the operation, bucket and parameters of the action match the authorization
+func (a *authorization) Authorizes(act Action) bool {
+	msg, err := newAuthorizeMsgEther(a.msg.Operator, act, a.OperatorNonce(), a.Expiry())
+	if err != nil {
+		return false
+	}
+	return *msg == a.msg
+}
+
+func newAuthorizeMsgEther(operator string, act Action, nonce, expiry uint64) (*AuthorizeMsgEther, error) {
+	msg := AuthorizeMsgEther{
+		Operator:  operator,
+		Nonce:     int(nonce),
+		Expiry:    int(expiry),
+		Authorize: _authorize,
+	}
+	switch act := act.(type) {
+	case *Restake:
+		msg.BucketIdx = int(act.BucketIndex())
+		msg.Operation = AuthorizeRestake
+		msg.Duration = act.Duration()
+		msg.AutoStake = act.AutoStake()
+	case *DepositToStake:
+		msg.BucketIdx = int(act.BucketIndex())
+		msg.Operation = AuthorizeDepositToStake
+		msg.Amount = act.Amount().String()
+	case *ChangeCandidate:
+		msg.BucketIdx = int(act.BucketIndex())
+		msg.Operation = AuthorizeChangeCandidate
+		msg.Candidate = act.Candidate()
+	default:
+		return nil, errors.Wrapf(ErrNotSupported, "action %T cannot be authorized", act)
+	}
+	return &msg, nil
+}
+
+// NewAuthorizeMsg creates a staking authorization message for the action to be submitted by operator
+func NewAuthorizeMsg(sigType, operator string, act Action, nonce, expiry uint64) ([]byte, error) {
+	switch sigType {
+	case "Ethereum":
+		msg, err := newAuthorizeMsgEther(operator, act, nonce, expiry)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(msg)
+	default:
+		return nil, ErrNotSupported
+	}
+}
+
+// NewAuthorizeJSON creates a staking authorization JSON from inputs
+func NewAuthorizeJSON(sigType, operator, sig string, act Action, nonce, expiry uint64) ([]byte, error) {
+	msgBytes, err := NewAuthorizeMsg(sigType, operator, act, nonce, expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	msgJSON := ConsignJSON{
+		Type: sigType,
+		Msg:  string(msgBytes),
+		Sig:  sig,
+	}
+	return json.Marshal(msgJSON)
+}
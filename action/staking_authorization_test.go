@@ -0,0 +1,103 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestStakingAuthorization(t *testing.T) {
+	r := require.New(t)
+
+	owner := identityset.PrivateKey(1)
+	operator := identityset.Address(2).String()
+	restake, err := NewRestake(3, 47, 91, true, nil, 10000, big.NewInt(0))
+	r.NoError(err)
+
+	_, err = NewAuthorizeMsg("Trezor", operator, restake, 3, 100)
+	r.Equal(ErrNotSupported, err)
+	transfer, err := NewTransferStake(3, operator, 47, nil, 10000, big.NewInt(0))
+	r.NoError(err)
+	_, err = NewAuthorizeMsg("Ethereum", operator, transfer, 3, 100)
+	r.Equal(ErrNotSupported, errors.Cause(err))
+
+	// sign the authorization message by the bucket owner
+	msg, err := NewAuthorizeMsg("Ethereum", operator, restake, 3, 100)
+	r.NoError(err)
+	h, err := MsgHash("Ethereum", msg)
+	r.NoError(err)
+	sig, err := owner.Sign(h)
+	r.NoError(err)
+	b, err := NewAuthorizeJSON("Ethereum", operator, hex.EncodeToString(sig), restake, 3, 100)
+	r.NoError(err)
+
+	// process the payload as a staking authorization
+	auth, err := NewStakingAuthorization(b)
+	r.NoError(err)
+	r.Equal(identityset.Address(1).String(), auth.Authorizer().String())
+	r.Equal(operator, auth.Operator().String())
+	r.EqualValues(47, auth.AssetID())
+	r.EqualValues(3, auth.OperatorNonce())
+	r.EqualValues(100, auth.Expiry())
+	r.True(auth.Authorizes(restake))
+
+	// the operation and parameters have to match the authorization
+	for _, act := range []Action{
+		func() Action {
+			act, err := NewRestake(3, 47, 91, false, nil, 10000, big.NewInt(0))
+			r.NoError(err)
+			return act
+		}(),
+		func() Action {
+			act, err := NewRestake(3, 48, 91, true, nil, 10000, big.NewInt(0))
+			r.NoError(err)
+			return act
+		}(),
+		func() Action {
+			act, err := NewChangeCandidate(3, "candidate", 47, nil, 10000, big.NewInt(0))
+			r.NoError(err)
+			return act
+		}(),
+		transfer,
+	} {
+		r.False(auth.Authorizes(act))
+	}
+
+	// test wrong authorize text and unsupported signature type
+	c := &ConsignJSON{}
+	r.NoError(json.Unmarshal(b, c))
+	m := &AuthorizeMsgEther{}
+	r.NoError(json.Unmarshal([]byte(c.Msg), m))
+	m.Authorize = _reclaim
+	wrongMsg, err := json.Marshal(m)
+	r.NoError(err)
+	for _, v := range []*ConsignJSON{
+		{Type: "Ethereum", Msg: string(wrongMsg), Sig: c.Sig},
+		{Type: "Trezor", Msg: c.Msg, Sig: c.Sig},
+	} {
+		b, err = json.Marshal(v)
+		r.NoError(err)
+		auth, err = NewStakingAuthorization(b)
+		r.Error(err)
+		r.Nil(auth)
+	}
+
+	// a consignment cannot be processed as a staking authorization
+	v := sigTests[2]
+	b, err = NewConsignJSON("Ethereum", v.recipient, v.sig, 47, 136)
+	r.NoError(err)
+	_, err = NewStakingAuthorization(b)
+	r.Error(err)
+}
@@ -7,10 +7,13 @@
 package actpool
 
 import (
+	"container/heap"
 	"context"
+	"math/big"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -24,8 +27,10 @@ import (
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/prometheustimer"
+	"github.com/iotexproject/iotex-core/state"
 )
 
 var (
@@ -33,15 +38,31 @@ var (
 		Name: "iotex_actpool_rejection_metrics",
 		Help: "actpool metrics.",
 	}, []string{"type"})
+	actpoolReplacementMtc = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "iotex_actpool_replacement_metrics",
+		Help: "actpool replacement metrics.",
+	}, []string{"type"})
+	actpoolEvictionMtc = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "iotex_actpool_eviction_metrics",
+		Help: "actpool eviction metrics.",
+	}, []string{"type"})
+	actpoolJournalMtc = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "iotex_actpool_journal_metrics",
+		Help: "actpool journal metrics.",
+	}, []string{"type"})
 )
 
 func init() {
 	prometheus.MustRegister(actpoolMtc)
+	prometheus.MustRegister(actpoolReplacementMtc)
+	prometheus.MustRegister(actpoolEvictionMtc)
+	prometheus.MustRegister(actpoolJournalMtc)
 }
 
 // ActPool is the interface of actpool
 type ActPool interface {
 	action.SealedEnvelopeValidator
+	lifecycle.StartStopper
 	// Reset resets actpool state
 	Reset()
 	// PendingActionMap returns an action map with all accepted actions
@@ -66,10 +87,42 @@ type ActPool interface {
 	DeleteAction(address.Address)
 	// ReceiveBlock will be called when a new block is committed
 	ReceiveBlock(*block.Block) error
+	// AddSubscriber adds a subscriber to the actions added into pool
+	AddSubscriber(ActionSubscriber) error
 
 	AddActionEnvelopeValidators(...action.SealedEnvelopeValidator)
 }
 
+// ActionSubscriber is the interface of a subscriber to the actions added into pool
+type ActionSubscriber interface {
+	ReceiveAction(action.SealedEnvelope) error
+}
+
+// _subscriberBufferSize is the number of actions buffered for a subscriber, beyond which new actions are dropped
+// for the subscriber instead of blocking the pool
+const _subscriberBufferSize = 1000
+
+// actionSubscription includes the subscriber, the buffered channel of the actions to notify, and the cancel
+// channel to end the handler thread
+type actionSubscription struct {
+	subscriber ActionSubscriber
+	pending    chan action.SealedEnvelope
+	cancel     chan struct{}
+}
+
+func (s *actionSubscription) handler() {
+	for {
+		select {
+		case <-s.cancel:
+			return
+		case act := <-s.pending:
+			if err := s.subscriber.ReceiveAction(act); err != nil {
+				log.L().Error("Failed to handle new action.", zap.Error(err))
+			}
+		}
+	}
+}
+
 // SortedActions is a slice of actions that implements sort.Interface to sort by Value.
 type SortedActions []action.SealedEnvelope
 
@@ -77,6 +130,48 @@ func (p SortedActions) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
 func (p SortedActions) Len() int           { return len(p) }
 func (p SortedActions) Less(i, j int) bool { return p[i].Nonce() < p[j].Nonce() }
 
+// evictionCandidate is the nonce-sorted actions of an account, the tail of which is the next to evict
+type evictionCandidate struct {
+	sender string
+	acts   []action.SealedEnvelope
+}
+
+func (c *evictionCandidate) tail() *action.SealedEnvelope { return &c.acts[len(c.acts)-1] }
+
+// evictionCandidates is a min-heap of accounts ordered by the gas price of their tail actions
+type evictionCandidates []*evictionCandidate
+
+func (h evictionCandidates) Len() int { return len(h) }
+func (h evictionCandidates) Less(i, j int) bool {
+	return h[i].tail().GasPrice().Cmp(h[j].tail().GasPrice()) < 0
+}
+func (h evictionCandidates) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *evictionCandidates) Push(x interface{}) {
+	*h = append(*h, x.(*evictionCandidate))
+}
+
+func (h *evictionCandidates) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[0 : n-1]
+	return x
+}
+
+type localActionContextKey struct{}
+
+// WithLocalAction marks the actions added into pool with the context as locally received, which are recorded in the
+// journal if it is enabled
+func WithLocalAction(ctx context.Context) context.Context {
+	return context.WithValue(ctx, localActionContextKey{}, true)
+}
+
+func isLocalAction(ctx context.Context) bool {
+	local, ok := ctx.Value(localActionContextKey{}).(bool)
+	return ok && local
+}
+
 // Option sets action pool construction parameter
 type Option func(pool *actPool) error
 
@@ -101,6 +196,10 @@ type actPool struct {
 	timerFactory              *prometheustimer.TimerFactory
 	enableExperimentalActions bool
 	senderBlackList           map[string]bool
+	journal                   *journal
+	// localActs are the receiving time of the locally received actions in pool
+	localActs   map[hash.Hash256]time.Time
+	subscribers []*actionSubscription
 }
 
 // NewActPool constructs a new actpool
@@ -121,6 +220,7 @@ func NewActPool(sf protocol.StateReader, cfg config.ActPool, opts ...Option) (Ac
 		accountActs:     make(map[string]ActQueue),
 		accountDesActs:  make(map[string]map[hash.Hash256]action.SealedEnvelope),
 		allActions:      make(map[hash.Hash256]action.SealedEnvelope),
+		localActs:       make(map[hash.Hash256]time.Time),
 	}
 	for _, opt := range opts {
 		if err := opt(ap); err != nil {
@@ -140,6 +240,59 @@ func NewActPool(sf protocol.StateReader, cfg config.ActPool, opts ...Option) (Ac
 	return ap, nil
 }
 
+// Start replays the locally received actions in the journal into pool, and starts recording new ones
+func (ap *actPool) Start(ctx context.Context) error {
+	if ap.cfg.JournalPath == "" {
+		return nil
+	}
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+
+	journal := newJournal(ap.cfg.JournalPath)
+	entries, err := journal.load()
+	if err != nil {
+		return err
+	}
+	var replayed int
+	for _, entry := range entries {
+		if ap.cfg.ActionExpiry > 0 && time.Since(entry.received) > ap.cfg.ActionExpiry {
+			actpoolJournalMtc.WithLabelValues("expired").Inc()
+			continue
+		}
+		if err := ap.add(ctx, entry.act); err != nil {
+			actpoolJournalMtc.WithLabelValues("rejected").Inc()
+			log.L().Debug("Dropped action in journal.", zap.Error(err))
+			continue
+		}
+		ap.localActs[entry.act.Hash()] = entry.received
+		replayed++
+	}
+	actpoolJournalMtc.WithLabelValues("replayed").Add(float64(replayed))
+	log.L().Info("Loaded actpool journal.", zap.Int("entries", len(entries)), zap.Int("replayed", replayed))
+	if err := journal.rotate(ap.journalEntries()); err != nil {
+		return err
+	}
+	ap.journal = journal
+	return nil
+}
+
+// Stop stops recording locally received actions
+func (ap *actPool) Stop(_ context.Context) error {
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+
+	for _, s := range ap.subscribers {
+		close(s.cancel)
+	}
+	ap.subscribers = nil
+	if ap.journal == nil {
+		return nil
+	}
+	err := ap.journal.close()
+	ap.journal = nil
+	return err
+}
+
 func (ap *actPool) AddActionEnvelopeValidators(fs ...action.SealedEnvelopeValidator) {
 	ap.actionEnvelopeValidators = append(ap.actionEnvelopeValidators, fs...)
 }
@@ -157,6 +310,7 @@ func (ap *actPool) Reset() {
 	defer ap.mutex.Unlock()
 
 	ap.reset()
+	ap.rotateJournal()
 }
 
 func (ap *actPool) ReceiveBlock(*block.Block) error {
@@ -164,6 +318,7 @@ func (ap *actPool) ReceiveBlock(*block.Block) error {
 	defer ap.mutex.Unlock()
 
 	ap.reset()
+	ap.rotateJournal()
 	return nil
 }
 
@@ -183,20 +338,74 @@ func (ap *actPool) PendingActionMap() map[string][]action.SealedEnvelope {
 }
 
 func (ap *actPool) Add(ctx context.Context, act action.SealedEnvelope) error {
+	ap.mutex.Lock()
+	err := ap.add(ctx, act)
+	if err == nil && isLocalAction(ctx) {
+		ap.journalAction(act)
+	}
+	subscribers := ap.subscribers
+	ap.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	// Subscribers are notified asynchronously, and the action is dropped for a subscriber whose buffer is full,
+	// such that a slow subscriber doesn't block the pool
+	for _, s := range subscribers {
+		select {
+		case s.pending <- act:
+		default:
+			actpoolMtc.WithLabelValues("subscriberOverflow").Inc()
+		}
+	}
+	return nil
+}
+
+// AddSubscriber adds a subscriber to the actions added into pool
+func (ap *actPool) AddSubscriber(s ActionSubscriber) error {
+	if s == nil {
+		return errors.New("subscriber could not be nil")
+	}
 	ap.mutex.Lock()
 	defer ap.mutex.Unlock()
 
-	// Reject action if pool space is full
-	if uint64(len(ap.allActions)) >= ap.cfg.MaxNumActsPerPool {
-		actpoolMtc.WithLabelValues("overMaxNumActsPerPool").Inc()
-		return errors.Wrap(action.ErrActPool, "insufficient space for action")
+	subscription := &actionSubscription{
+		subscriber: s,
+		pending:    make(chan action.SealedEnvelope, _subscriberBufferSize),
+		cancel:     make(chan struct{}),
+	}
+	// create subscriber handler thread to handle the actions added into pool
+	go subscription.handler()
+	ap.subscribers = append(ap.subscribers, subscription)
+	return nil
+}
+
+func (ap *actPool) add(ctx context.Context, act action.SealedEnvelope) error {
+	caller, err := address.FromBytes(act.SrcPubkey().Hash())
+	if err != nil {
+		return err
+	}
+	// A replacement of a pending action takes the space of the replaced one
+	var replacedGas uint64
+	replaced, replacing := ap.pendingAction(caller.String(), act.Nonce())
+	if replacing {
+		replacedGas, _ = replaced.IntrinsicGas()
 	}
 	intrinsicGas, err := act.IntrinsicGas()
 	if err != nil {
 		actpoolMtc.WithLabelValues("failedGetIntrinsicGas").Inc()
 		return errors.Wrap(err, "failed to get action's intrinsic gas")
 	}
-	if ap.gasInPool+intrinsicGas > ap.cfg.MaxGasLimitPerPool {
+	// Make room for the action by evicting lower-priced actions if pool space is full, or reject the action
+	var evictions map[string]int
+	if !replacing {
+		if err := ap.checkAcctShare(caller.String()); err != nil {
+			return err
+		}
+		evictions, err = ap.evictionPlan(caller.String(), act, intrinsicGas)
+		if err != nil {
+			return err
+		}
+	} else if ap.gasInPool+intrinsicGas > ap.cfg.MaxGasLimitPerPool+replacedGas {
 		actpoolMtc.WithLabelValues("overMaxGasLimitPerPool").Inc()
 		return errors.Wrap(action.ErrActPool, "insufficient gas space for action")
 	}
@@ -219,12 +428,7 @@ func (ap *actPool) Add(ctx context.Context, act action.SealedEnvelope) error {
 	if err := ap.validate(ctx, act); err != nil {
 		return err
 	}
-
-	caller, err := address.FromBytes(act.SrcPubkey().Hash())
-	if err != nil {
-		return err
-	}
-	return ap.enqueueAction(caller.String(), act, hash, act.Nonce())
+	return ap.enqueueAction(caller.String(), act, hash, act.Nonce(), evictions)
 }
 
 // GetPendingNonce returns pending nonce in pool or confirmed nonce given an account address
@@ -340,7 +544,13 @@ func (ap *actPool) validate(ctx context.Context, selp action.SealedEnvelope) err
 //======================================
 // private functions
 //======================================
-func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHash hash.Hash256, actNonce uint64) error {
+func (ap *actPool) enqueueAction(
+	sender string,
+	act action.SealedEnvelope,
+	actHash hash.Hash256,
+	actNonce uint64,
+	evictions map[string]int,
+) error {
 	confirmedState, err := accountutil.AccountState(ap.sf, sender)
 	if err != nil {
 		actpoolMtc.WithLabelValues("failedToGetNonce").Inc()
@@ -365,9 +575,8 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHa
 		queue.SetPendingBalance(state.Balance)
 	}
 	if queue.Overlaps(act) {
-		// Nonce already exists
-		actpoolMtc.WithLabelValues("nonceUsed").Inc()
-		return errors.Wrapf(action.ErrNonce, "duplicate nonce for action %x", actHash)
+		// Nonce already exists, try to replace the pending action
+		return ap.replaceAction(sender, queue, act, actHash, confirmedState)
 	}
 
 	if actNonce-confirmedNonce-1 >= ap.cfg.MaxNumActsPerAcct {
@@ -402,17 +611,12 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHa
 		actpoolMtc.WithLabelValues("failedPutActQueue").Inc()
 		return errors.Wrapf(err, "cannot put action %x into ActQueue", actHash)
 	}
+	// Only make room for the action once it is accepted
+	ap.evictActions(evictions)
 	ap.allActions[actHash] = act
 
 	//add actions to destination map
-	desAddress, ok := act.Destination()
-	if ok && !strings.EqualFold(sender, desAddress) {
-		desQueue := ap.accountDesActs[desAddress]
-		if desQueue == nil {
-			ap.accountDesActs[desAddress] = make(map[hash.Hash256]action.SealedEnvelope)
-		}
-		ap.accountDesActs[desAddress][actHash] = act
-	}
+	ap.addDestinationAction(sender, act, actHash)
 
 	intrinsicGas, _ := act.IntrinsicGas()
 	ap.gasInPool += intrinsicGas
@@ -424,6 +628,205 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHa
 	return nil
 }
 
+// replaceAction replaces the pending action of the same nonce, if the gas price is higher by at least
+// GasPriceBumpPercent and the pending balance is sufficient for the new action
+func (ap *actPool) replaceAction(
+	sender string,
+	queue ActQueue,
+	act action.SealedEnvelope,
+	actHash hash.Hash256,
+	confirmedState *state.Account,
+) error {
+	actNonce := act.Nonce()
+	pending, _ := queue.Get(actNonce)
+	minGasPrice := new(big.Int).Mul(pending.GasPrice(), new(big.Int).SetUint64(100+ap.cfg.GasPriceBumpPercent))
+	minGasPrice.Div(minGasPrice, big.NewInt(100))
+	if act.GasPrice().Cmp(pending.GasPrice()) <= 0 || act.GasPrice().Cmp(minGasPrice) < 0 {
+		actpoolMtc.WithLabelValues("replacementUnderpriced").Inc()
+		return errors.Wrapf(
+			action.ErrGasPrice,
+			"gas price %s of action %x is not enough to replace the pending action of nonce %d, minimal gas price = %s",
+			act.GasPrice(),
+			actHash,
+			actNonce,
+			minGasPrice,
+		)
+	}
+
+	cost, err := act.Cost()
+	if err != nil {
+		actpoolMtc.WithLabelValues("failedToGetCost").Inc()
+		return errors.Wrapf(err, "failed to get cost of action %x", actHash)
+	}
+	// The cost of the pending action has been deducted from pending balance if it is payable
+	balance := new(big.Int).Set(queue.PendingBalance())
+	if actNonce < queue.PendingNonce() {
+		pendingCost, err := pending.Cost()
+		if err != nil {
+			actpoolMtc.WithLabelValues("failedToGetCost").Inc()
+			return errors.Wrapf(err, "failed to get cost of action %x", pending.Hash())
+		}
+		balance.Add(balance, pendingCost)
+	}
+	if balance.Cmp(cost) < 0 {
+		actpoolMtc.WithLabelValues("insufficientBalance").Inc()
+		return errors.Wrapf(
+			action.ErrBalance,
+			"insufficient balance for action %x, cost = %s, pending balance = %s, sender = %s",
+			actHash,
+			cost.String(),
+			balance.String(),
+			sender,
+		)
+	}
+
+	replaced, err := queue.Replace(act)
+	if err != nil {
+		actpoolMtc.WithLabelValues("failedReplaceActQueue").Inc()
+		return errors.Wrapf(err, "cannot replace action %x in ActQueue", actHash)
+	}
+	ap.removeInvalidActs([]action.SealedEnvelope{replaced})
+	ap.allActions[actHash] = act
+	ap.addDestinationAction(sender, act, actHash)
+	intrinsicGas, _ := act.IntrinsicGas()
+	ap.gasInPool += intrinsicGas
+	actpoolReplacementMtc.WithLabelValues("replaced").Inc()
+	log.L().Debug("Replaced pending action.",
+		log.Hex("hash", actHash[:]),
+		zap.Uint64("nonce", actNonce),
+		zap.String("gasPrice", act.GasPrice().String()))
+
+	// Re-evaluate the pending nonce and balance, since the cost of the replaced action may differ
+	queue.SetPendingBalance(confirmedState.Balance)
+	queue.SetPendingNonce(confirmedState.Nonce + 1)
+	ap.updateAccount(sender)
+	return nil
+}
+
+// checkAcctShare checks whether the sender holds less than its maximum share of the pool, such that it can add one
+// more action
+func (ap *actPool) checkAcctShare(sender string) error {
+	if ap.cfg.MaxAcctSharePercent == 0 {
+		return nil
+	}
+	queue, ok := ap.accountActs[sender]
+	if !ok {
+		return nil
+	}
+	if uint64(queue.Len()+1)*100 > ap.cfg.MaxNumActsPerPool*ap.cfg.MaxAcctSharePercent {
+		actpoolMtc.WithLabelValues("overMaxAcctShare").Inc()
+		return errors.Wrapf(action.ErrActPool, "account %s holds its maximum share of the pool", sender)
+	}
+	return nil
+}
+
+// evictionPlan checks whether there is space in pool for the action of the sender. If pool space is full, it plans
+// to evict the tail actions of other accounts with the lowest gas prices, which are lower than the action's, until
+// there is enough space. Actions priced no lower than the action are never evicted for it. The number of tail
+// actions to evict is returned by account, and an error is returned if there isn't enough space to make
+func (ap *actPool) evictionPlan(sender string, act action.SealedEnvelope, intrinsicGas uint64) (map[string]int, error) {
+	var numToFree, gasToFree uint64
+	if uint64(len(ap.allActions)) >= ap.cfg.MaxNumActsPerPool {
+		numToFree = uint64(len(ap.allActions)) + 1 - ap.cfg.MaxNumActsPerPool
+	}
+	if ap.gasInPool+intrinsicGas > ap.cfg.MaxGasLimitPerPool {
+		gasToFree = ap.gasInPool + intrinsicGas - ap.cfg.MaxGasLimitPerPool
+	}
+	if numToFree == 0 && gasToFree == 0 {
+		return nil, nil
+	}
+	overMaxMtc, overMaxErr := "overMaxNumActsPerPool", "insufficient space for action"
+	if numToFree == 0 {
+		overMaxMtc, overMaxErr = "overMaxGasLimitPerPool", "insufficient gas space for action"
+	}
+	candidates := evictionCandidates{}
+	for from, queue := range ap.accountActs {
+		if from == sender || queue.Empty() {
+			continue
+		}
+		candidates = append(candidates, &evictionCandidate{sender: from, acts: queue.AllActs()})
+	}
+	heap.Init(&candidates)
+	evictions := make(map[string]int)
+	var numFreed, gasFreed uint64
+	for numFreed < numToFree || gasFreed < gasToFree {
+		if candidates.Len() == 0 || candidates[0].tail().GasPrice().Cmp(act.GasPrice()) >= 0 {
+			actpoolMtc.WithLabelValues(overMaxMtc).Inc()
+			return nil, errors.Wrap(action.ErrActPool, overMaxErr)
+		}
+		candidate := candidates[0]
+		gas, _ := candidate.tail().IntrinsicGas()
+		numFreed++
+		gasFreed += gas
+		evictions[candidate.sender]++
+		// Actions are evicted from the tail, such that no nonce gap is left in the queue
+		candidate.acts = candidate.acts[:len(candidate.acts)-1]
+		if len(candidate.acts) == 0 {
+			heap.Pop(&candidates)
+		} else {
+			heap.Fix(&candidates, 0)
+		}
+	}
+	return evictions, nil
+}
+
+// evictActions removes the given number of tail actions from the queues of the accounts
+func (ap *actPool) evictActions(evictions map[string]int) {
+	for sender, num := range evictions {
+		queue, ok := ap.accountActs[sender]
+		if !ok {
+			continue
+		}
+		evicted := make([]action.SealedEnvelope, 0, num)
+		for i := 0; i < num; i++ {
+			act, ok := queue.PopTail()
+			if !ok {
+				break
+			}
+			evicted = append(evicted, act)
+		}
+		ap.removeInvalidActs(evicted)
+		actpoolEvictionMtc.WithLabelValues("evicted").Add(float64(len(evicted)))
+		log.L().Debug("Evicted actions for higher-priced actions.",
+			zap.String("sender", sender),
+			zap.Int("count", len(evicted)))
+		if queue.Empty() {
+			delete(ap.accountActs, sender)
+			continue
+		}
+		// Re-evaluate the pending nonce and balance, since the evicted actions may be pending
+		confirmedState, err := accountutil.AccountState(ap.sf, sender)
+		if err != nil {
+			log.L().Error("Error when updating account after eviction.", zap.Error(err))
+			continue
+		}
+		queue.SetPendingBalance(confirmedState.Balance)
+		queue.SetPendingNonce(confirmedState.Nonce + 1)
+		ap.updateAccount(sender)
+	}
+}
+
+// pendingAction returns the pending action of the sender and nonce in pool
+func (ap *actPool) pendingAction(sender string, nonce uint64) (action.SealedEnvelope, bool) {
+	queue, ok := ap.accountActs[sender]
+	if !ok {
+		return action.SealedEnvelope{}, false
+	}
+	return queue.Get(nonce)
+}
+
+// addDestinationAction adds the action to the destination map
+func (ap *actPool) addDestinationAction(sender string, act action.SealedEnvelope, actHash hash.Hash256) {
+	desAddress, ok := act.Destination()
+	if ok && !strings.EqualFold(sender, desAddress) {
+		desQueue := ap.accountDesActs[desAddress]
+		if desQueue == nil {
+			ap.accountDesActs[desAddress] = make(map[hash.Hash256]action.SealedEnvelope)
+		}
+		ap.accountDesActs[desAddress][actHash] = act
+	}
+}
+
 // removeConfirmedActs removes processed (committed to block) actions from pool
 func (ap *actPool) removeConfirmedActs() {
 	for from, queue := range ap.accountActs {
@@ -450,6 +853,7 @@ func (ap *actPool) removeInvalidActs(acts []action.SealedEnvelope) {
 		hash := act.Hash()
 		log.L().Debug("Removed invalidated action.", log.Hex("hash", hash[:]))
 		delete(ap.allActions, hash)
+		delete(ap.localActs, hash)
 		intrinsicGas, _ := act.IntrinsicGas()
 		ap.subGasFromPool(intrinsicGas)
 		//del actions in destination map
@@ -506,6 +910,42 @@ func (ap *actPool) reset() {
 	}
 }
 
+// journalAction records the locally received action in the journal
+func (ap *actPool) journalAction(act action.SealedEnvelope) {
+	received := time.Now()
+	ap.localActs[act.Hash()] = received
+	if ap.journal == nil {
+		return
+	}
+	if err := ap.journal.insert(journalEntry{act: act, received: received}); err != nil {
+		log.L().Warn("Failed to record action in journal.", zap.Error(err))
+	}
+}
+
+// rotateJournal regenerates the journal with the locally received actions in pool, once some of the recorded actions
+// have left the pool
+func (ap *actPool) rotateJournal() {
+	if ap.journal == nil || ap.journal.size <= len(ap.localActs) {
+		return
+	}
+	if err := ap.journal.rotate(ap.journalEntries()); err != nil {
+		log.L().Error("Failed to rotate actpool journal.", zap.Error(err))
+	}
+}
+
+// journalEntries returns the locally received actions in pool, sorted by sender and nonce
+func (ap *actPool) journalEntries() []journalEntry {
+	entries := make([]journalEntry, 0, len(ap.localActs))
+	for _, queue := range ap.accountActs {
+		for _, act := range queue.AllActs() {
+			if received, ok := ap.localActs[act.Hash()]; ok {
+				entries = append(entries, journalEntry{act: act, received: received})
+			}
+		}
+	}
+	return entries
+}
+
 func (ap *actPool) subGasFromPool(gas uint64) {
 	if ap.gasInPool < gas {
 		ap.gasInPool = 0
@@ -11,6 +11,7 @@ import (
 	"context"
 	"math/big"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/iotexproject/iotex-core/test/mock/mock_sealed_envelope_validator"
 
 	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/crypto"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
@@ -237,11 +239,11 @@ func TestActPool_AddActs(t *testing.T) {
 	err = ap3.Add(ctx, tsf10)
 	require.True(strings.Contains(err.Error(), "insufficient gas space for action"))
 
-	// Case IV: Nonce already exists
+	// Case IV: Nonce already exists and the gas price is not higher
 	replaceTsf, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(1), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
 	err = ap.Add(ctx, replaceTsf)
-	require.Equal(action.ErrNonce, errors.Cause(err))
+	require.Equal(action.ErrGasPrice, errors.Cause(err))
 	replaceTransfer, err := action.NewTransfer(uint64(4), big.NewInt(1), addr2, []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
 
@@ -254,7 +256,7 @@ func TestActPool_AddActs(t *testing.T) {
 	require.NoError(err)
 
 	err = ap.Add(ctx, selp)
-	require.Equal(action.ErrNonce, errors.Cause(err))
+	require.Equal(action.ErrGasPrice, errors.Cause(err))
 	// Case V: Nonce is too large
 	outOfBoundsTsf, err := testutil.SignedTransfer(addr1, priKey1, ap.cfg.MaxNumActsPerAcct+1, big.NewInt(1), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -289,6 +291,284 @@ func TestActPool_AddActs(t *testing.T) {
 	require.Equal(action.ErrInsufficientBalanceForGas, errors.Cause(err))
 }
 
+func TestActPool_ReplaceAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		acct.Nonce = 0
+		acct.Balance = big.NewInt(1000000)
+		return 0, nil
+	}).AnyTimes()
+	apConfig := getActPoolCfg()
+	apConfig.GasPriceBumpPercent = 10
+	Ap, err := NewActPool(sf, apConfig, EnableExperimentalActions())
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(sf, accountutil.AccountState))
+
+	ctx := context.Background()
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(10))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(10))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, tsf1))
+	require.NoError(ap.Add(ctx, tsf2))
+	gasInPool := ap.GetGasSize()
+
+	// the gas price is not bumped enough
+	tsf3, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(20), []byte{}, uint64(10000), big.NewInt(10))
+	require.NoError(err)
+	require.Equal(action.ErrGasPrice, errors.Cause(ap.Add(ctx, tsf3)))
+	// replace the pending action
+	tsf4, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(20), []byte{}, uint64(10000), big.NewInt(11))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, tsf4))
+	_, err = ap.GetActionByHash(tsf1.Hash())
+	require.Equal(action.ErrNotFound, errors.Cause(err))
+	act, err := ap.GetActionByHash(tsf4.Hash())
+	require.NoError(err)
+	require.Equal(tsf4, act)
+	require.Equal(uint64(2), ap.GetSize())
+	require.Equal(gasInPool, ap.GetGasSize())
+	require.Equal([]action.SealedEnvelope{tsf4, tsf2}, ap.GetUnconfirmedActs(addr1))
+	require.Equal([]action.SealedEnvelope{tsf4, tsf2}, ap.PendingActionMap()[addr1])
+	pNonce, err := ap.getPendingNonce(addr1)
+	require.NoError(err)
+	require.Equal(uint64(3), pNonce)
+	pBalance, err := ap.getPendingBalance(addr1)
+	require.NoError(err)
+	require.Equal(big.NewInt(1000000-110020-100010), pBalance)
+
+	// insufficient balance for the replacement
+	tsf5, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(800000), []byte{}, uint64(10000), big.NewInt(20))
+	require.NoError(err)
+	require.Equal(action.ErrBalance, errors.Cause(ap.Add(ctx, tsf5)))
+	// a replacement is accepted when the pool is full
+	ap.cfg.MaxNumActsPerPool = 2
+	tsf6, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(20))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, tsf6))
+	require.Equal([]action.SealedEnvelope{tsf4, tsf6}, ap.GetUnconfirmedActs(addr1))
+	tsf7, err := testutil.SignedTransfer(addr2, priKey1, uint64(3), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(20))
+	require.NoError(err)
+	require.Equal(action.ErrActPool, errors.Cause(ap.Add(ctx, tsf7)))
+}
+
+func TestActPool_EvictionFlood(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		acct.Nonce = 0
+		acct.Balance = big.NewInt(1000000)
+		return 0, nil
+	}).AnyTimes()
+	apConfig := getActPoolCfg()
+	apConfig.MaxNumActsPerPool = 6
+	Ap, err := NewActPool(sf, apConfig, EnableExperimentalActions())
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(sf, accountutil.AccountState))
+
+	ctx := context.Background()
+	transfer := func(priKey crypto.PrivateKey, nonce uint64, gasPrice int64) action.SealedEnvelope {
+		tsf, err := testutil.SignedTransfer(addr1, priKey, nonce, big.NewInt(10), []byte{}, uint64(10000), big.NewInt(gasPrice))
+		require.NoError(err)
+		return tsf
+	}
+	// the pool is flooded with the cheapest actions of the spammer
+	spam := make([]action.SealedEnvelope, 0, 5)
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		tsf := transfer(priKey2, nonce, 1)
+		require.NoError(ap.Add(ctx, tsf))
+		spam = append(spam, tsf)
+	}
+	tsf := transfer(priKey3, 1, 2)
+	require.NoError(ap.Add(ctx, tsf))
+	require.Equal(uint64(6), ap.GetSize())
+
+	// an action of the same gas price cannot evict others
+	require.Equal(action.ErrActPool, errors.Cause(ap.Add(ctx, transfer(priKey4, 1, 1))))
+	// a higher-priced action evicts the tail of the spammer
+	require.NoError(ap.Add(ctx, transfer(priKey4, 1, 2)))
+	require.Equal(uint64(6), ap.GetSize())
+	require.Equal(spam[:4], ap.GetUnconfirmedActs(addr2))
+	pNonce, err := ap.getPendingNonce(addr2)
+	require.NoError(err)
+	require.Equal(uint64(5), pNonce)
+	pBalance, err := ap.getPendingBalance(addr2)
+	require.NoError(err)
+	require.Equal(big.NewInt(1000000-4*10010), pBalance)
+
+	// actions are evicted for gas space too
+	ap.cfg.MaxNumActsPerPool = 100
+	ap.cfg.MaxGasLimitPerPool = ap.GetGasSize()
+	require.NoError(ap.Add(ctx, transfer(priKey4, 2, 2)))
+	require.Equal(spam[:3], ap.GetUnconfirmedActs(addr2))
+	require.Equal(ap.cfg.MaxGasLimitPerPool, ap.GetGasSize())
+	ap.cfg.MaxNumActsPerPool = 6
+
+	// a rejected action evicts nothing
+	require.Equal(action.ErrNonce, errors.Cause(ap.Add(ctx, transfer(priKey5, ap.cfg.MaxNumActsPerAcct+1, 5))))
+	require.Equal(spam[:3], ap.GetUnconfirmedActs(addr2))
+	// no matter how many actions an account holds, its lower-priced actions are evicted
+	require.NoError(ap.Add(ctx, transfer(priKey4, 3, 5)))
+	require.Equal(spam[:2], ap.GetUnconfirmedActs(addr2))
+	require.NoError(ap.Add(ctx, transfer(priKey5, 1, 3)))
+	require.NoError(ap.Add(ctx, transfer(priKey5, 2, 3)))
+	require.Empty(ap.GetUnconfirmedActs(addr2))
+	// the actions priced no lower than the action are not evicted
+	require.Equal(action.ErrActPool, errors.Cause(ap.Add(ctx, transfer(priKey1, 1, 2))))
+	require.Equal(uint64(6), ap.GetSize())
+	require.Equal([]action.SealedEnvelope{tsf}, ap.GetUnconfirmedActs(addr3))
+	require.Equal(6, lenPendingActionMap(ap.PendingActionMap()))
+}
+
+func TestActPool_AcctShare(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		acct.Nonce = 0
+		acct.Balance = big.NewInt(1000000)
+		return 0, nil
+	}).AnyTimes()
+	apConfig := getActPoolCfg()
+	apConfig.MaxNumActsPerPool = 10
+	apConfig.MaxAcctSharePercent = 50
+	Ap, err := NewActPool(sf, apConfig, EnableExperimentalActions())
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(sf, accountutil.AccountState))
+
+	ctx := context.Background()
+	transfer := func(priKey crypto.PrivateKey, nonce uint64, gasPrice int64) action.SealedEnvelope {
+		tsf, err := testutil.SignedTransfer(addr1, priKey, nonce, big.NewInt(10), []byte{}, uint64(10000), big.NewInt(gasPrice))
+		require.NoError(err)
+		return tsf
+	}
+	// the flooder holds at most half of the pool, no matter how high its gas price is
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		require.NoError(ap.Add(ctx, transfer(priKey2, nonce, 10)))
+	}
+	require.Equal(action.ErrActPool, errors.Cause(ap.Add(ctx, transfer(priKey2, 6, 10))))
+	require.Len(ap.GetUnconfirmedActs(addr2), 5)
+	// the pending action of the flooder can still be replaced
+	replacement := transfer(priKey2, 5, 20)
+	require.NoError(ap.Add(ctx, replacement))
+	require.Equal(replacement, ap.GetUnconfirmedActs(addr2)[4])
+
+	// other senders can still fill the rest of the pool
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		require.NoError(ap.Add(ctx, transfer(priKey3, nonce, 1)))
+	}
+	require.NoError(ap.Add(ctx, transfer(priKey4, 1, 1)))
+	require.NoError(ap.Add(ctx, transfer(priKey5, 1, 1)))
+	require.Equal(uint64(10), ap.GetSize())
+	// the flooder cannot evict the others for its higher gas price either
+	require.Equal(action.ErrActPool, errors.Cause(ap.Add(ctx, transfer(priKey2, 6, 30))))
+	require.Len(ap.GetUnconfirmedActs(addr3), 3)
+}
+
+type actionRecorder struct {
+	mutex   sync.Mutex
+	acts    []action.SealedEnvelope
+	block   chan struct{}
+	entered chan struct{}
+}
+
+func (r *actionRecorder) ReceiveAction(act action.SealedEnvelope) error {
+	if r.block != nil {
+		select {
+		case r.entered <- struct{}{}:
+		default:
+		}
+		<-r.block
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.acts = append(r.acts, act)
+	return nil
+}
+
+func (r *actionRecorder) received() []action.SealedEnvelope {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]action.SealedEnvelope{}, r.acts...)
+}
+
+func TestActPool_AddSubscriber(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		acct.Nonce = 0
+		acct.Balance = big.NewInt(1000000)
+		return 0, nil
+	}).AnyTimes()
+	ap, err := NewActPool(sf, getActPoolCfg(), EnableExperimentalActions())
+	require.NoError(err)
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(sf, accountutil.AccountState))
+	require.Error(ap.AddSubscriber(nil))
+	recorder := &actionRecorder{}
+	require.NoError(ap.AddSubscriber(recorder))
+
+	ctx := context.Background()
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(10))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, tsf1))
+	// rejected actions are not notified
+	require.Error(ap.Add(ctx, tsf1))
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(2000000), []byte{}, uint64(10000), big.NewInt(10))
+	require.NoError(err)
+	require.Equal(action.ErrBalance, errors.Cause(ap.Add(ctx, tsf2)))
+	require.NoError(testutil.WaitUntil(10*time.Millisecond, time.Second, func() (bool, error) {
+		return len(recorder.received()) == 1, nil
+	}))
+	require.Equal([]action.SealedEnvelope{tsf1}, recorder.received())
+
+	require.NoError(ap.Stop(ctx))
+
+	// a blocked subscriber doesn't block the pool, and the actions beyond its buffer are dropped
+	cfg := getActPoolCfg()
+	cfg.MaxNumActsPerAcct = 2 * _subscriberBufferSize
+	ap, err = NewActPool(sf, cfg, EnableExperimentalActions())
+	require.NoError(err)
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(sf, accountutil.AccountState))
+	blocked := &actionRecorder{block: make(chan struct{}), entered: make(chan struct{}, 1)}
+	require.NoError(ap.AddSubscriber(blocked))
+	for nonce := uint64(1); nonce <= _subscriberBufferSize+2; nonce++ {
+		tsf, err := testutil.SignedTransfer(addr2, priKey1, nonce, big.NewInt(1), []byte{}, uint64(10000), big.NewInt(0))
+		require.NoError(err)
+		require.NoError(ap.Add(ctx, tsf))
+		if nonce == 1 {
+			// wait until the subscriber is blocked in handling the first action
+			<-blocked.entered
+		}
+	}
+	close(blocked.block)
+	require.NoError(testutil.WaitUntil(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return len(blocked.received()) == _subscriberBufferSize+1, nil
+	}))
+	require.NoError(ap.Stop(ctx))
+}
+
 func TestActPool_PickActs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
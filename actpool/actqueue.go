@@ -51,7 +51,11 @@ func (h *noncePriorityQueue) Pop() interface{} {
 // ActQueue is the interface of actQueue
 type ActQueue interface {
 	Overlaps(action.SealedEnvelope) bool
+	Get(uint64) (action.SealedEnvelope, bool)
 	Put(action.SealedEnvelope) error
+	Replace(action.SealedEnvelope) (action.SealedEnvelope, error)
+	Tail() (action.SealedEnvelope, bool)
+	PopTail() (action.SealedEnvelope, bool)
 	FilterNonce(uint64) []action.SealedEnvelope
 	UpdateQueue(uint64) []action.SealedEnvelope
 	SetPendingNonce(uint64)
@@ -120,6 +124,61 @@ func (q *actQueue) Put(act action.SealedEnvelope) error {
 	return nil
 }
 
+// Get returns the action of the given nonce in the queue
+func (q *actQueue) Get(nonce uint64) (action.SealedEnvelope, bool) {
+	act, exist := q.items[nonce]
+	return act, exist
+}
+
+// Replace replaces the action of the same nonce in the map, also renewing the deadline of the nonce in the queue's
+// nonce index. The replaced action is returned
+func (q *actQueue) Replace(act action.SealedEnvelope) (action.SealedEnvelope, error) {
+	nonce := act.Nonce()
+	replaced, exist := q.items[nonce]
+	if !exist {
+		return action.SealedEnvelope{}, errors.Wrapf(action.ErrNonce, "nonce %d doesn't exist", nonce)
+	}
+	for i := range q.index {
+		if q.index[i].nonce == nonce {
+			q.index[i].deadline = q.clock.Now().Add(q.ttl)
+			break
+		}
+	}
+	q.items[nonce] = act
+	return replaced, nil
+}
+
+// Tail returns the action of the highest nonce in the queue
+func (q *actQueue) Tail() (action.SealedEnvelope, bool) {
+	if q.index.Len() == 0 {
+		return action.SealedEnvelope{}, false
+	}
+	return q.items[q.index[q.tailIndex()].nonce], true
+}
+
+// PopTail removes the action of the highest nonce from the map and the queue's nonce index, such that no nonce gap
+// is left in the queue
+func (q *actQueue) PopTail() (action.SealedEnvelope, bool) {
+	if q.index.Len() == 0 {
+		return action.SealedEnvelope{}, false
+	}
+	nonce := heap.Remove(&q.index, q.tailIndex()).(nonceWithTTL).nonce
+	act := q.items[nonce]
+	delete(q.items, nonce)
+	return act, true
+}
+
+// tailIndex returns the index of the highest nonce in the queue's nonce index
+func (q *actQueue) tailIndex() int {
+	tail := 0
+	for i := range q.index {
+		if q.index[i].nonce > q.index[tail].nonce {
+			tail = i
+		}
+	}
+	return tail
+}
+
 // FilterNonce removes all actions from the map with a nonce lower than the given threshold
 func (q *actQueue) FilterNonce(threshold uint64) []action.SealedEnvelope {
 	var removed []action.SealedEnvelope
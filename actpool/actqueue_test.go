@@ -14,6 +14,7 @@ import (
 
 	"github.com/facebookgo/clock"
 	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -77,6 +78,67 @@ func TestActQueuePut(t *testing.T) {
 	require.Error(q.Put(tsf3))
 }
 
+func TestActQueueReplace(t *testing.T) {
+	require := require.New(t)
+	c := clock.NewMock()
+	q := NewActQueue(nil, "", WithClock(c), WithTimeOut(time.Minute)).(*actQueue)
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(100), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(1000), nil, uint64(0), big.NewInt(1))
+	require.NoError(err)
+	_, err = q.Replace(tsf2)
+	require.Equal(action.ErrNonce, errors.Cause(err))
+	require.NoError(q.Put(tsf1))
+	act, ok := q.Get(1)
+	require.True(ok)
+	require.Equal(tsf1, act)
+
+	// the replacement renews the deadline
+	c.Add(30 * time.Second)
+	replaced, err := q.Replace(tsf2)
+	require.NoError(err)
+	require.Equal(tsf1, replaced)
+	act, ok = q.Get(1)
+	require.True(ok)
+	require.Equal(tsf2, act)
+	require.Equal(1, q.index.Len())
+	c.Add(45 * time.Second)
+	require.Empty(q.cleanTimeout())
+	c.Add(30 * time.Second)
+	require.Equal([]action.SealedEnvelope{tsf2}, q.cleanTimeout())
+	_, ok = q.Get(1)
+	require.False(ok)
+}
+
+func TestActQueuePopTail(t *testing.T) {
+	require := require.New(t)
+	q := NewActQueue(nil, "").(*actQueue)
+	_, ok := q.Tail()
+	require.False(ok)
+	_, ok = q.PopTail()
+	require.False(ok)
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(100), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, 2, big.NewInt(100), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr2, priKey1, 3, big.NewInt(100), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(q.Put(tsf3))
+	require.NoError(q.Put(tsf1))
+	require.NoError(q.Put(tsf2))
+	act, ok := q.Tail()
+	require.True(ok)
+	require.Equal(tsf3, act)
+	act, ok = q.PopTail()
+	require.True(ok)
+	require.Equal(tsf3, act)
+	act, ok = q.PopTail()
+	require.True(ok)
+	require.Equal(tsf2, act)
+	require.Equal([]action.SealedEnvelope{tsf1}, q.AllActs())
+	require.Equal(uint64(1), q.index[0].nonce)
+}
+
 func TestActQueueFilterNonce(t *testing.T) {
 	require := require.New(t)
 	q := NewActQueue(nil, "").(*actQueue)
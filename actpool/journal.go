@@ -0,0 +1,168 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// journalHeaderSize is the size of the header of a journal entry, i.e., the receiving time in unix nanoseconds and
+// the size of the encoded action
+const journalHeaderSize = 12
+
+type (
+	// journalEntry is a locally received action recorded in the journal
+	journalEntry struct {
+		act      action.SealedEnvelope
+		received time.Time
+	}
+
+	// journal is an append-only file of the locally received actions, which are replayed into the pool on restart
+	journal struct {
+		path   string
+		writer *os.File
+		// size is the number of entries in the file
+		size int
+	}
+)
+
+func newJournal(path string) *journal {
+	return &journal{path: path}
+}
+
+// load reads the entries in the journal file. A truncated or corrupted entry, e.g., the last one written before a
+// crash, ends the loading without failure
+func (j *journal) load() ([]journalEntry, error) {
+	file, err := os.Open(j.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open actpool journal %s", j.path)
+	}
+	defer file.Close()
+
+	var (
+		reader  = bufio.NewReader(file)
+		header  = make([]byte, journalHeaderSize)
+		entries []journalEntry
+	)
+	for {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			if err != io.EOF {
+				log.L().Warn("Truncated entry in actpool journal.", zap.Error(err))
+			}
+			return entries, nil
+		}
+		received := time.Unix(0, int64(binary.BigEndian.Uint64(header[:8])))
+		data := make([]byte, binary.BigEndian.Uint32(header[8:]))
+		if _, err := io.ReadFull(reader, data); err != nil {
+			log.L().Warn("Truncated entry in actpool journal.", zap.Error(err))
+			return entries, nil
+		}
+		actPb := &iotextypes.Action{}
+		if err := proto.Unmarshal(data, actPb); err != nil {
+			log.L().Warn("Corrupted entry in actpool journal.", zap.Error(err))
+			return entries, nil
+		}
+		var act action.SealedEnvelope
+		if err := act.LoadProto(actPb); err != nil {
+			log.L().Warn("Invalid action in actpool journal.", zap.Error(err))
+			continue
+		}
+		entries = append(entries, journalEntry{act: act, received: received})
+	}
+}
+
+// insert appends an entry to the journal file
+func (j *journal) insert(entry journalEntry) error {
+	if j.writer == nil {
+		return errors.New("actpool journal is not open")
+	}
+	data, err := encodeJournalEntry(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := j.writer.Write(data); err != nil {
+		return errors.Wrapf(err, "failed to write actpool journal %s", j.path)
+	}
+	j.size++
+	return nil
+}
+
+// rotate regenerates the journal file with the given entries, and opens it for appending
+func (j *journal) rotate(entries []journalEntry) error {
+	if err := j.close(); err != nil {
+		return err
+	}
+	tmpPath := j.path + ".new"
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create actpool journal %s", tmpPath)
+	}
+	writer := bufio.NewWriter(file)
+	for _, entry := range entries {
+		data, err := encodeJournalEntry(entry)
+		if err != nil {
+			file.Close()
+			return err
+		}
+		if _, err := writer.Write(data); err != nil {
+			file.Close()
+			return errors.Wrapf(err, "failed to write actpool journal %s", tmpPath)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		file.Close()
+		return errors.Wrapf(err, "failed to write actpool journal %s", tmpPath)
+	}
+	if err := file.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close actpool journal %s", tmpPath)
+	}
+	if err := os.Rename(tmpPath, j.path); err != nil {
+		return errors.Wrapf(err, "failed to replace actpool journal %s", j.path)
+	}
+	j.writer, err = os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open actpool journal %s", j.path)
+	}
+	j.size = len(entries)
+	return nil
+}
+
+// close closes the journal file
+func (j *journal) close() error {
+	if j.writer == nil {
+		return nil
+	}
+	err := j.writer.Close()
+	j.writer = nil
+	return errors.Wrapf(err, "failed to close actpool journal %s", j.path)
+}
+
+func encodeJournalEntry(entry journalEntry) ([]byte, error) {
+	data, err := proto.Marshal(entry.act.Proto())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encode action %x", entry.act.Hash())
+	}
+	buf := make([]byte, journalHeaderSize, journalHeaderSize+len(data))
+	binary.BigEndian.PutUint64(buf[:8], uint64(entry.received.UnixNano()))
+	binary.BigEndian.PutUint32(buf[8:], uint32(len(data)))
+	return append(buf, data...), nil
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestJournal(t *testing.T) {
+	require := require.New(t)
+	path, err := testutil.PathOfTempFile("actpool.journal")
+	require.NoError(err)
+	defer testutil.CleanupPath(t, path)
+
+	j := newJournal(path)
+	entries, err := j.load()
+	require.NoError(err)
+	require.Empty(entries)
+	require.Error(j.insert(journalEntry{}))
+
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(10), nil, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, 2, big.NewInt(10), nil, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	received := time.Unix(0, time.Now().UnixNano())
+	require.NoError(j.rotate([]journalEntry{{act: tsf1, received: received}}))
+	require.NoError(j.insert(journalEntry{act: tsf2, received: received}))
+	require.Equal(2, j.size)
+	require.NoError(j.close())
+	entries, err = j.load()
+	require.NoError(err)
+	require.Equal(2, len(entries))
+	require.Equal(tsf1.Hash(), entries[0].act.Hash())
+	require.Equal(tsf2.Hash(), entries[1].act.Hash())
+	require.True(received.Equal(entries[1].received))
+
+	// a truncated entry is dropped
+	info, err := os.Stat(path)
+	require.NoError(err)
+	require.NoError(os.Truncate(path, info.Size()-1))
+	entries, err = j.load()
+	require.NoError(err)
+	require.Equal(1, len(entries))
+	require.Equal(tsf1.Hash(), entries[0].act.Hash())
+}
+
+func TestActPool_Journal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		acct.Nonce = 0
+		acct.Balance = big.NewInt(1000000)
+		return 0, nil
+	}).AnyTimes()
+	path, err := testutil.PathOfTempFile("actpool.journal")
+	require.NoError(err)
+	defer testutil.CleanupPath(t, path)
+	apConfig := getActPoolCfg()
+	apConfig.ActionExpiry = 10 * time.Minute
+	apConfig.JournalPath = path
+	newActPool := func() *actPool {
+		ap, err := NewActPool(sf, apConfig, EnableExperimentalActions())
+		require.NoError(err)
+		ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(sf, accountutil.AccountState))
+		return ap.(*actPool)
+	}
+
+	// an expired action in journal is dropped
+	expired, err := testutil.SignedTransfer(addr4, priKey3, 1, big.NewInt(10), nil, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	require.NoError(newJournal(path).rotate([]journalEntry{{act: expired, received: time.Now().Add(-time.Hour)}}))
+
+	ctx := context.Background()
+	ap := newActPool()
+	require.NoError(ap.Start(ctx))
+	require.Zero(ap.GetSize())
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(10), nil, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, 2, big.NewInt(10), nil, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr3, priKey2, 1, big.NewInt(10), nil, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	require.NoError(ap.Add(WithLocalAction(ctx), tsf1))
+	require.NoError(ap.Add(WithLocalAction(ctx), tsf2))
+	// an action received from the network is not recorded
+	require.NoError(ap.Add(ctx, tsf3))
+	require.NoError(ap.Stop(ctx))
+
+	// the locally received actions are replayed on restart
+	ap = newActPool()
+	require.NoError(ap.Start(ctx))
+	require.Equal([]action.SealedEnvelope{tsf1, tsf2}, ap.GetUnconfirmedActs(addr1))
+	require.Equal(uint64(2), ap.GetSize())
+	require.Equal(2, ap.journal.size)
+
+	// the journal is rotated once the recorded actions leave the pool
+	caller, err := address.FromString(addr1)
+	require.NoError(err)
+	ap.DeleteAction(caller)
+	require.NoError(ap.ReceiveBlock(nil))
+	require.Zero(ap.journal.size)
+	require.NoError(ap.Stop(ctx))
+	entries, err := newJournal(path).load()
+	require.NoError(err)
+	require.Empty(entries)
+}
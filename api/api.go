@@ -13,9 +13,12 @@ import (
 	"math"
 	"math/big"
 	"net"
+	"sort"
 	"strconv"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
@@ -36,6 +39,7 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/action/protocol/poll"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
 	"github.com/iotexproject/iotex-core/actpool"
@@ -45,6 +49,7 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain/filedao"
 	"github.com/iotexproject/iotex-core/blockindex"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/consensus"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/gasstation"
 	"github.com/iotexproject/iotex-core/pkg/log"
@@ -69,6 +74,7 @@ type BroadcastOutbound func(ctx context.Context, chainID uint32, msg proto.Messa
 type Config struct {
 	broadcastHandler  BroadcastOutbound
 	electionCommittee committee.Committee
+	consensus         consensus.Consensus
 }
 
 // Option is the option to override the api config
@@ -90,6 +96,22 @@ func WithNativeElection(committee committee.Committee) Option {
 	}
 }
 
+// WithConsensus is the option to stream the logs of the consensus rounds through API.
+func WithConsensus(c consensus.Consensus) Option {
+	return func(cfg *Config) error {
+		cfg.consensus = c
+		return nil
+	}
+}
+
+// ActionTrace is the trace of re-executing an execution
+type ActionTrace struct {
+	ReturnValue []byte
+	Receipt     *action.Receipt
+	// Tracer holds the opcode-level trace, the call tree and the storage changes of the execution
+	Tracer *evm.Tracer
+}
+
 // Server provides api for user to query blockchain data
 type Server struct {
 	bc                blockchain.Blockchain
@@ -103,8 +125,10 @@ type Server struct {
 	registry          *protocol.Registry
 	chainListener     Listener
 	grpcServer        *grpc.Server
+	web3Server        *web3Server
 	hasActionIndex    bool
 	electionCommittee committee.Committee
+	consensus         consensus.Consensus
 }
 
 // NewServer creates a new server
@@ -146,6 +170,7 @@ func NewServer(
 		chainListener:     NewChainListener(),
 		gs:                gasstation.NewGasStation(chain, sf.SimulateExecution, dao, cfg.API),
 		electionCommittee: apiCfg.electionCommittee,
+		consensus:         apiCfg.consensus,
 	}
 	if _, ok := cfg.Plugins[config.GatewayPlugin]; ok {
 		svr.hasActionIndex = true
@@ -157,23 +182,33 @@ func NewServer(
 	iotexapi.RegisterAPIServiceServer(svr.grpcServer, svr)
 	grpc_prometheus.Register(svr.grpcServer)
 	reflection.Register(svr.grpcServer)
+	if cfg.API.Web3Port != 0 {
+		svr.web3Server = newWeb3Server(svr, cfg.API.Web3Port)
+	}
 
 	return svr, nil
 }
 
-// GetAccount returns the metadata of an account
+// GetAccount returns the metadata of an account, at the tip or at the height in the request
 func (api *Server) GetAccount(ctx context.Context, in *iotexapi.GetAccountRequest) (*iotexapi.GetAccountResponse, error) {
+	height, err := api.historyHeight(in.Height)
+	if err != nil {
+		return nil, err
+	}
 	if in.Address == address.RewardingPoolAddr || in.Address == address.StakingBucketPoolAddr {
-		return api.getProtocolAccount(ctx, in.Address)
+		return api.getProtocolAccount(ctx, in.Address, height)
 	}
 
-	state, tipHeight, err := accountutil.AccountStateWithHeight(api.sf, in.Address)
+	state, stateHeight, err := accountutil.AccountStateWithHeight(api.stateReaderAt(height), in.Address)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, historyStateError(err, codes.NotFound)
 	}
-	pendingNonce, err := api.ap.GetPendingNonce(in.Address)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	// there is no pending action at a history height
+	pendingNonce := state.Nonce + 1
+	if height == 0 {
+		if pendingNonce, err = api.ap.GetPendingNonce(in.Address); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 	}
 	if api.indexer == nil {
 		return nil, status.Error(codes.NotFound, blockindex.ErrActionIndexNA.Error())
@@ -194,14 +229,14 @@ func (api *Server) GetAccount(ctx context.Context, in *iotexapi.GetAccountReques
 		NumActions:   numActions,
 		IsContract:   state.IsContract(),
 	}
-	header, err := api.bc.BlockHeaderByHeight(tipHeight)
+	header, err := api.bc.BlockHeaderByHeight(stateHeight)
 	if err != nil {
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 	hash := header.HashBlock()
 	return &iotexapi.GetAccountResponse{AccountMeta: accountMeta, BlockIdentifier: &iotextypes.BlockIdentifier{
 		Hash:   hex.EncodeToString(hash[:]),
-		Height: tipHeight,
+		Height: stateHeight,
 	}}, nil
 }
 
@@ -251,7 +286,8 @@ func (api *Server) GetChainMeta(ctx context.Context, in *iotexapi.GetChainMetaRe
 	if tipHeight == 0 {
 		return &iotexapi.GetChainMetaResponse{
 			ChainMeta: &iotextypes.ChainMeta{
-				Epoch: &iotextypes.EpochData{},
+				Epoch:   &iotextypes.EpochData{},
+				ChainID: api.bc.ChainID(),
 			},
 		}, nil
 	}
@@ -299,6 +335,7 @@ func (api *Server) GetChainMeta(ctx context.Context, in *iotexapi.GetChainMetaRe
 		NumActions: int64(totalActions),
 		Tps:        int64(math.Ceil(float64(tps))),
 		TpsFloat:   tps,
+		ChainID:    api.bc.ChainID(),
 	}
 
 	rp := rolldpos.FindProtocol(api.registry)
@@ -339,7 +376,14 @@ func (api *Server) SendAction(ctx context.Context, in *iotexapi.SendActionReques
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	// Add to local actpool
+	chainID := api.bc.ChainID()
+	ctx = actpool.WithLocalAction(ctx)
 	ctx = protocol.WithRegistry(ctx, api.registry)
+	ctx = protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{
+		Genesis: api.cfg.Genesis,
+		ChainID: chainID,
+		Tip:     protocol.TipInfo{Height: api.bc.TipHeight()},
+	})
 	if err = api.ap.Add(ctx, selp); err != nil {
 		log.L().Debug(err.Error())
 		var desc string
@@ -356,6 +400,10 @@ func (api *Server) SendAction(ctx context.Context, in *iotexapi.SendActionReques
 			desc = "Invalid actpool"
 		case action.ErrGasPrice:
 			desc = "Invalid gas price"
+		case action.ErrChainID:
+			desc = "Invalid chain ID"
+		case action.ErrInvalidEncoding:
+			desc = "Invalid encoding"
 		default:
 			desc = "Unknown"
 		}
@@ -374,7 +422,7 @@ func (api *Server) SendAction(ctx context.Context, in *iotexapi.SendActionReques
 	}
 	// If there is no error putting into local actpool,
 	// Broadcast it to the network
-	if err = api.broadcastHandler(context.Background(), api.bc.ChainID(), in.Action); err != nil {
+	if err = api.broadcastHandler(context.Background(), chainID, in.Action); err != nil {
 		log.L().Warn("Failed to broadcast SendAction request.", zap.Error(err))
 	}
 	hash := selp.Hash()
@@ -406,7 +454,8 @@ func (api *Server) GetReceiptByAction(ctx context.Context, in *iotexapi.GetRecei
 	}, nil
 }
 
-// ReadContract reads the state in a contract address specified by the slot
+// ReadContract reads the state in a contract address specified by the slot, at the tip or at the height in the
+// request
 func (api *Server) ReadContract(ctx context.Context, in *iotexapi.ReadContractRequest) (*iotexapi.ReadContractResponse, error) {
 	log.L().Debug("receive read smart contract request")
 
@@ -414,10 +463,14 @@ func (api *Server) ReadContract(ctx context.Context, in *iotexapi.ReadContractRe
 	if err := sc.LoadProto(in.Execution); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	height, err := api.historyHeight(in.Height)
+	if err != nil {
+		return nil, err
+	}
 
-	state, err := accountutil.AccountState(api.sf, in.CallerAddress)
+	state, err := accountutil.AccountState(api.stateReaderAt(height), in.CallerAddress)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, historyStateError(err, codes.InvalidArgument)
 	}
 
 	sc, _ = action.NewExecution(
@@ -434,13 +487,23 @@ func (api *Server) ReadContract(ctx context.Context, in *iotexapi.ReadContractRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	ctx, err = api.bc.Context()
-	if err != nil {
-		return nil, err
+	var (
+		retval  []byte
+		receipt *action.Receipt
+	)
+	if height == 0 {
+		if ctx, err = api.bc.Context(); err != nil {
+			return nil, err
+		}
+		retval, receipt, err = api.sf.SimulateExecution(ctx, callerAddr, sc, api.dao.GetBlockHash)
+	} else {
+		if ctx, err = api.historyContext(ctx, height); err != nil {
+			return nil, err
+		}
+		retval, receipt, err = api.sf.SimulateExecutionAtHeight(ctx, height, callerAddr, sc, api.dao.GetBlockHash)
 	}
-	retval, receipt, err := api.sf.SimulateExecution(ctx, callerAddr, sc, api.dao.GetBlockHash)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, historyStateError(err, codes.Internal)
 	}
 	return &iotexapi.ReadContractResponse{
 		Data:    hex.EncodeToString(retval),
@@ -456,6 +519,9 @@ func (api *Server) ReadState(ctx context.Context, in *iotexapi.ReadStateRequest)
 	}
 	data, readStateHeight, err := api.readState(ctx, p, in.GetHeight(), in.MethodName, in.Arguments...)
 	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 	blkHash, err := api.dao.GetBlockHash(readStateHeight)
@@ -733,6 +799,51 @@ func (api *Server) StreamLogs(in *iotexapi.StreamLogsRequest, stream iotexapi.AP
 	}
 }
 
+// StreamPendingActions streams the actions entering actpool that match the filter
+func (api *Server) StreamPendingActions(in *iotexapi.StreamPendingActionsRequest, stream iotexapi.APIService_StreamPendingActionsServer) error {
+	if err := validatePendingActionsFilter(in.GetFilter()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	errChan := make(chan error)
+	// register the listener so it will match the actions entering actpool
+	if err := api.chainListener.AddResponder(NewPendingActionListener(in.GetFilter(), stream, errChan)); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	for {
+		select {
+		case err := <-errChan:
+			if err != nil {
+				err = status.Error(codes.Aborted, err.Error())
+			}
+			return err
+		}
+	}
+}
+
+// StreamRoundLogs streams the logs of the consensus rounds at the height, or of all rounds if the height is 0, once
+// the rounds are closed
+func (api *Server) StreamRoundLogs(in *iotexapi.StreamRoundLogsRequest, stream iotexapi.APIService_StreamRoundLogsServer) error {
+	if api.consensus == nil {
+		return status.Error(codes.Unavailable, "consensus round logs are not supported")
+	}
+	errChan := make(chan error)
+	// register the listener so it will receive the logs of the closed rounds
+	if err := api.chainListener.AddResponder(NewRoundLogListener(in.GetHeight(), stream, errChan)); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	for {
+		select {
+		case err := <-errChan:
+			if err != nil {
+				err = status.Error(codes.Aborted, err.Error())
+			}
+			return err
+		}
+	}
+}
+
 // GetElectionBuckets returns the native election buckets.
 func (api *Server) GetElectionBuckets(
 	ctx context.Context,
@@ -786,6 +897,142 @@ func (api *Server) GetActionByActionHash(h hash.Hash256) (action.SealedEnvelope,
 	return selp, err
 }
 
+// TraceAction re-runs a committed execution with the tracer, on top of the state of the parent block and the actions
+// before it in the block
+func (api *Server) TraceAction(ctx context.Context, in *iotexapi.TraceActionRequest) (*iotexapi.TraceActionResponse, error) {
+	h, err := hash.HexStringToHash256(in.ActionHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var cfg *vm.LogConfig
+	if in.StructLogs {
+		cfg = &vm.LogConfig{
+			DisableStorage: in.DisableStorage,
+			DisableMemory:  in.DisableMemory,
+			DisableStack:   in.DisableStack,
+			Limit:          int(in.Limit),
+		}
+	}
+	trace, err := api.traceAction(ctx, h, cfg)
+	if err != nil {
+		return nil, err
+	}
+	res := &iotexapi.TraceActionResponse{
+		Receipt:     trace.Receipt.ConvertToReceiptPb(),
+		ReturnValue: trace.ReturnValue,
+	}
+	for _, l := range trace.Tracer.StructLogs() {
+		res.StructLogs = append(res.StructLogs, structLogProto(&l))
+	}
+	if root := trace.Tracer.CallTree(); root != nil {
+		if res.CallTree, err = callFrameProto(root); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	if res.StorageChanges, err = storageChangesProto(trace.Tracer.StorageChanges()); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return res, nil
+}
+
+// GetActionProof returns the header of the block containing the action, and the Merkle audit path from the
+// action hash to the tx root of the header
+func (api *Server) GetActionProof(ctx context.Context, in *iotexapi.GetActionProofRequest) (*iotexapi.GetActionProofResponse, error) {
+	if !api.hasActionIndex || api.indexer == nil {
+		return nil, status.Error(codes.NotFound, blockindex.ErrActionIndexNA.Error())
+	}
+	h, err := hash.HexStringToHash256(in.ActionHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	actIndex, err := api.indexer.GetActionIndex(h[:])
+	if err != nil {
+		if errors.Cause(err) == db.ErrNotExist {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	blk, err := api.dao.GetBlockByHeight(actIndex.BlockHeight())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	index, proof, err := blk.TxProof(h)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &iotexapi.GetActionProofResponse{
+		BlockHeader: blk.Header.BlockHeaderProto(),
+		Index:       uint64(index),
+		Proof:       make([][]byte, len(proof)),
+	}
+	for i := range proof {
+		res.Proof[i] = proof[i][:]
+	}
+	return res, nil
+}
+
+// GetAccountProof returns the merkle proofs of an account and the storage slots of the contract at a block height
+func (api *Server) GetAccountProof(ctx context.Context, in *iotexapi.GetAccountProofRequest) (*iotexapi.GetAccountProofResponse, error) {
+	addr, err := address.FromString(in.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	height := in.Height
+	tipHeight := api.bc.TipHeight()
+	switch {
+	case height == 0:
+		height = tipHeight
+	case height > tipHeight:
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is higher than tip height", height)
+	}
+	storageKeys := make([]hash.Hash256, 0, len(in.StorageKeys))
+	for _, key := range in.StorageKeys {
+		if len(key) != len(hash.ZeroHash256) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid storage key %x", key)
+		}
+		storageKeys = append(storageKeys, hash.BytesToHash256(key))
+	}
+	addrHash := hash.BytesToHash160(addr.Bytes())
+	stateProof, err := api.sf.ProofAtHeight(height, protocol.LegacyKeyOption(addrHash))
+	if err != nil {
+		return nil, historyStateError(err, codes.Internal)
+	}
+	res := &iotexapi.GetAccountProofResponse{
+		Height:         stateProof.Height,
+		StateRoot:      stateProof.RootHash,
+		NamespaceProof: stateProof.NamespaceProof,
+		AccountProof:   stateProof.KeyProof,
+	}
+	if len(storageKeys) == 0 {
+		return res, nil
+	}
+	account := state.EmptyAccount()
+	value, err := factory.VerifyStateProof(stateProof, protocol.LegacyKeyOption(addrHash))
+	switch errors.Cause(err) {
+	case nil:
+		if err := state.Deserialize(&account, value); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	case state.ErrStateNotExist:
+		// proof of absence, the account has no storage
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// the storage is read at the height of the account proof, even if the tip moves on
+	sr := api.stateReaderAt(height)
+	for _, key := range storageKeys {
+		storageProof, err := evm.StorageProof(sr, addrHash, account.Root, key)
+		if err != nil {
+			return nil, historyStateError(err, codes.Internal)
+		}
+		res.StorageProofs = append(res.StorageProofs, &iotexapi.StorageProof{
+			Key:   key[:],
+			Proof: storageProof,
+		})
+	}
+	return res, nil
+}
+
 // GetEvmTransfersByActionHash returns evm transfers by action hash
 func (api *Server) GetEvmTransfersByActionHash(ctx context.Context, in *iotexapi.GetEvmTransfersByActionHashRequest) (*iotexapi.GetEvmTransfersByActionHashResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "evm transfer index is deprecated, call GetSystemLogByActionHash instead")
@@ -897,9 +1144,22 @@ func (api *Server) Start() error {
 			log.L().Fatal("Node failed to serve.", zap.Error(err))
 		}
 	}()
+	if api.web3Server != nil {
+		if err := api.web3Server.Start(); err != nil {
+			return err
+		}
+	}
 	if err := api.bc.AddSubscriber(api.chainListener); err != nil {
 		return errors.Wrap(err, "failed to subscribe to block creations")
 	}
+	if err := api.ap.AddSubscriber(api.chainListener); err != nil {
+		return errors.Wrap(err, "failed to subscribe to new actions")
+	}
+	if api.consensus != nil {
+		if err := api.consensus.AddRoundLogSubscriber(api.chainListener); err != nil {
+			return errors.Wrap(err, "failed to subscribe to consensus round logs")
+		}
+	}
 	if err := api.chainListener.Start(); err != nil {
 		return errors.Wrap(err, "failed to start blockchain listener")
 	}
@@ -909,6 +1169,11 @@ func (api *Server) Start() error {
 // Stop stops the API server
 func (api *Server) Stop() error {
 	api.grpcServer.Stop()
+	if api.web3Server != nil {
+		if err := api.web3Server.Stop(); err != nil {
+			return errors.Wrap(err, "failed to stop web3 server")
+		}
+	}
 	if err := api.bc.RemoveSubscriber(api.chainListener); err != nil {
 		return errors.Wrap(err, "failed to unsubscribe blockchain listener")
 	}
@@ -928,21 +1193,22 @@ func (api *Server) readState(ctx context.Context, p protocol.Protocol, height st
 		},
 	)
 
-	rp := rolldpos.FindProtocol(api.registry)
-	if rp == nil {
-		return nil, uint64(0), errors.New("rolldpos is not registered")
-	}
-
-	tipEpochNum := rp.GetEpochNum(tipHeight)
 	if height != "" {
 		inputHeight, err := strconv.ParseUint(height, 0, 64)
 		if err != nil {
-			return nil, uint64(0), err
+			return nil, uint64(0), status.Error(codes.InvalidArgument, err.Error())
+		}
+		if inputHeight > tipHeight {
+			return nil, uint64(0), status.Errorf(codes.InvalidArgument, "height %d is higher than tip height %d", inputHeight, tipHeight)
 		}
-		inputEpochNum := rp.GetEpochNum(inputHeight)
-		if inputEpochNum < tipEpochNum {
-			// old data, wrap to history state reader
-			return p.ReadState(ctx, factory.NewHistoryStateReader(api.sf, rp.GetEpochHeight(inputEpochNum)), methodName, arguments...)
+		if inputHeight < tipHeight {
+			// old data, wrap to history state reader at the height
+			ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: inputHeight})
+			data, stateHeight, err := p.ReadState(ctx, factory.NewHistoryStateReader(api.sf, inputHeight), methodName, arguments...)
+			if err != nil {
+				return nil, uint64(0), historyStateError(err, codes.NotFound)
+			}
+			return data, stateHeight, nil
 		}
 	}
 
@@ -1118,7 +1384,7 @@ func (api *Server) getUnconfirmedActionsByAddress(address string, start uint64,
 
 	var res []*iotexapi.ActionInfo
 	for i := start; i < uint64(len(selps)) && i < start+count; i++ {
-		act, err := api.pendingAction(selps[i])
+		act, err := pendingAction(selps[i])
 		if err != nil {
 			continue
 		}
@@ -1368,7 +1634,7 @@ func (api *Server) committedAction(selp action.SealedEnvelope, blkHash hash.Hash
 	}, nil
 }
 
-func (api *Server) pendingAction(selp action.SealedEnvelope) (*iotexapi.ActionInfo, error) {
+func pendingAction(selp action.SealedEnvelope) (*iotexapi.ActionInfo, error) {
 	actHash := selp.Hash()
 	sender, _ := address.FromBytes(selp.SrcPubkey().Hash())
 	return &iotexapi.ActionInfo{
@@ -1393,7 +1659,7 @@ func (api *Server) getAction(actHash hash.Hash256, checkPending bool) (*iotexapi
 	if err != nil {
 		return nil, err
 	}
-	return api.pendingAction(selp)
+	return pendingAction(selp)
 }
 
 func (api *Server) actionsInBlock(blk *block.Block, start, count uint64) []*iotexapi.ActionInfo {
@@ -1596,7 +1862,7 @@ func (api *Server) getProductivityByEpoch(
 	return num, produce, nil
 }
 
-func (api *Server) getProtocolAccount(ctx context.Context, addr string) (ret *iotexapi.GetAccountResponse, err error) {
+func (api *Server) getProtocolAccount(ctx context.Context, addr string, height uint64) (ret *iotexapi.GetAccountResponse, err error) {
 	var req *iotexapi.ReadStateRequest
 	var balance string
 	var out *iotexapi.ReadStateResponse
@@ -1606,6 +1872,9 @@ func (api *Server) getProtocolAccount(ctx context.Context, addr string) (ret *io
 			ProtocolID: []byte("rewarding"),
 			MethodName: []byte("TotalBalance"),
 		}
+		if height != 0 {
+			req.Height = strconv.FormatUint(height, 10)
+		}
 		out, err = api.ReadState(ctx, req)
 		if err != nil {
 			return
@@ -1636,6 +1905,9 @@ func (api *Server) getProtocolAccount(ctx context.Context, addr string) (ret *io
 			MethodName: methodName,
 			Arguments:  [][]byte{arg},
 		}
+		if height != 0 {
+			req.Height = strconv.FormatUint(height, 10)
+		}
 		out, err = api.ReadState(ctx, req)
 		if err != nil {
 			return nil, err
@@ -1656,3 +1928,180 @@ func (api *Server) getProtocolAccount(ctx context.Context, addr string) (ret *io
 	}
 	return
 }
+
+// historyHeight checks the height in the request, and returns 0 if the state at tip is requested
+func (api *Server) historyHeight(height uint64) (uint64, error) {
+	tipHeight := api.bc.TipHeight()
+	switch {
+	case height > tipHeight:
+		return 0, status.Errorf(codes.InvalidArgument, "height %d is higher than tip height %d", height, tipHeight)
+	case height == tipHeight:
+		return 0, nil
+	}
+	return height, nil
+}
+
+// stateReaderAt returns the state reader at the height, or at the tip if the height is 0
+func (api *Server) stateReaderAt(height uint64) protocol.StateReader {
+	if height == 0 {
+		return api.sf
+	}
+	return factory.NewHistoryStateReader(api.sf, height)
+}
+
+// traceAction re-runs a committed execution on top of the state of the parent block and the actions before it in the
+// block, and traces the execution as configured, or without the opcode-level trace if cfg is nil. The state of the
+// parent block is kept in archive mode, or if the block is the tip
+func (api *Server) traceAction(ctx context.Context, h hash.Hash256, cfg *vm.LogConfig) (*ActionTrace, error) {
+	if !api.hasActionIndex || api.indexer == nil {
+		return nil, status.Error(codes.NotFound, blockindex.ErrActionIndexNA.Error())
+	}
+	selp, _, height, err := api.getActionByActionHash(h)
+	if err != nil {
+		if errors.Cause(err) == db.ErrNotExist {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if _, ok := selp.Action().(*action.Execution); !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "action %x is not an execution", h)
+	}
+	blk, err := api.dao.GetBlockByHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	ctx, err = api.historyContext(ctx, height-1)
+	if err != nil {
+		return nil, err
+	}
+	tracer := evm.NewTracer(cfg)
+	receipt, err := api.sf.TraceAction(ctx, blk, h, tracer)
+	if err != nil {
+		return nil, historyStateError(err, codes.Internal)
+	}
+	trace := ActionTrace{
+		Receipt: receipt,
+		Tracer:  tracer,
+	}
+	if root := tracer.CallTree(); root != nil {
+		trace.ReturnValue = root.Output
+	}
+	return &trace, nil
+}
+
+// historyContext returns the context to simulate an execution on top of the block at the height
+func (api *Server) historyContext(ctx context.Context, height uint64) (context.Context, error) {
+	header, err := api.bc.BlockHeaderByHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{
+		Genesis: api.cfg.Genesis,
+		ChainID: api.bc.ChainID(),
+		Tip: protocol.TipInfo{
+			Height:    height,
+			Hash:      header.HashBlock(),
+			Timestamp: header.Timestamp(),
+		},
+	}), nil
+}
+
+// historyStateError converts the error of reading states to a status error, such that a missing or pruned history
+// state is distinguishable from other errors
+func historyStateError(err error, code codes.Code) error {
+	switch errors.Cause(err) {
+	case factory.ErrNoArchiveData, factory.ErrNotSupported:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case factory.ErrHistoryPruned:
+		return status.Error(codes.OutOfRange, err.Error())
+	}
+	return status.Error(code, err.Error())
+}
+
+func structLogProto(l *vm.StructLog) *iotexapi.StructLog {
+	pb := &iotexapi.StructLog{
+		Pc:      l.Pc,
+		Op:      l.OpName(),
+		Gas:     l.Gas,
+		GasCost: l.GasCost,
+		Depth:   int64(l.Depth),
+		Memory:  l.Memory,
+		Error:   l.ErrorString(),
+	}
+	for _, v := range l.Stack {
+		pb.Stack = append(pb.Stack, common.BigToHash(v).Bytes())
+	}
+	keys := make([]common.Hash, 0, len(l.Storage))
+	for k := range l.Storage {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i][:], keys[j][:]) < 0 })
+	for _, k := range keys {
+		v := l.Storage[k]
+		pb.Storage = append(pb.Storage, &iotexapi.StorageSlot{Key: k.Bytes(), Value: v.Bytes()})
+	}
+	return pb
+}
+
+func callFrameProto(frame *evm.CallFrame) (*iotexapi.CallFrame, error) {
+	from, err := address.FromBytes(frame.From.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	to, err := address.FromBytes(frame.To.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	pb := &iotexapi.CallFrame{
+		Type:    frame.Type,
+		From:    from.String(),
+		To:      to.String(),
+		Gas:     frame.Gas,
+		GasUsed: frame.GasUsed,
+		Input:   frame.Input,
+		Output:  frame.Output,
+		Error:   frame.Error,
+	}
+	if frame.Value != nil {
+		pb.Value = frame.Value.String()
+	}
+	for _, call := range frame.Calls {
+		callPb, err := callFrameProto(call)
+		if err != nil {
+			return nil, err
+		}
+		pb.Calls = append(pb.Calls, callPb)
+	}
+	return pb, nil
+}
+
+// storageChangesProto converts the storage changes into proto, which are sorted by contract and key
+func storageChangesProto(changes map[common.Address]map[common.Hash]*evm.StorageChange) ([]*iotexapi.StorageChange, error) {
+	contracts := make([]common.Address, 0, len(changes))
+	for addr := range changes {
+		contracts = append(contracts, addr)
+	}
+	sort.Slice(contracts, func(i, j int) bool { return bytes.Compare(contracts[i][:], contracts[j][:]) < 0 })
+	var pbs []*iotexapi.StorageChange
+	for _, contract := range contracts {
+		addr, err := address.FromBytes(contract.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		slots := changes[contract]
+		keys := make([]common.Hash, 0, len(slots))
+		for k := range slots {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i][:], keys[j][:]) < 0 })
+		for _, k := range keys {
+			pbs = append(pbs, &iotexapi.StorageChange{
+				Contract: addr.String(),
+				Key:      k.Bytes(),
+				Original: slots[k].Original.Bytes(),
+				Current:  slots[k].Current.Bytes(),
+			})
+		}
+	}
+	return pbs, nil
+}
@@ -24,6 +24,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-election/test/mock/mock_committee"
 	"github.com/iotexproject/iotex-proto/golang/iotexapi"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
@@ -43,6 +44,8 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/blockindex"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/consensus/scheme"
+	"github.com/iotexproject/iotex-core/crypto"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/gasstation"
 	"github.com/iotexproject/iotex-core/pkg/unit"
@@ -50,7 +53,9 @@ import (
 	"github.com/iotexproject/iotex-core/state/factory"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/test/mock/mock_actpool"
+	"github.com/iotexproject/iotex-core/test/mock/mock_apiserver"
 	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
+	"github.com/iotexproject/iotex-core/test/mock/mock_consensus"
 	"github.com/iotexproject/iotex-core/testutil"
 )
 
@@ -780,6 +785,158 @@ func TestServer_GetAccount(t *testing.T) {
 	require.Error(err)
 }
 
+func TestServer_GetAccountProof(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+
+	tipHeight := svr.bc.TipHeight()
+	for _, test := range getAccountTests {
+		res, err := svr.GetAccountProof(context.Background(), &iotexapi.GetAccountProofRequest{
+			Address:     test.in,
+			StorageKeys: [][]byte{hash.ZeroHash256[:]},
+		})
+		require.NoError(err)
+		require.Equal(tipHeight, res.Height)
+		addr, err := address.FromString(test.in)
+		require.NoError(err)
+		value, err := factory.VerifyStateProof(&factory.StateProof{
+			Height:         res.Height,
+			RootHash:       res.StateRoot,
+			NamespaceProof: res.NamespaceProof,
+			KeyProof:       res.AccountProof,
+		}, protocol.LegacyKeyOption(hash.BytesToHash160(addr.Bytes())))
+		require.NoError(err)
+		var account state.Account
+		require.NoError(state.Deserialize(&account, value))
+		require.Equal(test.balance, account.Balance.String())
+		require.Equal(test.nonce, account.Nonce)
+		// account without storage
+		require.Equal(1, len(res.StorageProofs))
+		require.Equal(hash.ZeroHash256[:], res.StorageProofs[0].Key)
+		require.Nil(res.StorageProofs[0].Proof)
+	}
+	// proof of absence
+	addr := identityset.Address(33)
+	res, err := svr.GetAccountProof(context.Background(), &iotexapi.GetAccountProofRequest{
+		Address: addr.String(),
+		Height:  tipHeight,
+	})
+	require.NoError(err)
+	_, err = factory.VerifyStateProof(&factory.StateProof{
+		RootHash:       res.StateRoot,
+		NamespaceProof: res.NamespaceProof,
+		KeyProof:       res.AccountProof,
+	}, protocol.LegacyKeyOption(hash.BytesToHash160(addr.Bytes())))
+	require.Equal(state.ErrStateNotExist, errors.Cause(err))
+	// failure
+	for _, in := range []*iotexapi.GetAccountProofRequest{
+		{Address: ""},
+		{Address: identityset.Address(27).String(), Height: tipHeight + 1},
+		{Address: identityset.Address(27).String(), StorageKeys: [][]byte{{1, 2, 3}}},
+	} {
+		_, err = svr.GetAccountProof(context.Background(), in)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	}
+	// history proof beyond the parent of the tip requires archive mode
+	_, err = svr.GetAccountProof(context.Background(), &iotexapi.GetAccountProofRequest{
+		Address: identityset.Address(27).String(),
+		Height:  tipHeight - 2,
+	})
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+}
+
+func TestServer_TraceAction(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+	cfg.Chain.EnableArchiveMode = true
+
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+
+	// the execution follows the transfers of the same sender in the block
+	res, err := svr.TraceAction(context.Background(), &iotexapi.TraceActionRequest{
+		ActionHash: hex.EncodeToString(executionHash1[:]),
+		StructLogs: true,
+	})
+	require.NoError(err)
+	require.Equal(iotextypes.ReceiptStatus_Success, iotextypes.ReceiptStatus(res.Receipt.Status))
+	require.Equal(executionHash1[:], res.Receipt.ActHash)
+	root := res.CallTree
+	require.Equal("CALL", root.Type)
+	require.Equal(identityset.Address(30).String(), root.From)
+	require.Equal(identityset.Address(31).String(), root.To)
+	require.Equal("1", root.Value)
+	require.Equal([]byte{1}, root.Input)
+	require.Empty(root.Error)
+	require.Empty(root.Calls)
+	// the account has no code to run
+	require.Empty(res.StructLogs)
+	require.Empty(res.StorageChanges)
+	require.Empty(res.ReturnValue)
+
+	// failure
+	for _, c := range []struct {
+		actHash string
+		code    codes.Code
+	}{
+		{"abc", codes.InvalidArgument},
+		{hex.EncodeToString(transferHash1[:]), codes.InvalidArgument},
+		{hex.EncodeToString(hash.ZeroHash256[:]), codes.NotFound},
+	} {
+		_, err = svr.TraceAction(context.Background(), &iotexapi.TraceActionRequest{ActionHash: c.actHash})
+		require.Equal(c.code, status.Code(err))
+	}
+
+	// the state of the parent of the tip is kept without archive mode
+	cfg.Chain.EnableArchiveMode = false
+	svr, err = createServer(cfg, false)
+	require.NoError(err)
+	res, err = svr.TraceAction(context.Background(), &iotexapi.TraceActionRequest{
+		ActionHash: hex.EncodeToString(executionHash3[:]),
+	})
+	require.NoError(err)
+	require.Equal(iotextypes.ReceiptStatus_Success, iotextypes.ReceiptStatus(res.Receipt.Status))
+	require.Equal(identityset.Address(28).String(), res.CallTree.From)
+	_, err = svr.TraceAction(context.Background(), &iotexapi.TraceActionRequest{
+		ActionHash: hex.EncodeToString(executionHash1[:]),
+	})
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+}
+
+func TestServer_GetActionProof(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+
+	for _, test := range getReceiptByActionTests {
+		h, err := hash.HexStringToHash256(test.in)
+		require.NoError(err)
+		res, err := svr.GetActionProof(context.Background(), &iotexapi.GetActionProofRequest{ActionHash: test.in})
+		require.NoError(err)
+		header := &block.Header{}
+		require.NoError(header.LoadFromBlockHeaderProto(res.BlockHeader))
+		require.Equal(test.blkHeight, header.Height())
+		proof := make([]hash.Hash256, len(res.Proof))
+		for i := range res.Proof {
+			proof[i] = hash.BytesToHash256(res.Proof[i])
+		}
+		require.True(crypto.VerifyMerkleProof(header.TxRoot(), h, int(res.Index), proof))
+	}
+	// failure
+	_, err = svr.GetActionProof(context.Background(), &iotexapi.GetActionProofRequest{ActionHash: "0x"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = svr.GetActionProof(context.Background(), &iotexapi.GetActionProofRequest{ActionHash: hex.EncodeToString(hash.ZeroHash256[:])})
+	require.Equal(codes.NotFound, status.Code(err))
+	svr.hasActionIndex = false
+	_, err = svr.GetActionProof(context.Background(), &iotexapi.GetActionProofRequest{ActionHash: hex.EncodeToString(hash.ZeroHash256[:])})
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
 func TestServer_GetActions(t *testing.T) {
 	require := require.New(t)
 	cfg := newConfig(t)
@@ -1063,11 +1220,13 @@ func TestServer_GetChainMeta(t *testing.T) {
 		if test.emptyChain {
 			mbc := mock_blockchain.NewMockBlockchain(ctrl)
 			mbc.EXPECT().TipHeight().Return(uint64(0)).Times(1)
+			mbc.EXPECT().ChainID().Return(cfg.Chain.ID).Times(1)
 			svr.bc = mbc
 		}
 		res, err := svr.GetChainMeta(context.Background(), &iotexapi.GetChainMetaRequest{})
 		require.NoError(err)
 		chainMetaPb := res.ChainMeta
+		require.Equal(cfg.Chain.ID, chainMetaPb.ChainID)
 		require.Equal(test.height, chainMetaPb.Height)
 		require.Equal(test.numActions, chainMetaPb.NumActions)
 		require.Equal(test.tps, chainMetaPb.Tps)
@@ -1092,6 +1251,7 @@ func TestServer_SendAction(t *testing.T) {
 	}}
 
 	chain.EXPECT().ChainID().Return(uint32(1)).Times(2)
+	chain.EXPECT().TipHeight().Return(uint64(1)).Times(2)
 	ap.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil).Times(2)
 
 	for i, test := range sendActionTests {
@@ -1146,6 +1306,91 @@ func TestServer_ReadContract(t *testing.T) {
 	}
 }
 
+func TestServer_HistoryState(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+	cfg.Chain.EnableArchiveMode = true
+
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+	tipHeight := svr.bc.TipHeight()
+
+	// account at height 1 and at tip
+	addr := identityset.Address(30).String()
+	request := &iotexapi.GetAccountRequest{Address: addr, Height: 1}
+	res, err := svr.GetAccount(context.Background(), request)
+	require.NoError(err)
+	require.Equal("10", res.AccountMeta.Balance)
+	require.Zero(res.AccountMeta.Nonce)
+	require.EqualValues(1, res.AccountMeta.PendingNonce)
+	require.EqualValues(1, res.BlockIdentifier.Height)
+	request.Height = tipHeight
+	res, err = svr.GetAccount(context.Background(), request)
+	require.NoError(err)
+	require.Equal(getAccountTests[0].balance, res.AccountMeta.Balance)
+	require.Equal(getAccountTests[0].nonce, res.AccountMeta.Nonce)
+	require.Equal(tipHeight, res.BlockIdentifier.Height)
+	request.Height = tipHeight + 1
+	_, err = svr.GetAccount(context.Background(), request)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// contract at a history height
+	h, err := hash.HexStringToHash256(readContractTests[0].execHash)
+	require.NoError(err)
+	ai, err := svr.indexer.GetActionIndex(h[:])
+	require.NoError(err)
+	exec, err := svr.dao.GetActionByActionHash(h, ai.BlockHeight())
+	require.NoError(err)
+	contractRequest := &iotexapi.ReadContractRequest{
+		Execution:     exec.Proto().GetCore().GetExecution(),
+		CallerAddress: readContractTests[0].callerAddr,
+		Height:        ai.BlockHeight(),
+	}
+	contractRes, err := svr.ReadContract(context.Background(), contractRequest)
+	require.NoError(err)
+	require.Equal(readContractTests[0].retValue, contractRes.Data)
+
+	// invalid state height
+	_, err = svr.ReadState(context.Background(), &iotexapi.ReadStateRequest{
+		ProtocolID: []byte("rewarding"),
+		MethodName: []byte("UnclaimedBalance"),
+		Arguments:  [][]byte{[]byte(addr)},
+		Height:     "abc",
+	})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	// state read at the exact history height
+	stateRes, err := svr.ReadState(context.Background(), &iotexapi.ReadStateRequest{
+		ProtocolID: []byte("rewarding"),
+		MethodName: []byte("TotalBalance"),
+		Height:     "1",
+	})
+	require.NoError(err)
+	require.EqualValues(1, stateRes.BlockIdentifier.Height)
+
+	// history states beyond the retention are pruned
+	cfg.DB.HistoryStateRetention = 2
+	svr, err = createServer(cfg, false)
+	require.NoError(err)
+	request.Height = 1
+	_, err = svr.GetAccount(context.Background(), request)
+	require.Equal(codes.OutOfRange, status.Code(err))
+	request.Height = tipHeight - 1
+	_, err = svr.GetAccount(context.Background(), request)
+	require.NoError(err)
+
+	// history states beyond the parent of the tip are not available without archive mode
+	cfg.Chain.EnableArchiveMode = false
+	svr, err = createServer(cfg, false)
+	require.NoError(err)
+	_, err = svr.GetAccount(context.Background(), request)
+	require.NoError(err)
+	request.Height = tipHeight - 2
+	_, err = svr.GetAccount(context.Background(), request)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+	_, err = svr.ReadContract(context.Background(), contractRequest)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+}
+
 func TestServer_SuggestGasPrice(t *testing.T) {
 	require := require.New(t)
 	cfg := newConfig(t)
@@ -1808,6 +2053,74 @@ func TestServer_GetRawBlocks(t *testing.T) {
 	}
 }
 
+func TestServer_StreamPendingActions(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svr := Server{chainListener: NewChainListener()}
+	stream := mock_apiserver.NewMockStreamPendingActionsServer(ctrl)
+	err := svr.StreamPendingActions(&iotexapi.StreamPendingActionsRequest{}, stream)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	err = svr.StreamPendingActions(&iotexapi.StreamPendingActionsRequest{
+		Filter: &iotexapi.PendingActionsFilter{ActionTypes: []string{"unknown"}},
+	}, stream)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	tsf, err := testutil.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), 1, big.NewInt(1), nil, 10000, big.NewInt(0))
+	require.NoError(err)
+	sent := make(chan *iotexapi.ActionInfo, 1)
+	stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(res *iotexapi.StreamPendingActionsResponse) error {
+		sent <- res.Action
+		return nil
+	}).Times(1)
+	errChan := make(chan error)
+	go func() {
+		errChan <- svr.StreamPendingActions(&iotexapi.StreamPendingActionsRequest{
+			Filter: &iotexapi.PendingActionsFilter{ActionTypes: []string{"transfer"}},
+		}, stream)
+	}()
+	require.NoError(testutil.WaitUntil(10*time.Millisecond, time.Second, func() (bool, error) {
+		require.NoError(svr.chainListener.ReceiveAction(tsf))
+		return len(sent) > 0, nil
+	}))
+	actInfo := <-sent
+	require.Equal(identityset.Address(27).String(), actInfo.Sender)
+	require.NoError(svr.chainListener.Stop())
+	require.NoError(<-errChan)
+}
+
+func TestServer_StreamRoundLogs(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svr := Server{chainListener: NewChainListener()}
+	stream := mock_apiserver.NewMockStreamRoundLogsServer(ctrl)
+	err := svr.StreamRoundLogs(&iotexapi.StreamRoundLogsRequest{}, stream)
+	require.Equal(codes.Unavailable, status.Code(err))
+
+	svr.consensus = mock_consensus.NewMockConsensus(ctrl)
+	sent := make(chan *iotexapi.RoundLog, 1)
+	stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(res *iotexapi.StreamRoundLogsResponse) error {
+		sent <- res.RoundLog
+		return nil
+	}).Times(1)
+	errChan := make(chan error)
+	go func() {
+		errChan <- svr.StreamRoundLogs(&iotexapi.StreamRoundLogsRequest{}, stream)
+	}()
+	require.NoError(testutil.WaitUntil(10*time.Millisecond, time.Second, func() (bool, error) {
+		require.NoError(svr.chainListener.ReceiveRoundLog(scheme.RoundLog{Height: 5, Outcome: scheme.RoundCommitted}))
+		return len(sent) > 0, nil
+	}))
+	rl := <-sent
+	require.Equal(uint64(5), rl.Height)
+	require.Equal(scheme.RoundCommitted, rl.Outcome)
+	require.NoError(svr.chainListener.Stop())
+	require.NoError(<-errChan)
+}
+
 func TestServer_GetLogs(t *testing.T) {
 	require := require.New(t)
 	cfg := newConfig(t)
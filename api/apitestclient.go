@@ -56,10 +56,20 @@ type ServiceClient interface {
 	GetTransactionLogByActionHash(ctx context.Context, in *iotexapi.GetTransactionLogByActionHashRequest, opts ...grpc.CallOption) (*iotexapi.GetTransactionLogByActionHashResponse, error)
 	// get transaction log by block height
 	GetTransactionLogByBlockHeight(ctx context.Context, in *iotexapi.GetTransactionLogByBlockHeightRequest, opts ...grpc.CallOption) (*iotexapi.GetTransactionLogByBlockHeightResponse, error)
+	// get the merkle proof of an account and its storage slots against the state root
+	GetAccountProof(ctx context.Context, in *iotexapi.GetAccountProofRequest, opts ...grpc.CallOption) (*iotexapi.GetAccountProofResponse, error)
+	// get the merkle proof of an action against the tx root of the block containing it
+	GetActionProof(ctx context.Context, in *iotexapi.GetActionProofRequest, opts ...grpc.CallOption) (*iotexapi.GetActionProofResponse, error)
+	// re-run a committed action with the tracer, on top of the state of the parent block and the actions before it in the block
+	TraceAction(ctx context.Context, in *iotexapi.TraceActionRequest, opts ...grpc.CallOption) (*iotexapi.TraceActionResponse, error)
 	// get block info in stream
 	StreamBlocks(ctx context.Context, in *iotexapi.StreamBlocksRequest, opts ...grpc.CallOption) (iotexapi.APIService_StreamBlocksClient, error)
 	// get filtered logs in stream
 	StreamLogs(ctx context.Context, in *iotexapi.StreamLogsRequest, opts ...grpc.CallOption) (iotexapi.APIService_StreamLogsClient, error)
+	// get the actions entering actpool filtered by senders, recipients and action types in stream
+	StreamPendingActions(ctx context.Context, in *iotexapi.StreamPendingActionsRequest, opts ...grpc.CallOption) (iotexapi.APIService_StreamPendingActionsClient, error)
+	// get the logs of the consensus rounds in stream
+	StreamRoundLogs(ctx context.Context, in *iotexapi.StreamRoundLogsRequest, opts ...grpc.CallOption) (iotexapi.APIService_StreamRoundLogsClient, error)
 	// get native election buckets
 	GetElectionBuckets(ctx context.Context, in *iotexapi.GetElectionBucketsRequest, opts ...grpc.CallOption) (*iotexapi.GetElectionBucketsResponse, error)
 }
@@ -16,3 +16,15 @@ type StreamBlocksServer interface {
 	Send(*iotexapi.StreamBlocksResponse) error
 	grpc.ServerStream
 }
+
+// StreamPendingActionsServer defines the interface of a rpc stream server of pending actions
+type StreamPendingActionsServer interface {
+	Send(*iotexapi.StreamPendingActionsResponse) error
+	grpc.ServerStream
+}
+
+// StreamRoundLogsServer defines the interface of a rpc stream server of consensus round logs
+type StreamRoundLogsServer interface {
+	Send(*iotexapi.StreamRoundLogsResponse) error
+	grpc.ServerStream
+}
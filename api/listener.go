@@ -6,7 +6,9 @@ import (
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/pkg/errors"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/consensus/scheme"
 )
 
 var (
@@ -14,11 +16,14 @@ var (
 )
 
 type (
-	// Listener pass new block to all responders
+	// Listener pass new block to all responders, new action in actpool to all action responders, and the log of a
+	// closed consensus round to all round log responders
 	Listener interface {
 		Start() error
 		Stop() error
 		ReceiveBlock(*block.Block) error
+		ReceiveAction(action.SealedEnvelope) error
+		ReceiveRoundLog(scheme.RoundLog) error
 		AddResponder(Responder) error
 	}
 
@@ -69,6 +74,38 @@ func (cl *chainListener) ReceiveBlock(blk *block.Block) error {
 	return nil
 }
 
+// ReceiveAction handles the action added into actpool
+func (cl *chainListener) ReceiveAction(selp action.SealedEnvelope) error {
+	// pass the action to every action responder
+	cl.streamMap.Range(func(key, _ interface{}) bool {
+		r, ok := key.(ActionResponder)
+		if !ok {
+			return true
+		}
+		if err := r.RespondAction(selp); err != nil {
+			cl.streamMap.Delete(key)
+		}
+		return true
+	})
+	return nil
+}
+
+// ReceiveRoundLog handles the log of a closed consensus round
+func (cl *chainListener) ReceiveRoundLog(rl scheme.RoundLog) error {
+	// pass the round log to every round log responder
+	cl.streamMap.Range(func(key, _ interface{}) bool {
+		r, ok := key.(RoundLogResponder)
+		if !ok {
+			return true
+		}
+		if err := r.RespondRoundLog(rl); err != nil {
+			cl.streamMap.Delete(key)
+		}
+		return true
+	})
+	return nil
+}
+
 // AddResponder adds a new responder
 func (cl *chainListener) AddResponder(r Responder) error {
 	_, loaded := cl.streamMap.LoadOrStore(r, struct{}{})
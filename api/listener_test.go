@@ -7,6 +7,7 @@
 package api
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -14,7 +15,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/consensus/scheme"
+	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/test/mock/mock_apiresponder"
+	"github.com/iotexproject/iotex-core/testutil"
 )
 
 // test for chainListener
@@ -58,3 +62,52 @@ func TestChainListener(t *testing.T) {
 	err = listener.Stop()
 	require.NoError(t, err)
 }
+
+func TestChainListenerReceiveAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+
+	listener := NewChainListener()
+	responder := mock_apiresponder.NewMockResponder(ctrl)
+	actionResponder := mock_apiresponder.NewMockActionResponder(ctrl)
+	require.NoError(listener.AddResponder(responder))
+	require.NoError(listener.AddResponder(actionResponder))
+
+	selp, err := testutil.SignedTransfer(identityset.Address(1).String(), identityset.PrivateKey(0), 1, big.NewInt(1), nil, 10000, big.NewInt(0))
+	require.NoError(err)
+	// only the action responders receive the action
+	actionResponder.EXPECT().RespondAction(selp).Return(nil).Times(1)
+	require.NoError(listener.ReceiveAction(selp))
+
+	// a failed action responder is removed
+	actionResponder.EXPECT().RespondAction(selp).Return(errors.New("Error when streaming the action")).Times(1)
+	require.NoError(listener.ReceiveAction(selp))
+	require.NoError(listener.ReceiveAction(selp))
+	responder.EXPECT().Exit().Return().Times(1)
+	require.NoError(listener.Stop())
+}
+
+func TestChainListenerReceiveRoundLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+
+	listener := NewChainListener()
+	responder := mock_apiresponder.NewMockActionResponder(ctrl)
+	roundLogResponder := mock_apiresponder.NewMockRoundLogResponder(ctrl)
+	require.NoError(listener.AddResponder(responder))
+	require.NoError(listener.AddResponder(roundLogResponder))
+
+	rl := scheme.RoundLog{Height: 1, Outcome: scheme.RoundCommitted}
+	// only the round log responders receive the round log
+	roundLogResponder.EXPECT().RespondRoundLog(rl).Return(nil).Times(1)
+	require.NoError(listener.ReceiveRoundLog(rl))
+
+	// a failed round log responder is removed
+	roundLogResponder.EXPECT().RespondRoundLog(rl).Return(errors.New("Error when streaming the round log")).Times(1)
+	require.NoError(listener.ReceiveRoundLog(rl))
+	require.NoError(listener.ReceiveRoundLog(rl))
+	responder.EXPECT().Exit().Return().Times(1)
+	require.NoError(listener.Stop())
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"strings"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// pendingActionListener defines the pending action listener subscribed through API
+type pendingActionListener struct {
+	stream  iotexapi.APIService_StreamPendingActionsServer
+	errChan chan error
+	filter  *iotexapi.PendingActionsFilter
+}
+
+// NewPendingActionListener returns a new pending action listener
+func NewPendingActionListener(
+	filter *iotexapi.PendingActionsFilter,
+	stream iotexapi.APIService_StreamPendingActionsServer,
+	errChan chan error,
+) ActionResponder {
+	return &pendingActionListener{
+		stream:  stream,
+		errChan: errChan,
+		filter:  filter,
+	}
+}
+
+// Respond to new block, which is ignored
+func (pl *pendingActionListener) Respond(*block.Block) error {
+	return nil
+}
+
+// RespondAction to new action in actpool
+func (pl *pendingActionListener) RespondAction(selp action.SealedEnvelope) error {
+	if !matchPendingAction(pl.filter, selp) {
+		return nil
+	}
+	actInfo, err := pendingAction(selp)
+	if err != nil {
+		log.L().Error("Error when converting the pending action", zap.Error(err))
+		return nil
+	}
+	// send the action thru streaming API
+	if err := pl.stream.Send(&iotexapi.StreamPendingActionsResponse{Action: actInfo}); err != nil {
+		log.L().Info(
+			"Error when streaming the pending action",
+			zap.String("hash", actInfo.ActHash),
+			zap.Error(err),
+		)
+		pl.errChan <- err
+		return err
+	}
+	return nil
+}
+
+// Exit send to error channel
+func (pl *pendingActionListener) Exit() {
+	pl.errChan <- nil
+}
+
+// validatePendingActionsFilter checks the addresses and action types in the filter
+func validatePendingActionsFilter(f *iotexapi.PendingActionsFilter) error {
+	if f == nil {
+		return errors.New("empty filter")
+	}
+	for _, addrs := range [][]string{f.Senders, f.Recipients} {
+		for _, addr := range addrs {
+			if _, err := address.FromString(addr); err != nil {
+				return errors.Wrapf(err, "invalid address %s", addr)
+			}
+		}
+	}
+	fields := (&iotextypes.ActionCore{}).ProtoReflect().Descriptor().Oneofs().ByName("action").Fields()
+	for _, typ := range f.ActionTypes {
+		if fields.ByJSONName(typ) == nil {
+			return errors.Errorf("invalid action type %s", typ)
+		}
+	}
+	return nil
+}
+
+// matchPendingAction returns whether the action matches the filter. An empty list in the filter matches any
+// action, and an action matches the filter if it matches all the lists
+func matchPendingAction(f *iotexapi.PendingActionsFilter, selp action.SealedEnvelope) bool {
+	if len(f.Senders) > 0 {
+		sender, err := address.FromBytes(selp.SrcPubkey().Hash())
+		if err != nil || !containsAddress(f.Senders, sender.String()) {
+			return false
+		}
+	}
+	if len(f.Recipients) > 0 {
+		recipient, ok := selp.Destination()
+		if !ok || !containsAddress(f.Recipients, recipient) {
+			return false
+		}
+	}
+	if len(f.ActionTypes) > 0 {
+		core := selp.Proto().GetCore().ProtoReflect()
+		field := core.WhichOneof(core.Descriptor().Oneofs().ByName("action"))
+		if field == nil || !containsString(f.ActionTypes, field.JSONName()) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAddress(addrs []string, addr string) bool {
+	for _, a := range addrs {
+		if strings.EqualFold(a, addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
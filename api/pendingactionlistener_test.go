@@ -0,0 +1,96 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_apiserver"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestPendingActionsFilter(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		sender    = identityset.Address(27).String()
+		recipient = identityset.Address(28).String()
+		contract  = identityset.Address(29).String()
+	)
+	tsf, err := testutil.SignedTransfer(recipient, identityset.PrivateKey(27), 1, big.NewInt(1), nil, 10000, big.NewInt(0))
+	require.NoError(err)
+	exec, err := testutil.SignedExecution(contract, identityset.PrivateKey(27), 2, big.NewInt(1), 10000, big.NewInt(0), nil)
+	require.NoError(err)
+
+	require.Error(validatePendingActionsFilter(nil))
+	require.Error(validatePendingActionsFilter(&iotexapi.PendingActionsFilter{Senders: []string{"abc"}}))
+	require.Error(validatePendingActionsFilter(&iotexapi.PendingActionsFilter{Recipients: []string{"abc"}}))
+	require.Error(validatePendingActionsFilter(&iotexapi.PendingActionsFilter{ActionTypes: []string{"unknown"}}))
+
+	for _, test := range []struct {
+		filter   *iotexapi.PendingActionsFilter
+		transfer bool
+		exec     bool
+	}{
+		{&iotexapi.PendingActionsFilter{}, true, true},
+		{&iotexapi.PendingActionsFilter{Senders: []string{sender}}, true, true},
+		{&iotexapi.PendingActionsFilter{Senders: []string{recipient}}, false, false},
+		{&iotexapi.PendingActionsFilter{Recipients: []string{recipient}}, true, false},
+		{&iotexapi.PendingActionsFilter{Recipients: []string{recipient, contract}}, true, true},
+		{&iotexapi.PendingActionsFilter{ActionTypes: []string{"execution"}}, false, true},
+		{&iotexapi.PendingActionsFilter{ActionTypes: []string{"transfer", "stakeCreate"}}, true, false},
+		{&iotexapi.PendingActionsFilter{Senders: []string{sender}, Recipients: []string{contract}, ActionTypes: []string{"transfer"}}, false, false},
+	} {
+		require.NoError(validatePendingActionsFilter(test.filter))
+		require.Equal(test.transfer, matchPendingAction(test.filter, tsf))
+		require.Equal(test.exec, matchPendingAction(test.filter, exec))
+	}
+}
+
+func TestPendingActionListener(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+
+	errChan := make(chan error, 10)
+	server := mock_apiserver.NewMockStreamPendingActionsServer(ctrl)
+	responder := NewPendingActionListener(&iotexapi.PendingActionsFilter{
+		Recipients: []string{identityset.Address(28).String()},
+	}, server, errChan)
+
+	tsf1, err := testutil.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), 1, big.NewInt(1), nil, 10000, big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(identityset.Address(29).String(), identityset.PrivateKey(27), 2, big.NewInt(1), nil, 10000, big.NewInt(0))
+	require.NoError(err)
+	require.NoError(responder.Respond(nil))
+
+	h := tsf1.Hash()
+	server.EXPECT().Send(gomock.Any()).DoAndReturn(func(res *iotexapi.StreamPendingActionsResponse) error {
+		actInfo := res.Action
+		require.Equal(hex.EncodeToString(h[:]), actInfo.ActHash)
+		require.Equal(identityset.Address(27).String(), actInfo.Sender)
+		require.Zero(actInfo.BlkHeight)
+		return nil
+	}).Times(1)
+	require.NoError(responder.RespondAction(tsf1))
+	// the action not matching the filter is not sent
+	require.NoError(responder.RespondAction(tsf2))
+
+	server.EXPECT().Send(gomock.Any()).Return(errorSend).Times(1)
+	require.Equal(errorSend, responder.RespondAction(tsf1))
+
+	responder.Exit()
+	require.Equal(errorSend, <-errChan)
+	require.NoError(<-errChan)
+}
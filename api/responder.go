@@ -7,7 +7,9 @@
 package api
 
 import (
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/consensus/scheme"
 )
 
 // Responder responds to new block
@@ -15,3 +17,15 @@ type Responder interface {
 	Respond(*block.Block) error
 	Exit()
 }
+
+// ActionResponder responds to new action in actpool as well
+type ActionResponder interface {
+	Responder
+	RespondAction(action.SealedEnvelope) error
+}
+
+// RoundLogResponder responds to the log of a closed consensus round as well
+type RoundLogResponder interface {
+	Responder
+	RespondRoundLog(scheme.RoundLog) error
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/consensus/scheme"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// roundLogListener defines the consensus round log listener subscribed through API
+type roundLogListener struct {
+	stream  iotexapi.APIService_StreamRoundLogsServer
+	errChan chan error
+	height  uint64
+}
+
+// NewRoundLogListener returns a new consensus round log listener, which streams the logs of the rounds at the
+// height, or of all rounds if the height is 0
+func NewRoundLogListener(
+	height uint64,
+	stream iotexapi.APIService_StreamRoundLogsServer,
+	errChan chan error,
+) RoundLogResponder {
+	return &roundLogListener{
+		stream:  stream,
+		errChan: errChan,
+		height:  height,
+	}
+}
+
+// Respond to new block, which is ignored
+func (rl *roundLogListener) Respond(*block.Block) error {
+	return nil
+}
+
+// RespondRoundLog to the log of a closed consensus round
+func (rl *roundLogListener) RespondRoundLog(roundLog scheme.RoundLog) error {
+	if rl.height != 0 && roundLog.Height != rl.height {
+		return nil
+	}
+	pb, err := roundLogProto(roundLog)
+	if err != nil {
+		log.L().Error("Error when converting the round log", zap.Error(err))
+		return nil
+	}
+	// send the round log thru streaming API
+	if err := rl.stream.Send(&iotexapi.StreamRoundLogsResponse{RoundLog: pb}); err != nil {
+		log.L().Info(
+			"Error when streaming the round log",
+			zap.Uint64("height", roundLog.Height),
+			zap.Uint32("round", roundLog.Round),
+			zap.Error(err),
+		)
+		rl.errChan <- err
+		return err
+	}
+	return nil
+}
+
+// Exit send to error channel
+func (rl *roundLogListener) Exit() {
+	rl.errChan <- nil
+}
+
+// roundLogProto converts the round log into proto, the delegates missing the endorsements are sorted by topic
+func roundLogProto(rl scheme.RoundLog) (*iotexapi.RoundLog, error) {
+	startTime, err := ptypes.TimestampProto(rl.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	pb := &iotexapi.RoundLog{
+		Height:    rl.Height,
+		Round:     rl.Round,
+		StartTime: startTime,
+		Proposer:  rl.Proposer,
+		Delegates: rl.Delegates,
+		Outcome:   rl.Outcome,
+		Reason:    rl.Reason,
+	}
+	if !rl.BlockReceived.IsZero() {
+		if pb.BlockReceived, err = ptypes.TimestampProto(rl.BlockReceived); err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range rl.Endorsements {
+		received, err := ptypes.TimestampProto(e.Received)
+		if err != nil {
+			return nil, err
+		}
+		pb.Endorsements = append(pb.Endorsements, &iotexapi.EndorsementLog{
+			Endorser:  e.Endorser,
+			Topic:     e.Topic,
+			BlockHash: e.BlockHash,
+			Received:  received,
+			Late:      e.Late,
+		})
+	}
+	for _, t := range rl.Transitions {
+		ts, err := ptypes.TimestampProto(t.Time)
+		if err != nil {
+			return nil, err
+		}
+		pb.Transitions = append(pb.Transitions, &iotexapi.TransitionLog{
+			Src:   t.Src,
+			Dst:   t.Dst,
+			Event: t.Event,
+			Time:  ts,
+		})
+	}
+	topics := make([]string, 0, len(rl.Missing))
+	for topic := range rl.Missing {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	for _, topic := range topics {
+		pb.Missing = append(pb.Missing, &iotexapi.MissingEndorsers{
+			Topic:     topic,
+			Delegates: rl.Missing[topic],
+		})
+	}
+	return pb, nil
+}
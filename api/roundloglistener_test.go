@@ -0,0 +1,92 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/consensus/scheme"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_apiserver"
+)
+
+func TestRoundLogListener(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+
+	errChan := make(chan error, 10)
+	server := mock_apiserver.NewMockStreamRoundLogsServer(ctrl)
+	responder := NewRoundLogListener(2, server, errChan)
+	require.NoError(responder.Respond(nil))
+
+	start := time.Unix(1600000000, 0)
+	delegates := []string{identityset.Address(0).String(), identityset.Address(1).String()}
+	rl := scheme.RoundLog{
+		Height:    2,
+		Round:     1,
+		StartTime: start,
+		Proposer:  delegates[0],
+		Delegates: delegates,
+		Endorsements: []scheme.EndorsementLog{{
+			Endorser:  delegates[1],
+			Topic:     "PROPOSAL",
+			BlockHash: "010203",
+			Received:  start.Add(time.Second),
+		}},
+		Transitions: []scheme.TransitionLog{{
+			Src:   "S_PREPARE",
+			Dst:   "S_ACCEPT_BLOCK_PROPOSAL",
+			Event: "E_PREPARE",
+			Time:  start,
+		}},
+		Outcome: scheme.RoundFailed,
+		Reason:  "no block proposal received",
+		Missing: map[string][]string{
+			"PROPOSAL": {delegates[0]},
+			"LOCK":     delegates,
+			"COMMIT":   delegates,
+		},
+	}
+	server.EXPECT().Send(gomock.Any()).DoAndReturn(func(res *iotexapi.StreamRoundLogsResponse) error {
+		pb := res.RoundLog
+		require.Equal(uint64(2), pb.Height)
+		require.Equal(uint32(1), pb.Round)
+		require.Equal(start.Unix(), pb.StartTime.Seconds)
+		require.Equal(delegates, pb.Delegates)
+		// no block is received
+		require.Nil(pb.BlockReceived)
+		require.Len(pb.Endorsements, 1)
+		require.Equal(delegates[1], pb.Endorsements[0].Endorser)
+		require.Equal(start.Unix()+1, pb.Endorsements[0].Received.Seconds)
+		require.Len(pb.Transitions, 1)
+		require.Equal("E_PREPARE", pb.Transitions[0].Event)
+		require.Equal(scheme.RoundFailed, pb.Outcome)
+		require.Equal("no block proposal received", pb.Reason)
+		require.Len(pb.Missing, 3)
+		for i, topic := range []string{"COMMIT", "LOCK", "PROPOSAL"} {
+			require.Equal(topic, pb.Missing[i].Topic)
+			require.Equal(rl.Missing[topic], pb.Missing[i].Delegates)
+		}
+		return nil
+	}).Times(1)
+	require.NoError(responder.RespondRoundLog(rl))
+	// the round log at another height is not sent
+	require.NoError(responder.RespondRoundLog(scheme.RoundLog{Height: 3}))
+
+	server.EXPECT().Send(gomock.Any()).Return(errorSend).Times(1)
+	require.Equal(errorSend, responder.RespondRoundLog(rl))
+
+	responder.Exit()
+	require.Equal(errorSend, <-errChan)
+	require.NoError(<-errChan)
+}
@@ -0,0 +1,1203 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+// JSON-RPC 2.0 error codes
+const (
+	_web3ErrParse          = -32700
+	_web3ErrInvalidRequest = -32600
+	_web3ErrMethodNotFound = -32601
+	_web3ErrInvalidParams  = -32602
+	_web3ErrServer         = -32000
+)
+
+// _web3MaxBodySize is the maximum size of a request body
+const _web3MaxBodySize = 1 << 20
+
+var (
+	// _web3Handlers maps the supported JSON-RPC methods to their handlers
+	_web3Handlers = map[string]web3Handler{
+		"web3_clientVersion":        (*web3Server).clientVersion,
+		"net_version":               (*web3Server).netVersion,
+		"eth_chainId":               (*web3Server).chainID,
+		"eth_accounts":              (*web3Server).accounts,
+		"eth_blockNumber":           (*web3Server).blockNumber,
+		"eth_gasPrice":              (*web3Server).gasPrice,
+		"eth_getBalance":            (*web3Server).getBalance,
+		"eth_getTransactionCount":   (*web3Server).getTransactionCount,
+		"eth_getCode":               (*web3Server).getCode,
+		"eth_call":                  (*web3Server).call,
+		"eth_estimateGas":           (*web3Server).estimateGas,
+		"eth_sendRawTransaction":    (*web3Server).sendRawTransaction,
+		"eth_getTransactionByHash":  (*web3Server).getTransactionByHash,
+		"eth_getTransactionReceipt": (*web3Server).getTransactionReceipt,
+		"eth_getBlockByNumber":      (*web3Server).getBlockByNumber,
+		"eth_getBlockByHash":        (*web3Server).getBlockByHash,
+		"eth_getLogs":               (*web3Server).getLogs,
+		"debug_traceTransaction":    (*web3Server).traceTransaction,
+	}
+
+	// _web3FullBloom is returned as the logs bloom of blocks and receipts. The bloom filter of IoTeX blocks
+	// isn't compatible with Ethereum, so a bloom with all bits set makes clients never skip a block
+	_web3FullBloom = hexutil.Bytes(bytes.Repeat([]byte{0xff}, types.BloomByteLength))
+)
+
+type (
+	web3Handler func(*web3Server, context.Context, []json.RawMessage) (interface{}, error)
+
+	// web3Server serves the Ethereum JSON-RPC API on top of the API server, such that Ethereum tooling can
+	// talk to the chain. Addresses are converted between the io and 0x formats inside the server
+	web3Server struct {
+		api    *Server
+		server *http.Server
+	}
+
+	web3Request struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Method  string          `json:"method"`
+		Params  json.RawMessage `json:"params"`
+	}
+
+	web3Response struct {
+		id     json.RawMessage
+		result interface{}
+		err    *web3Error
+	}
+
+	web3Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+
+	web3CallObject struct {
+		From  string        `json:"from"`
+		To    string        `json:"to"`
+		Value *hexutil.Big  `json:"value"`
+		Data  hexutil.Bytes `json:"data"`
+		Input hexutil.Bytes `json:"input"`
+	}
+
+	web3FilterObject struct {
+		FromBlock string            `json:"fromBlock"`
+		ToBlock   string            `json:"toBlock"`
+		Address   json.RawMessage   `json:"address"`
+		Topics    []json.RawMessage `json:"topics"`
+		BlockHash string            `json:"blockHash"`
+	}
+
+	web3Block struct {
+		Number           hexutil.Uint64 `json:"number"`
+		Hash             string         `json:"hash"`
+		ParentHash       string         `json:"parentHash"`
+		Nonce            string         `json:"nonce"`
+		Sha3Uncles       string         `json:"sha3Uncles"`
+		LogsBloom        hexutil.Bytes  `json:"logsBloom"`
+		TransactionsRoot string         `json:"transactionsRoot"`
+		StateRoot        string         `json:"stateRoot"`
+		ReceiptsRoot     string         `json:"receiptsRoot"`
+		Miner            string         `json:"miner"`
+		Difficulty       hexutil.Uint64 `json:"difficulty"`
+		TotalDifficulty  hexutil.Uint64 `json:"totalDifficulty"`
+		ExtraData        hexutil.Bytes  `json:"extraData"`
+		Size             hexutil.Uint64 `json:"size"`
+		GasLimit         hexutil.Uint64 `json:"gasLimit"`
+		GasUsed          hexutil.Uint64 `json:"gasUsed"`
+		Timestamp        hexutil.Uint64 `json:"timestamp"`
+		Transactions     []interface{}  `json:"transactions"`
+		Uncles           []string       `json:"uncles"`
+	}
+
+	web3Transaction struct {
+		BlockHash        *string         `json:"blockHash"`
+		BlockNumber      *hexutil.Uint64 `json:"blockNumber"`
+		From             string          `json:"from"`
+		Gas              hexutil.Uint64  `json:"gas"`
+		GasPrice         *hexutil.Big    `json:"gasPrice"`
+		Hash             string          `json:"hash"`
+		Input            hexutil.Bytes   `json:"input"`
+		Nonce            hexutil.Uint64  `json:"nonce"`
+		To               *string         `json:"to"`
+		TransactionIndex *hexutil.Uint64 `json:"transactionIndex"`
+		Value            *hexutil.Big    `json:"value"`
+		V                *hexutil.Big    `json:"v"`
+		R                *hexutil.Big    `json:"r"`
+		S                *hexutil.Big    `json:"s"`
+	}
+
+	web3Receipt struct {
+		BlockHash         string         `json:"blockHash"`
+		BlockNumber       hexutil.Uint64 `json:"blockNumber"`
+		ContractAddress   *string        `json:"contractAddress"`
+		CumulativeGasUsed hexutil.Uint64 `json:"cumulativeGasUsed"`
+		From              string         `json:"from"`
+		GasUsed           hexutil.Uint64 `json:"gasUsed"`
+		Logs              []*web3Log     `json:"logs"`
+		LogsBloom         hexutil.Bytes  `json:"logsBloom"`
+		Status            hexutil.Uint64 `json:"status"`
+		To                *string        `json:"to"`
+		TransactionHash   string         `json:"transactionHash"`
+		TransactionIndex  hexutil.Uint64 `json:"transactionIndex"`
+	}
+
+	web3Log struct {
+		Removed          bool           `json:"removed"`
+		LogIndex         hexutil.Uint64 `json:"logIndex"`
+		TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+		TransactionHash  string         `json:"transactionHash"`
+		BlockHash        string         `json:"blockHash"`
+		BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+		Address          string         `json:"address"`
+		Data             hexutil.Bytes  `json:"data"`
+		Topics           []string       `json:"topics"`
+		pb               *iotextypes.Log
+	}
+
+	web3TraceConfig struct {
+		DisableStorage bool   `json:"disableStorage"`
+		DisableMemory  bool   `json:"disableMemory"`
+		DisableStack   bool   `json:"disableStack"`
+		Limit          int    `json:"limit"`
+		Tracer         string `json:"tracer"`
+	}
+
+	// web3StructLogs is the opcode-level trace of an execution, together with the storage changes, which are
+	// not part of the Ethereum trace
+	web3StructLogs struct {
+		Gas            uint64                                   `json:"gas"`
+		Failed         bool                                     `json:"failed"`
+		ReturnValue    string                                   `json:"returnValue"`
+		StructLogs     []*web3StructLog                         `json:"structLogs"`
+		StorageChanges map[string]map[string]*web3StorageChange `json:"storageChanges"`
+	}
+
+	web3StructLog struct {
+		Pc      uint64             `json:"pc"`
+		Op      string             `json:"op"`
+		Gas     uint64             `json:"gas"`
+		GasCost uint64             `json:"gasCost"`
+		Depth   int                `json:"depth"`
+		Error   string             `json:"error,omitempty"`
+		Stack   *[]string          `json:"stack,omitempty"`
+		Memory  *[]string          `json:"memory,omitempty"`
+		Storage *map[string]string `json:"storage,omitempty"`
+	}
+
+	web3StorageChange struct {
+		Original string `json:"original"`
+		Current  string `json:"current"`
+	}
+
+	// web3CallFrame is the call tree of an execution in the format of the call tracer of Ethereum
+	web3CallFrame struct {
+		Type    string           `json:"type"`
+		From    string           `json:"from"`
+		To      string           `json:"to"`
+		Value   *hexutil.Big     `json:"value"`
+		Gas     hexutil.Uint64   `json:"gas"`
+		GasUsed hexutil.Uint64   `json:"gasUsed"`
+		Input   hexutil.Bytes    `json:"input"`
+		Output  hexutil.Bytes    `json:"output"`
+		Error   string           `json:"error,omitempty"`
+		Calls   []*web3CallFrame `json:"calls,omitempty"`
+	}
+
+	// web3BlockIndex indexes the transactions, receipts and logs of a block
+	web3BlockIndex struct {
+		blk      *block.Block
+		hash     hash.Hash256
+		receipts []*action.Receipt
+		txIndex  map[hash.Hash256]uint64
+		logs     []*web3Log
+	}
+)
+
+func newWeb3Server(api *Server, port int) *web3Server {
+	svr := &web3Server{api: api}
+	svr.server = &http.Server{
+		Addr:    ":" + strconv.Itoa(port),
+		Handler: svr,
+	}
+	return svr
+}
+
+// Start starts the web3 server
+func (svr *web3Server) Start() error {
+	lis, err := net.Listen("tcp", svr.server.Addr)
+	if err != nil {
+		log.L().Error("web3 server failed to listen.", zap.Error(err))
+		return errors.Wrap(err, "web3 server failed to listen")
+	}
+	log.L().Info("web3 server is listening.", zap.String("addr", lis.Addr().String()))
+
+	go func() {
+		if err := svr.server.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.L().Fatal("Node failed to serve web3 requests.", zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+// Stop stops the web3 server
+func (svr *web3Server) Stop() error {
+	return svr.server.Shutdown(context.Background())
+}
+
+// ServeHTTP serves a single or a batch of JSON-RPC requests
+func (svr *web3Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+	switch req.Method {
+	case http.MethodOptions:
+		w.WriteHeader(http.StatusOK)
+		return
+	case http.MethodPost:
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var res interface{}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, req.Body, _web3MaxBodySize))
+	body = bytes.TrimSpace(body)
+	switch {
+	case err != nil:
+		res = newWeb3ErrorResponse(nil, _web3ErrInvalidRequest, err.Error())
+	case len(body) > 0 && body[0] == '[':
+		var batch []json.RawMessage
+		if err := json.Unmarshal(body, &batch); err != nil {
+			res = newWeb3ErrorResponse(nil, _web3ErrParse, err.Error())
+			break
+		}
+		if len(batch) == 0 {
+			res = newWeb3ErrorResponse(nil, _web3ErrInvalidRequest, "empty batch")
+			break
+		}
+		responses := make([]*web3Response, len(batch))
+		for i, raw := range batch {
+			responses[i] = svr.handle(req.Context(), raw)
+		}
+		res = responses
+	default:
+		res = svr.handle(req.Context(), body)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		log.L().Warn("Failed to write web3 response.", zap.Error(err))
+	}
+}
+
+func (svr *web3Server) handle(ctx context.Context, raw json.RawMessage) *web3Response {
+	var req web3Request
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return newWeb3ErrorResponse(nil, _web3ErrParse, err.Error())
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return newWeb3ErrorResponse(req.ID, _web3ErrInvalidRequest, "invalid JSON-RPC 2.0 request")
+	}
+	handler, ok := _web3Handlers[req.Method]
+	if !ok {
+		return newWeb3ErrorResponse(req.ID, _web3ErrMethodNotFound, "method "+req.Method+" is not supported")
+	}
+	var params []json.RawMessage
+	if len(req.Params) > 0 && string(req.Params) != "null" {
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return newWeb3ErrorResponse(req.ID, _web3ErrInvalidParams, err.Error())
+		}
+	}
+	log.L().Debug("receive web3 request", zap.String("method", req.Method))
+	result, err := handler(svr, ctx, params)
+	if err != nil {
+		return &web3Response{id: req.ID, err: toWeb3Error(err)}
+	}
+	return &web3Response{id: req.ID, result: result}
+}
+
+func (svr *web3Server) clientVersion(context.Context, []json.RawMessage) (interface{}, error) {
+	return "iotex-core/" + version.PackageVersion, nil
+}
+
+func (svr *web3Server) netVersion(context.Context, []json.RawMessage) (interface{}, error) {
+	return strconv.FormatUint(uint64(svr.api.cfg.Genesis.EVMNetworkID), 10), nil
+}
+
+func (svr *web3Server) chainID(context.Context, []json.RawMessage) (interface{}, error) {
+	return hexutil.Uint64(svr.api.cfg.Genesis.EVMNetworkID), nil
+}
+
+func (svr *web3Server) accounts(context.Context, []json.RawMessage) (interface{}, error) {
+	// the node doesn't manage any account
+	return []string{}, nil
+}
+
+func (svr *web3Server) blockNumber(context.Context, []json.RawMessage) (interface{}, error) {
+	return hexutil.Uint64(svr.api.bc.TipHeight()), nil
+}
+
+func (svr *web3Server) gasPrice(ctx context.Context, _ []json.RawMessage) (interface{}, error) {
+	res, err := svr.api.SuggestGasPrice(ctx, &iotexapi.SuggestGasPriceRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(new(big.Int).SetUint64(res.GasPrice)), nil
+}
+
+func (svr *web3Server) getBalance(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	meta, _, err := svr.accountMeta(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	balance, ok := new(big.Int).SetString(meta.Balance, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid balance %s", meta.Balance)
+	}
+	return (*hexutil.Big)(balance), nil
+}
+
+func (svr *web3Server) getTransactionCount(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	meta, tag, err := svr.accountMeta(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	// the nonce of an account is the nonce of its last action, while Ethereum expects the nonce to use next
+	if tag == "pending" {
+		return hexutil.Uint64(meta.PendingNonce), nil
+	}
+	return hexutil.Uint64(meta.Nonce + 1), nil
+}
+
+func (svr *web3Server) getCode(_ context.Context, params []json.RawMessage) (interface{}, error) {
+	var addrStr, tag string
+	if err := parseWeb3Params(params, 1, &addrStr, &tag); err != nil {
+		return nil, err
+	}
+	addr, err := ethToIoAddress(addrStr)
+	if err != nil {
+		return nil, err
+	}
+	height, err := svr.stateHeight(tag)
+	if err != nil {
+		return nil, err
+	}
+	sr := svr.api.stateReaderAt(height)
+	account, err := accountutil.AccountState(sr, addr.String())
+	if err != nil {
+		return nil, historyStateError(err, codes.Internal)
+	}
+	if !account.IsContract() {
+		return hexutil.Bytes{}, nil
+	}
+	var code evm.SerializableBytes
+	if _, err := sr.State(&code, protocol.NamespaceOption(evm.CodeKVNameSpace), protocol.KeyOption(account.CodeHash)); err != nil {
+		return nil, historyStateError(err, codes.Internal)
+	}
+	return hexutil.Bytes(code), nil
+}
+
+func (svr *web3Server) call(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	exec, caller, height, err := svr.parseCallObject(params)
+	if err != nil {
+		return nil, err
+	}
+	res, err := svr.api.ReadContract(ctx, &iotexapi.ReadContractRequest{
+		Execution:     exec,
+		CallerAddress: caller,
+		Height:        height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Receipt.Status != uint64(iotextypes.ReceiptStatus_Success) {
+		return nil, &web3Error{Code: _web3ErrServer, Message: "execution reverted"}
+	}
+	data, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Bytes(data), nil
+}
+
+func (svr *web3Server) estimateGas(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	exec, caller, height, err := svr.parseCallObject(params)
+	if err != nil {
+		return nil, err
+	}
+	if height != 0 {
+		return nil, &web3Error{Code: _web3ErrInvalidParams, Message: "estimating gas at a history block is not supported"}
+	}
+	res, err := svr.api.EstimateActionGasConsumption(ctx, &iotexapi.EstimateActionGasConsumptionRequest{
+		Action:        &iotexapi.EstimateActionGasConsumptionRequest_Execution{Execution: exec},
+		CallerAddress: caller,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Uint64(res.Gas), nil
+}
+
+func (svr *web3Server) sendRawTransaction(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var raw hexutil.Bytes
+	if err := parseWeb3Params(params, 1, &raw); err != nil {
+		return nil, err
+	}
+	selp, err := action.DecodeRawTx(raw, svr.api.cfg.Genesis.EVMNetworkID)
+	if err != nil {
+		return nil, newWeb3Error(_web3ErrInvalidParams, err)
+	}
+	res, err := svr.api.SendAction(ctx, &iotexapi.SendActionRequest{Action: selp.Proto()})
+	if err != nil {
+		return nil, err
+	}
+	return "0x" + res.ActionHash, nil
+}
+
+func (svr *web3Server) getTransactionByHash(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var h string
+	if err := parseWeb3Params(params, 1, &h); err != nil {
+		return nil, err
+	}
+	actHash, err := web3Hash(h)
+	if err != nil {
+		return nil, err
+	}
+	res, err := svr.api.GetActions(ctx, &iotexapi.GetActionsRequest{
+		Lookup: &iotexapi.GetActionsRequest_ByHash{
+			ByHash: &iotexapi.GetActionByHashRequest{
+				ActionHash:   hex.EncodeToString(actHash[:]),
+				CheckPending: true,
+			},
+		},
+	})
+	if err != nil {
+		if status.Code(err) == codes.Unavailable {
+			// the action is neither committed nor pending
+			return nil, nil
+		}
+		return nil, err
+	}
+	info := res.ActionInfo[0]
+	selp := action.SealedEnvelope{}
+	if err := selp.LoadProto(info.Action); err != nil {
+		return nil, err
+	}
+	if info.BlkHeight == 0 {
+		return web3TransactionOf(selp, nil)
+	}
+	bi, err := svr.indexBlock(info.BlkHeight)
+	if err != nil {
+		return nil, err
+	}
+	return web3TransactionOf(selp, bi)
+}
+
+func (svr *web3Server) getTransactionReceipt(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var h string
+	if err := parseWeb3Params(params, 1, &h); err != nil {
+		return nil, err
+	}
+	actHash, err := web3Hash(h)
+	if err != nil {
+		return nil, err
+	}
+	res, err := svr.api.GetReceiptByAction(ctx, &iotexapi.GetReceiptByActionRequest{
+		ActionHash: hex.EncodeToString(actHash[:]),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			// the action is not committed yet
+			return nil, nil
+		}
+		return nil, err
+	}
+	bi, err := svr.indexBlock(res.ReceiptInfo.Receipt.BlkHeight)
+	if err != nil {
+		return nil, err
+	}
+	index, ok := bi.txIndex[actHash]
+	if !ok {
+		return nil, errors.Errorf("action %x is not in block %d", actHash, bi.blk.Height())
+	}
+	selp := bi.blk.Actions[index]
+	tx, err := web3TransactionOf(selp, bi)
+	if err != nil {
+		return nil, err
+	}
+	receipt := web3Receipt{
+		BlockHash:        *tx.BlockHash,
+		BlockNumber:      *tx.BlockNumber,
+		From:             tx.From,
+		Logs:             []*web3Log{},
+		LogsBloom:        _web3FullBloom,
+		To:               tx.To,
+		TransactionHash:  tx.Hash,
+		TransactionIndex: *tx.TransactionIndex,
+	}
+	for _, r := range bi.receipts {
+		receipt.CumulativeGasUsed += hexutil.Uint64(r.GasConsumed)
+		if r.ActionHash != actHash {
+			continue
+		}
+		receipt.GasUsed = hexutil.Uint64(r.GasConsumed)
+		if r.Status == uint64(iotextypes.ReceiptStatus_Success) {
+			receipt.Status = 1
+		}
+		if exec, ok := selp.Action().(*action.Execution); ok && exec.Contract() == action.EmptyAddress {
+			contract, err := ioToEthAddress(r.ContractAddress)
+			if err != nil {
+				return nil, err
+			}
+			receipt.ContractAddress = &contract
+		}
+		break
+	}
+	for _, l := range bi.logs {
+		if l.TransactionHash == tx.Hash {
+			receipt.Logs = append(receipt.Logs, l)
+		}
+	}
+	return &receipt, nil
+}
+
+func (svr *web3Server) getBlockByNumber(_ context.Context, params []json.RawMessage) (interface{}, error) {
+	var (
+		tag  string
+		full bool
+	)
+	if err := parseWeb3Params(params, 1, &tag, &full); err != nil {
+		return nil, err
+	}
+	height, err := svr.parseBlockTag(tag)
+	if err != nil {
+		return nil, err
+	}
+	if height == 0 || height > svr.api.bc.TipHeight() {
+		return nil, nil
+	}
+	bi, err := svr.indexBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	return svr.web3BlockOf(bi, full)
+}
+
+func (svr *web3Server) getBlockByHash(_ context.Context, params []json.RawMessage) (interface{}, error) {
+	var (
+		h    string
+		full bool
+	)
+	if err := parseWeb3Params(params, 1, &h, &full); err != nil {
+		return nil, err
+	}
+	blkHash, err := web3Hash(h)
+	if err != nil {
+		return nil, err
+	}
+	height, err := svr.api.dao.GetBlockHeight(blkHash)
+	if err != nil {
+		// the block doesn't exist
+		return nil, nil
+	}
+	bi, err := svr.indexBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	return svr.web3BlockOf(bi, full)
+}
+
+func (svr *web3Server) getLogs(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var obj web3FilterObject
+	if err := parseWeb3Params(params, 1, &obj); err != nil {
+		return nil, err
+	}
+	filter, err := parseWeb3Filter(&obj)
+	if err != nil {
+		return nil, err
+	}
+	req := &iotexapi.GetLogsRequest{Filter: filter}
+	if obj.BlockHash != "" {
+		blkHash, err := web3Hash(obj.BlockHash)
+		if err != nil {
+			return nil, err
+		}
+		req.Lookup = &iotexapi.GetLogsRequest_ByBlock{
+			ByBlock: &iotexapi.GetLogsByBlock{BlockHash: blkHash[:]},
+		}
+	} else {
+		from, err := svr.parseBlockTag(obj.FromBlock)
+		if err != nil {
+			return nil, err
+		}
+		to, err := svr.parseBlockTag(obj.ToBlock)
+		if err != nil {
+			return nil, err
+		}
+		if from > to {
+			return nil, &web3Error{Code: _web3ErrInvalidParams, Message: "fromBlock is higher than toBlock"}
+		}
+		req.Lookup = &iotexapi.GetLogsRequest_ByRange{
+			ByRange: &iotexapi.GetLogsByRange{FromBlock: from, Count: to - from + 1},
+		}
+	}
+	res, err := svr.api.GetLogs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// locate the matched logs in their blocks, which are returned in the order of the block logs
+	var (
+		logs    = []*web3Log{}
+		indices = map[uint64]*web3BlockIndex{}
+		cursors = map[uint64]int{}
+	)
+	for _, pb := range res.Logs {
+		bi, ok := indices[pb.BlkHeight]
+		if !ok {
+			if bi, err = svr.indexBlock(pb.BlkHeight); err != nil {
+				return nil, err
+			}
+			indices[pb.BlkHeight] = bi
+		}
+		i := cursors[pb.BlkHeight]
+		for ; i < len(bi.logs); i++ {
+			if proto.Equal(bi.logs[i].pb, pb) {
+				break
+			}
+		}
+		if i == len(bi.logs) {
+			return nil, errors.Errorf("log of action %x is not in block %d", pb.ActHash, pb.BlkHeight)
+		}
+		logs = append(logs, bi.logs[i])
+		cursors[pb.BlkHeight] = i + 1
+	}
+	return logs, nil
+}
+
+func (svr *web3Server) traceTransaction(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var (
+		h   string
+		cfg web3TraceConfig
+	)
+	if err := parseWeb3Params(params, 1, &h, &cfg); err != nil {
+		return nil, err
+	}
+	actHash, err := web3Hash(h)
+	if err != nil {
+		return nil, err
+	}
+	var logConfig *vm.LogConfig
+	switch cfg.Tracer {
+	case "":
+		logConfig = &vm.LogConfig{
+			DisableStorage: cfg.DisableStorage,
+			DisableMemory:  cfg.DisableMemory,
+			DisableStack:   cfg.DisableStack,
+			Limit:          cfg.Limit,
+		}
+	case "callTracer":
+	default:
+		return nil, &web3Error{Code: _web3ErrInvalidParams, Message: "unsupported tracer " + cfg.Tracer}
+	}
+	trace, err := svr.api.traceAction(ctx, actHash, logConfig)
+	if err != nil {
+		return nil, err
+	}
+	if logConfig == nil {
+		if trace.Tracer.CallTree() == nil {
+			return nil, errors.Errorf("action %x is not executed in EVM", actHash)
+		}
+		return web3CallFrameOf(trace.Tracer.CallTree()), nil
+	}
+	res := web3StructLogs{
+		Gas:            trace.Receipt.GasConsumed,
+		Failed:         trace.Receipt.Status != uint64(iotextypes.ReceiptStatus_Success),
+		ReturnValue:    hex.EncodeToString(trace.ReturnValue),
+		StructLogs:     make([]*web3StructLog, 0, len(trace.Tracer.StructLogs())),
+		StorageChanges: make(map[string]map[string]*web3StorageChange),
+	}
+	for _, l := range trace.Tracer.StructLogs() {
+		res.StructLogs = append(res.StructLogs, web3StructLogOf(&l, logConfig))
+	}
+	for addr, slots := range trace.Tracer.StorageChanges() {
+		changes := make(map[string]*web3StorageChange, len(slots))
+		for key, change := range slots {
+			changes[key.Hex()] = &web3StorageChange{
+				Original: change.Original.Hex(),
+				Current:  change.Current.Hex(),
+			}
+		}
+		res.StorageChanges[addr.Hex()] = changes
+	}
+	return &res, nil
+}
+
+// accountMeta returns the account meta of the address and the block tag in the params
+func (svr *web3Server) accountMeta(ctx context.Context, params []json.RawMessage) (*iotextypes.AccountMeta, string, error) {
+	var addrStr, tag string
+	if err := parseWeb3Params(params, 1, &addrStr, &tag); err != nil {
+		return nil, "", err
+	}
+	addr, err := ethToIoAddress(addrStr)
+	if err != nil {
+		return nil, "", err
+	}
+	height, err := svr.stateHeight(tag)
+	if err != nil {
+		return nil, "", err
+	}
+	res, err := svr.api.GetAccount(ctx, &iotexapi.GetAccountRequest{Address: addr.String(), Height: height})
+	if err != nil {
+		return nil, "", err
+	}
+	return res.AccountMeta, tag, nil
+}
+
+// parseCallObject parses the call object in the params into an execution, the caller address and the state height
+func (svr *web3Server) parseCallObject(params []json.RawMessage) (*iotextypes.Execution, string, uint64, error) {
+	var (
+		obj web3CallObject
+		tag string
+	)
+	if err := parseWeb3Params(params, 1, &obj, &tag); err != nil {
+		return nil, "", 0, err
+	}
+	height, err := svr.stateHeight(tag)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	caller := address.ZeroAddress
+	if obj.From != "" {
+		addr, err := ethToIoAddress(obj.From)
+		if err != nil {
+			return nil, "", 0, err
+		}
+		caller = addr.String()
+	}
+	contract := action.EmptyAddress
+	if obj.To != "" {
+		addr, err := ethToIoAddress(obj.To)
+		if err != nil {
+			return nil, "", 0, err
+		}
+		contract = addr.String()
+	}
+	amount := big.NewInt(0)
+	if obj.Value != nil {
+		amount = obj.Value.ToInt()
+	}
+	data := obj.Input
+	if len(data) == 0 {
+		data = obj.Data
+	}
+	return &iotextypes.Execution{
+		Amount:   amount.String(),
+		Contract: contract,
+		Data:     data,
+	}, caller, height, nil
+}
+
+// parseBlockTag returns the block height of a block tag or number
+func (svr *web3Server) parseBlockTag(tag string) (uint64, error) {
+	switch tag {
+	case "", "latest", "pending":
+		return svr.api.bc.TipHeight(), nil
+	case "earliest":
+		// the first block after genesis
+		return 1, nil
+	}
+	height, err := hexutil.DecodeUint64(tag)
+	if err != nil {
+		return 0, newWeb3Error(_web3ErrInvalidParams, errors.Wrapf(err, "invalid block number %s", tag))
+	}
+	return height, nil
+}
+
+// stateHeight returns the height to read the state at of the block tag, which is 0 for the tip
+func (svr *web3Server) stateHeight(tag string) (uint64, error) {
+	height, err := svr.parseBlockTag(tag)
+	if err != nil {
+		return 0, err
+	}
+	tipHeight := svr.api.bc.TipHeight()
+	switch {
+	case height > tipHeight:
+		return 0, &web3Error{Code: _web3ErrInvalidParams, Message: "block number is higher than the tip"}
+	case height == tipHeight:
+		return 0, nil
+	}
+	return height, nil
+}
+
+// indexBlock returns the index of the block at the height
+func (svr *web3Server) indexBlock(height uint64) (*web3BlockIndex, error) {
+	blk, err := svr.api.dao.GetBlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := svr.api.dao.GetReceipts(height)
+	if err != nil {
+		return nil, err
+	}
+	bi := &web3BlockIndex{
+		blk:      blk,
+		hash:     blk.HashBlock(),
+		receipts: receipts,
+		txIndex:  make(map[hash.Hash256]uint64, len(blk.Actions)),
+	}
+	for i, selp := range blk.Actions {
+		bi.txIndex[selp.Hash()] = uint64(i)
+	}
+	for _, r := range receipts {
+		for _, l := range r.Logs() {
+			pb := l.ConvertToLogPb()
+			addr, err := ioToEthAddress(l.Address)
+			if err != nil {
+				return nil, err
+			}
+			topics := make([]string, len(l.Topics))
+			for i, topic := range l.Topics {
+				topics[i] = web3HashString(topic)
+			}
+			bi.logs = append(bi.logs, &web3Log{
+				LogIndex:         hexutil.Uint64(len(bi.logs)),
+				TransactionIndex: hexutil.Uint64(bi.txIndex[l.ActionHash]),
+				TransactionHash:  web3HashString(l.ActionHash),
+				BlockHash:        web3HashString(bi.hash),
+				BlockNumber:      hexutil.Uint64(height),
+				Address:          addr,
+				Data:             l.Data,
+				Topics:           topics,
+				pb:               pb,
+			})
+		}
+	}
+	return bi, nil
+}
+
+// web3BlockOf converts a block into the Ethereum format, with either the full transactions or their hashes
+func (svr *web3Server) web3BlockOf(bi *web3BlockIndex, full bool) (*web3Block, error) {
+	miner, err := ioToEthAddress(bi.blk.ProducerAddress())
+	if err != nil {
+		return nil, err
+	}
+	size, err := bi.blk.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	var gasUsed uint64
+	for _, r := range bi.receipts {
+		gasUsed += r.GasConsumed
+	}
+	res := &web3Block{
+		Number:           hexutil.Uint64(bi.blk.Height()),
+		Hash:             web3HashString(bi.hash),
+		ParentHash:       web3HashString(bi.blk.PrevHash()),
+		Nonce:            "0x0000000000000000",
+		Sha3Uncles:       types.EmptyUncleHash.Hex(),
+		LogsBloom:        _web3FullBloom,
+		TransactionsRoot: web3HashString(bi.blk.TxRoot()),
+		StateRoot:        web3HashString(bi.blk.DeltaStateDigest()),
+		ReceiptsRoot:     web3HashString(bi.blk.ReceiptRoot()),
+		Miner:            miner,
+		ExtraData:        hexutil.Bytes{},
+		Size:             hexutil.Uint64(len(size)),
+		GasLimit:         hexutil.Uint64(svr.api.cfg.Genesis.BlockGasLimit),
+		GasUsed:          hexutil.Uint64(gasUsed),
+		Timestamp:        hexutil.Uint64(bi.blk.Timestamp().Unix()),
+		Transactions:     make([]interface{}, 0, len(bi.blk.Actions)),
+		Uncles:           []string{},
+	}
+	for _, selp := range bi.blk.Actions {
+		if !full {
+			res.Transactions = append(res.Transactions, web3HashString(selp.Hash()))
+			continue
+		}
+		tx, err := web3TransactionOf(selp, bi)
+		if err != nil {
+			return nil, err
+		}
+		res.Transactions = append(res.Transactions, tx)
+	}
+	return res, nil
+}
+
+// web3TransactionOf converts an action into the Ethereum format. The block index is nil for a pending action
+func web3TransactionOf(selp action.SealedEnvelope, bi *web3BlockIndex) (*web3Transaction, error) {
+	sender, err := address.FromBytes(selp.SrcPubkey().Hash())
+	if err != nil {
+		return nil, err
+	}
+	sig := selp.Signature()
+	if len(sig) != 65 {
+		return nil, errors.Errorf("invalid signature length %d", len(sig))
+	}
+	// V is 27 + recovery ID for native actions, and follows EIP-155 for Ethereum RLP encoded actions
+	v := big.NewInt(int64(sig[64]) + 27)
+	if selp.Encoding() == action.EthereumRLP {
+		v = new(big.Int).Add(big.NewInt(int64(selp.EVMNetworkID())*2+35), big.NewInt(int64(sig[64])))
+	}
+	h := selp.Hash()
+	tx := &web3Transaction{
+		From:     common.BytesToAddress(sender.Bytes()).Hex(),
+		Gas:      hexutil.Uint64(selp.GasLimit()),
+		GasPrice: (*hexutil.Big)(selp.GasPrice()),
+		Hash:     web3HashString(h),
+		Input:    hexutil.Bytes{},
+		Nonce:    hexutil.Uint64(selp.Nonce()),
+		Value:    (*hexutil.Big)(big.NewInt(0)),
+		V:        (*hexutil.Big)(v),
+		R:        (*hexutil.Big)(new(big.Int).SetBytes(sig[:32])),
+		S:        (*hexutil.Big)(new(big.Int).SetBytes(sig[32:64])),
+	}
+	var to string
+	switch act := selp.Action().(type) {
+	case *action.Execution:
+		to = act.Contract()
+		tx.Value = (*hexutil.Big)(act.Amount())
+		tx.Input = act.Data()
+	case *action.Transfer:
+		to = act.Recipient()
+		tx.Value = (*hexutil.Big)(act.Amount())
+		tx.Input = act.Payload()
+	}
+	if to != action.EmptyAddress {
+		ethTo, err := ioToEthAddress(to)
+		if err != nil {
+			return nil, err
+		}
+		tx.To = &ethTo
+	}
+	if bi != nil {
+		blkHash := web3HashString(bi.hash)
+		height := hexutil.Uint64(bi.blk.Height())
+		index := hexutil.Uint64(bi.txIndex[h])
+		tx.BlockHash = &blkHash
+		tx.BlockNumber = &height
+		tx.TransactionIndex = &index
+	}
+	return tx, nil
+}
+
+// parseWeb3Filter parses the addresses and topics of a filter object
+func web3StructLogOf(l *vm.StructLog, cfg *vm.LogConfig) *web3StructLog {
+	sl := web3StructLog{
+		Pc:      l.Pc,
+		Op:      l.OpName(),
+		Gas:     l.Gas,
+		GasCost: l.GasCost,
+		Depth:   l.Depth,
+		Error:   l.ErrorString(),
+	}
+	if !cfg.DisableStack {
+		stack := make([]string, len(l.Stack))
+		for i, v := range l.Stack {
+			stack[i] = fmt.Sprintf("%064x", v)
+		}
+		sl.Stack = &stack
+	}
+	if !cfg.DisableMemory {
+		memory := make([]string, 0, (len(l.Memory)+31)/32)
+		for i := 0; i+32 <= len(l.Memory); i += 32 {
+			memory = append(memory, hex.EncodeToString(l.Memory[i:i+32]))
+		}
+		sl.Memory = &memory
+	}
+	if !cfg.DisableStorage {
+		storage := make(map[string]string, len(l.Storage))
+		for k, v := range l.Storage {
+			storage[hex.EncodeToString(k[:])] = hex.EncodeToString(v[:])
+		}
+		sl.Storage = &storage
+	}
+	return &sl
+}
+
+func web3CallFrameOf(frame *evm.CallFrame) *web3CallFrame {
+	f := web3CallFrame{
+		Type:    frame.Type,
+		From:    frame.From.Hex(),
+		To:      frame.To.Hex(),
+		Value:   (*hexutil.Big)(frame.Value),
+		Gas:     hexutil.Uint64(frame.Gas),
+		GasUsed: hexutil.Uint64(frame.GasUsed),
+		Input:   frame.Input,
+		Output:  frame.Output,
+		Error:   frame.Error,
+	}
+	for _, call := range frame.Calls {
+		f.Calls = append(f.Calls, web3CallFrameOf(call))
+	}
+	return &f
+}
+
+func parseWeb3Filter(obj *web3FilterObject) (*iotexapi.LogsFilter, error) {
+	filter := &iotexapi.LogsFilter{}
+	var addrs []string
+	if len(obj.Address) > 0 && string(obj.Address) != "null" {
+		var single string
+		if err := json.Unmarshal(obj.Address, &single); err == nil {
+			addrs = []string{single}
+		} else if err := json.Unmarshal(obj.Address, &addrs); err != nil {
+			return nil, newWeb3Error(_web3ErrInvalidParams, err)
+		}
+	}
+	for _, addrStr := range addrs {
+		addr, err := ethToIoAddress(addrStr)
+		if err != nil {
+			return nil, err
+		}
+		filter.Address = append(filter.Address, addr.String())
+	}
+	// a topic position is either null for any topic, a single topic, or a list of topics to match any
+	for _, raw := range obj.Topics {
+		topics := &iotexapi.Topics{}
+		var list []string
+		if string(raw) != "null" {
+			var single string
+			if err := json.Unmarshal(raw, &single); err == nil {
+				list = []string{single}
+			} else if err := json.Unmarshal(raw, &list); err != nil {
+				return nil, newWeb3Error(_web3ErrInvalidParams, err)
+			}
+		}
+		for _, t := range list {
+			topic, err := web3Hash(t)
+			if err != nil {
+				return nil, err
+			}
+			topics.Topic = append(topics.Topic, topic[:])
+		}
+		filter.Topics = append(filter.Topics, topics)
+	}
+	return filter, nil
+}
+
+// parseWeb3Params unmarshals the params into the outputs, of which the first ones are required
+func parseWeb3Params(params []json.RawMessage, required int, out ...interface{}) error {
+	if len(params) < required {
+		return &web3Error{Code: _web3ErrInvalidParams, Message: "missing params"}
+	}
+	for i, param := range params {
+		if i >= len(out) {
+			break
+		}
+		if err := json.Unmarshal(param, out[i]); err != nil {
+			return newWeb3Error(_web3ErrInvalidParams, errors.Wrapf(err, "invalid param %d", i))
+		}
+	}
+	return nil
+}
+
+// ethToIoAddress converts a 0x address into an io address
+func ethToIoAddress(s string) (address.Address, error) {
+	if !common.IsHexAddress(s) {
+		return nil, &web3Error{Code: _web3ErrInvalidParams, Message: "invalid address " + s}
+	}
+	return address.FromBytes(common.HexToAddress(s).Bytes())
+}
+
+// ioToEthAddress converts an io address into a 0x address
+func ioToEthAddress(s string) (string, error) {
+	addr, err := address.FromString(s)
+	if err != nil {
+		return "", err
+	}
+	return common.BytesToAddress(addr.Bytes()).Hex(), nil
+}
+
+func web3Hash(s string) (hash.Hash256, error) {
+	b, err := hexutil.Decode(s)
+	if err != nil || len(b) != len(hash.ZeroHash256) {
+		return hash.ZeroHash256, &web3Error{Code: _web3ErrInvalidParams, Message: "invalid hash " + s}
+	}
+	return hash.BytesToHash256(b), nil
+}
+
+func web3HashString(h hash.Hash256) string {
+	return "0x" + hex.EncodeToString(h[:])
+}
+
+func newWeb3Error(code int, err error) *web3Error {
+	return &web3Error{Code: code, Message: err.Error()}
+}
+
+// toWeb3Error converts an error into a JSON-RPC error, gRPC invalid argument errors map to invalid params
+func toWeb3Error(err error) *web3Error {
+	if e, ok := errors.Cause(err).(*web3Error); ok {
+		return e
+	}
+	if s, ok := status.FromError(err); ok {
+		if s.Code() == codes.InvalidArgument {
+			return &web3Error{Code: _web3ErrInvalidParams, Message: s.Message()}
+		}
+		return &web3Error{Code: _web3ErrServer, Message: s.Message()}
+	}
+	return &web3Error{Code: _web3ErrServer, Message: err.Error()}
+}
+
+func newWeb3ErrorResponse(id json.RawMessage, code int, msg string) *web3Response {
+	return &web3Response{id: id, err: &web3Error{Code: code, Message: msg}}
+}
+
+func (e *web3Error) Error() string {
+	return e.Message
+}
+
+// MarshalJSON marshals the response with either the result or the error, as JSON-RPC 2.0 requires
+func (res *web3Response) MarshalJSON() ([]byte, error) {
+	id := res.id
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	if res.err != nil {
+		return json.Marshal(&struct {
+			JSONRPC string          `json:"jsonrpc"`
+			ID      json.RawMessage `json:"id"`
+			Error   *web3Error      `json:"error"`
+		}{"2.0", id, res.err})
+	}
+	return json.Marshal(&struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Result  interface{}     `json:"result"`
+	}{"2.0", id, res.result})
+}
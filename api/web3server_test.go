@@ -0,0 +1,261 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+type web3TestResponse struct {
+	ID     int             `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *web3Error      `json:"error"`
+}
+
+func web3Post(t *testing.T, svr *web3Server, body string) []byte {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	svr.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	return rec.Body.Bytes()
+}
+
+func web3Call(t *testing.T, svr *web3Server, method string, params ...interface{}) web3TestResponse {
+	if params == nil {
+		params = []interface{}{}
+	}
+	req, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  params,
+	})
+	require.NoError(t, err)
+	res := web3TestResponse{}
+	require.NoError(t, json.Unmarshal(web3Post(t, svr, string(req)), &res))
+	return res
+}
+
+func web3Result(t *testing.T, svr *web3Server, out interface{}, method string, params ...interface{}) {
+	res := web3Call(t, svr, method, params...)
+	require.Nil(t, res.Error)
+	require.NoError(t, json.Unmarshal(res.Result, out))
+}
+
+func ethAddress(i int) string {
+	return common.BytesToAddress(identityset.Address(i).Bytes()).Hex()
+}
+
+func TestWeb3Server(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig(t)
+	cfg.Genesis.HawaiiBlockHeight = 0
+	cfg.Chain.EnableArchiveMode = true
+	testutil.CleanupPath(t, cfg.Chain.ChainDBPath)
+	testutil.CleanupPath(t, cfg.Chain.TrieDBPath)
+	defer func() {
+		testutil.CleanupPath(t, cfg.Chain.ChainDBPath)
+		testutil.CleanupPath(t, cfg.Chain.TrieDBPath)
+	}()
+
+	api, err := createServer(cfg, false)
+	require.NoError(err)
+	api.broadcastHandler = func(context.Context, uint32, proto.Message) error { return nil }
+	svr := newWeb3Server(api, 0)
+	tip := api.bc.TipHeight()
+
+	t.Run("chain", func(t *testing.T) {
+		var height hexutil.Uint64
+		web3Result(t, svr, &height, "eth_blockNumber")
+		require.EqualValues(tip, height)
+		var chainID hexutil.Uint64
+		web3Result(t, svr, &chainID, "eth_chainId")
+		require.EqualValues(cfg.Genesis.EVMNetworkID, chainID)
+		var version string
+		web3Result(t, svr, &version, "net_version")
+		require.Equal("4689", version)
+	})
+
+	t.Run("account", func(t *testing.T) {
+		res, err := api.GetAccount(context.Background(), &iotexapi.GetAccountRequest{Address: identityset.Address(27).String()})
+		require.NoError(err)
+		var balance hexutil.Big
+		web3Result(t, svr, &balance, "eth_getBalance", ethAddress(27), "latest")
+		require.Equal(res.AccountMeta.Balance, balance.ToInt().String())
+		var nonce hexutil.Uint64
+		web3Result(t, svr, &nonce, "eth_getTransactionCount", ethAddress(27), "latest")
+		require.EqualValues(res.AccountMeta.Nonce+1, nonce)
+		web3Result(t, svr, &nonce, "eth_getTransactionCount", ethAddress(27), "pending")
+		require.EqualValues(res.AccountMeta.PendingNonce, nonce)
+		var code hexutil.Bytes
+		web3Result(t, svr, &code, "eth_getCode", ethAddress(27), "latest")
+		require.Empty(code)
+
+		// historical state, future block and invalid address
+		web3Result(t, svr, &balance, "eth_getBalance", ethAddress(30), "0x1")
+		require.EqualValues(10, balance.ToInt().Int64())
+		r := web3Call(t, svr, "eth_getBalance", ethAddress(27), hexutil.EncodeUint64(tip+1))
+		require.Equal(_web3ErrInvalidParams, r.Error.Code)
+		r = web3Call(t, svr, "eth_getBalance", identityset.Address(27).String(), "latest")
+		require.Equal(_web3ErrInvalidParams, r.Error.Code)
+	})
+
+	t.Run("block and transaction", func(t *testing.T) {
+		blk, err := api.dao.GetBlockByHeight(1)
+		require.NoError(err)
+		var hashes struct {
+			Hash         string   `json:"hash"`
+			Transactions []string `json:"transactions"`
+		}
+		web3Result(t, svr, &hashes, "eth_getBlockByNumber", "0x1", false)
+		require.Equal("0x"+blkHash[1], hashes.Hash)
+		require.Equal(len(blk.Actions), len(hashes.Transactions))
+		var full struct {
+			Number       hexutil.Uint64     `json:"number"`
+			Transactions []*web3Transaction `json:"transactions"`
+		}
+		web3Result(t, svr, &full, "eth_getBlockByHash", hashes.Hash, true)
+		require.EqualValues(1, full.Number)
+		require.Equal(hashes.Transactions[0], full.Transactions[0].Hash)
+		require.Equal(ethAddress(27), full.Transactions[0].From)
+		require.Equal(ethAddress(30), *full.Transactions[0].To)
+		require.EqualValues(10, full.Transactions[0].Value.ToInt().Int64())
+
+		var tx web3Transaction
+		web3Result(t, svr, &tx, "eth_getTransactionByHash", hashes.Transactions[0])
+		require.Equal(hashes.Hash, *tx.BlockHash)
+		require.EqualValues(0, *tx.TransactionIndex)
+		var receipt web3Receipt
+		web3Result(t, svr, &receipt, "eth_getTransactionReceipt", hashes.Transactions[0])
+		require.EqualValues(1, receipt.Status)
+		require.EqualValues(1, receipt.BlockNumber)
+		require.Equal(ethAddress(27), receipt.From)
+		require.Nil(receipt.ContractAddress)
+
+		// non-existing block and transaction
+		r := web3Call(t, svr, "eth_getBlockByNumber", hexutil.EncodeUint64(tip+1), false)
+		require.Nil(r.Error)
+		require.Equal("null", string(r.Result))
+		r = web3Call(t, svr, "eth_getTransactionReceipt", "0x"+strings.Repeat("00", 32))
+		require.Nil(r.Error)
+		require.Equal("null", string(r.Result))
+	})
+
+	t.Run("logs", func(t *testing.T) {
+		var logs []*web3Log
+		web3Result(t, svr, &logs, "eth_getLogs", map[string]interface{}{
+			"fromBlock": "earliest",
+			"toBlock":   "latest",
+			"address":   ethAddress(31),
+		})
+		require.Empty(logs)
+		r := web3Call(t, svr, "eth_getLogs", map[string]interface{}{"fromBlock": "0x2", "toBlock": "0x1"})
+		require.Equal(_web3ErrInvalidParams, r.Error.Code)
+	})
+
+	t.Run("execution", func(t *testing.T) {
+		call := map[string]interface{}{
+			"from":  ethAddress(27),
+			"to":    ethAddress(31),
+			"value": "0x1",
+		}
+		var gas hexutil.Uint64
+		web3Result(t, svr, &gas, "eth_estimateGas", call)
+		require.NotZero(gas)
+		var data hexutil.Bytes
+		web3Result(t, svr, &data, "eth_call", call, "latest")
+		require.Empty(data)
+	})
+
+	t.Run("trace transaction", func(t *testing.T) {
+		h := web3HashString(executionHash1)
+		var structLogs web3StructLogs
+		web3Result(t, svr, &structLogs, "debug_traceTransaction", h)
+		require.False(structLogs.Failed)
+		require.NotZero(structLogs.Gas)
+		require.Empty(structLogs.StructLogs)
+		var call web3CallFrame
+		web3Result(t, svr, &call, "debug_traceTransaction", h, map[string]interface{}{"tracer": "callTracer"})
+		require.Equal("CALL", call.Type)
+		require.Equal(ethAddress(30), call.From)
+		require.Equal(ethAddress(31), call.To)
+		require.EqualValues(1, call.Value.ToInt().Int64())
+		require.Equal(hexutil.Bytes{1}, call.Input)
+
+		r := web3Call(t, svr, "debug_traceTransaction", h, map[string]interface{}{"tracer": "prestateTracer"})
+		require.Equal(_web3ErrInvalidParams, r.Error.Code)
+		r = web3Call(t, svr, "debug_traceTransaction", web3HashString(transferHash1))
+		require.Equal(_web3ErrInvalidParams, r.Error.Code)
+	})
+
+	t.Run("send raw transaction", func(t *testing.T) {
+		var nonce hexutil.Uint64
+		web3Result(t, svr, &nonce, "eth_getTransactionCount", ethAddress(28), "pending")
+		to := common.HexToAddress(ethAddress(29))
+		tx := types.NewTransaction(uint64(nonce), to, big.NewInt(10), 100000, big.NewInt(0), nil)
+		sk := identityset.PrivateKey(28).EcdsaPrivateKey().(*ecdsa.PrivateKey)
+		tx, err := types.SignTx(tx, types.NewEIP155Signer(big.NewInt(int64(cfg.Genesis.EVMNetworkID))), sk)
+		require.NoError(err)
+		raw, err := rlp.EncodeToBytes(tx)
+		require.NoError(err)
+
+		var h string
+		web3Result(t, svr, &h, "eth_sendRawTransaction", hexutil.Encode(raw))
+		require.Equal(tx.Hash().Hex(), h)
+		var pending web3Transaction
+		web3Result(t, svr, &pending, "eth_getTransactionByHash", h)
+		require.Nil(pending.BlockHash)
+		require.Equal(ethAddress(28), pending.From)
+		require.Equal(to.Hex(), *pending.To)
+		v, r, s := tx.RawSignatureValues()
+		require.Equal(v, pending.V.ToInt())
+		require.Equal(r, pending.R.ToInt())
+		require.Equal(s, pending.S.ToInt())
+
+		res := web3Call(t, svr, "eth_sendRawTransaction", "0x010203")
+		require.Equal(_web3ErrInvalidParams, res.Error.Code)
+	})
+
+	t.Run("protocol errors", func(t *testing.T) {
+		res := web3Call(t, svr, "eth_mining")
+		require.Equal(_web3ErrMethodNotFound, res.Error.Code)
+		res = web3TestResponse{}
+		require.NoError(json.Unmarshal(web3Post(t, svr, "{"), &res))
+		require.Equal(_web3ErrParse, res.Error.Code)
+		require.NoError(json.Unmarshal(web3Post(t, svr, `{"id":1,"method":"eth_blockNumber"}`), &res))
+		require.Equal(_web3ErrInvalidRequest, res.Error.Code)
+
+		var batch []web3TestResponse
+		require.NoError(json.Unmarshal(web3Post(t, svr, `[
+			{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},
+			{"jsonrpc":"2.0","id":2,"method":"eth_unknown"}
+		]`), &batch))
+		require.Equal(2, len(batch))
+		require.Equal(1, batch[0].ID)
+		require.Nil(batch[0].Error)
+		require.Equal(2, batch[1].ID)
+		require.Equal(_web3ErrMethodNotFound, batch[1].Error.Code)
+	})
+}
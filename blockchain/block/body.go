@@ -66,6 +66,11 @@ func (b *Body) CalculateTxRoot() hash.Hash256 {
 	return calculateTxRoot(b.Actions)
 }
 
+// TxProof returns the index of an action in this block, and the Merkle audit path from the action hash to tx root
+func (b *Body) TxProof(h hash.Hash256) (int, []hash.Hash256, error) {
+	return calculateTxProof(b.Actions, h)
+}
+
 // CalculateTransferAmount returns the calculated transfer amount in this block.
 func (b *Body) CalculateTransferAmount() *big.Int {
 	return calculateTransferAmount(b.Actions)
@@ -11,9 +11,11 @@ import (
 	"testing"
 
 	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/crypto"
 	"github.com/iotexproject/iotex-core/test/identityset"
 )
 
@@ -75,6 +77,20 @@ func TestCalculateTxRoot(t *testing.T) {
 	require.NotEqual(h, hash.ZeroHash256)
 }
 
+func TestTxProof(t *testing.T) {
+	require := require.New(t)
+	body, err := makeBody()
+	require.NoError(err)
+	h := body.Actions[0].Hash()
+	index, proof, err := body.TxProof(h)
+	require.NoError(err)
+	require.Equal(0, index)
+	require.True(crypto.VerifyMerkleProof(body.CalculateTxRoot(), h, index, proof))
+
+	_, _, err = body.TxProof(hash.ZeroHash256)
+	require.Equal(action.ErrNotFound, errors.Cause(err))
+}
+
 func TestCalculateTransferAmount(t *testing.T) {
 	require := require.New(t)
 	body := Body{}
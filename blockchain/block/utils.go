@@ -28,6 +28,26 @@ func calculateTxRoot(acts []action.SealedEnvelope) hash.Hash256 {
 	return crypto.NewMerkleTree(h).HashTree()
 }
 
+func calculateTxProof(acts []action.SealedEnvelope, actHash hash.Hash256) (int, []hash.Hash256, error) {
+	index := -1
+	h := make([]hash.Hash256, 0, len(acts))
+	for i, act := range acts {
+		ah := act.Hash()
+		if ah == actHash {
+			index = i
+		}
+		h = append(h, ah)
+	}
+	if index < 0 {
+		return 0, nil, errors.Wrapf(action.ErrNotFound, "action %x is not in the block", actHash)
+	}
+	proof, err := crypto.NewMerkleTree(h).MerkleProof(index)
+	if err != nil {
+		return 0, nil, err
+	}
+	return index, proof, nil
+}
+
 // calculateTransferAmount returns the calculated transfer amount
 func calculateTransferAmount(acts []action.SealedEnvelope) *big.Int {
 	transferAmount := big.NewInt(0)
@@ -366,6 +366,7 @@ func (bc *blockchain) context(ctx context.Context, tipInfoFlag bool) (context.Co
 		ctx,
 		protocol.BlockchainCtx{
 			Genesis: bc.config.Genesis,
+			ChainID: bc.config.Chain.ID,
 			Tip:     tip,
 		},
 	), nil
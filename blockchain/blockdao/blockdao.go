@@ -25,6 +25,7 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/filedao"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/prometheustimer"
@@ -64,6 +65,19 @@ type (
 		DeleteTipBlock(blk *block.Block) error
 	}
 
+	// BlockIndexerWithReceipts is the BlockIndexer which reads the receipts of the block, which are loaded for it when
+	// the indexer catches up with the chain
+	BlockIndexerWithReceipts interface {
+		BlockIndexer
+		ReadsReceipts() bool
+	}
+
+	// PrunedBlockIndexer is the BlockIndexer which is notified of the pruned blocks
+	PrunedBlockIndexer interface {
+		BlockIndexer
+		PruneBlocks(start, end uint64) error
+	}
+
 	blockDAO struct {
 		blockStore   filedao.FileDAO
 		indexers     []BlockIndexer
@@ -73,6 +87,10 @@ type (
 		bodyCache    *cache.ThreadSafeLruCache
 		footerCache  *cache.ThreadSafeLruCache
 		tipHeight    uint64
+		prunedEnd    uint64
+		pruneSignal  chan struct{}
+		pruneQuit    chan struct{}
+		pruneDone    chan struct{}
 	}
 )
 
@@ -106,7 +124,18 @@ func (dao *blockDAO) Start(ctx context.Context) error {
 		return err
 	}
 	atomic.StoreUint64(&dao.tipHeight, tipHeight)
-	return dao.checkIndexers(ctx)
+	if err := dao.checkIndexers(ctx); err != nil {
+		return err
+	}
+	if _, ok := dao.blockStore.(filedao.BlockPruner); ok {
+		// old blocks are pruned in the background, so that deleting the db files does not block committing blocks
+		dao.pruneSignal = make(chan struct{}, 1)
+		dao.pruneQuit = make(chan struct{})
+		dao.pruneDone = make(chan struct{})
+		go dao.pruneRoutine(dao.pruneSignal, dao.pruneQuit, dao.pruneDone)
+		dao.triggerPruning()
+	}
+	return nil
 }
 
 func (dao *blockDAO) fillWithBlockInfoAsTip(ctx context.Context, height uint64) (context.Context, error) {
@@ -148,11 +177,18 @@ func (dao *blockDAO) checkIndexers(ctx context.Context) error {
 			// TODO: delete block
 			return errors.New("indexer tip height cannot by higher than dao tip height")
 		}
+		ri, ok := indexer.(BlockIndexerWithReceipts)
+		readsReceipts := ok && ri.ReadsReceipts()
 		for i := tipHeight + 1; i <= dao.tipHeight; i++ {
 			blk, err := dao.GetBlockByHeight(i)
 			if err != nil {
 				return err
 			}
+			if readsReceipts {
+				if blk.Receipts, err = dao.GetReceipts(i); err != nil {
+					return err
+				}
+			}
 			producer, err := address.FromBytes(blk.PublicKey().Hash())
 			if err != nil {
 				return err
@@ -189,7 +225,14 @@ func (dao *blockDAO) checkIndexers(ctx context.Context) error {
 	return nil
 }
 
-func (dao *blockDAO) Stop(ctx context.Context) error { return dao.lifecycle.OnStop(ctx) }
+func (dao *blockDAO) Stop(ctx context.Context) error {
+	if dao.pruneQuit != nil {
+		close(dao.pruneQuit)
+		<-dao.pruneDone
+		dao.pruneQuit = nil
+	}
+	return dao.lifecycle.OnStop(ctx)
+}
 
 func (dao *blockDAO) GetBlockHash(height uint64) (hash.Hash256, error) {
 	timer := dao.timerFactory.NewTimer("get_block_hash")
@@ -224,6 +267,9 @@ func (dao *blockDAO) HeaderByHeight(height uint64) (*block.Header, error) {
 
 	blk, err := dao.blockStore.GetBlockByHeight(height)
 	if err != nil {
+		if errors.Cause(err) == db.ErrPruned {
+			return dao.prunedHeader(height)
+		}
 		return nil, err
 	}
 
@@ -262,7 +308,14 @@ func (dao *blockDAO) Header(h hash.Hash256) (*block.Header, error) {
 
 	blk, err := dao.GetBlock(h)
 	if err != nil {
-		return nil, err
+		if errors.Cause(err) != db.ErrPruned {
+			return nil, err
+		}
+		height, err := dao.GetBlockHeight(h)
+		if err != nil {
+			return nil, err
+		}
+		return dao.prunedHeader(height)
 	}
 
 	header := blk.Header
@@ -369,9 +422,67 @@ func (dao *blockDAO) PutBlock(ctx context.Context, blk *block.Block) error {
 			return err
 		}
 	}
+	dao.triggerPruning()
+	return nil
+}
+
+// triggerPruning notifies the pruning routine without blocking, a pending notification covers the new block as well
+func (dao *blockDAO) triggerPruning() {
+	if dao.pruneSignal == nil {
+		return
+	}
+	select {
+	case dao.pruneSignal <- struct{}{}:
+	default:
+	}
+}
+
+func (dao *blockDAO) pruneRoutine(signal <-chan struct{}, quit <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	for {
+		select {
+		case <-quit:
+			return
+		case <-signal:
+			if err := dao.pruneBlocks(); err != nil {
+				log.L().Error("Failed to prune blocks.", zap.Error(err))
+			}
+		}
+	}
+}
+
+// pruneBlocks prunes the old blocks if the block store supports it, and notifies the indexers of the pruned range
+func (dao *blockDAO) pruneBlocks() error {
+	pruner, ok := dao.blockStore.(filedao.BlockPruner)
+	if !ok {
+		return nil
+	}
+	start, end, err := pruner.PruneBlocks()
+	if err != nil {
+		return errors.Wrap(err, "failed to prune blocks")
+	}
+	if start == 0 || end == dao.prunedEnd {
+		return nil
+	}
+	for _, indexer := range dao.indexers {
+		if p, ok := indexer.(PrunedBlockIndexer); ok {
+			if err := p.PruneBlocks(start, end); err != nil {
+				return err
+			}
+		}
+	}
+	dao.prunedEnd = end
 	return nil
 }
 
+func (dao *blockDAO) prunedHeader(height uint64) (*block.Header, error) {
+	pruner, ok := dao.blockStore.(filedao.BlockPruner)
+	if !ok {
+		return nil, errors.Wrapf(db.ErrPruned, "block at height %d", height)
+	}
+	return pruner.PrunedHeader(height)
+}
+
 func (dao *blockDAO) DeleteTipBlock() error {
 	timer := dao.timerFactory.NewTimer("del_block")
 	defer timer.End()
@@ -5,6 +5,7 @@ import (
 	"hash/fnv"
 	"math/big"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -497,3 +498,192 @@ func BenchmarkBlockCache(b *testing.B) {
 		test(0, b)
 	})
 }
+
+type (
+	// testPruner prunes the blocks except the last 2 ones from an in-memory FileDAO
+	testPruner struct {
+		filedao.FileDAO
+		mutex   sync.RWMutex
+		headers map[uint64]*block.Header
+		end     uint64
+	}
+
+	testPrunedIndexer struct {
+		mutex      sync.RWMutex
+		height     uint64
+		start, end uint64
+	}
+)
+
+func (p *testPruner) GetBlockByHeight(height uint64) (*block.Block, error) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	if height <= p.end {
+		return nil, errors.Wrapf(db.ErrPruned, "block at height %d", height)
+	}
+	return p.FileDAO.GetBlockByHeight(height)
+}
+
+func (p *testPruner) PruneBlocks() (uint64, uint64, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	tip, err := p.FileDAO.Height()
+	if err != nil {
+		return 0, 0, err
+	}
+	for ; p.end+2 < tip; p.end++ {
+		blk, err := p.FileDAO.GetBlockByHeight(p.end + 1)
+		if err != nil {
+			return 0, 0, err
+		}
+		p.headers[p.end+1] = &blk.Header
+	}
+	if p.end == 0 {
+		return 0, 0, nil
+	}
+	return 1, p.end, nil
+}
+
+func (p *testPruner) PrunedHeader(height uint64) (*block.Header, error) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	header, ok := p.headers[height]
+	if !ok {
+		return nil, db.ErrNotExist
+	}
+	return header, nil
+}
+
+func (x *testPrunedIndexer) Start(context.Context) error { return nil }
+
+func (x *testPrunedIndexer) Stop(context.Context) error { return nil }
+
+func (x *testPrunedIndexer) Height() (uint64, error) {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+	return x.height, nil
+}
+
+func (x *testPrunedIndexer) PutBlock(_ context.Context, blk *block.Block) error {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	x.height = blk.Height()
+	return nil
+}
+
+func (x *testPrunedIndexer) DeleteTipBlock(*block.Block) error {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	x.height--
+	return nil
+}
+
+func (x *testPrunedIndexer) PruneBlocks(start, end uint64) error {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	x.start, x.end = start, end
+	return nil
+}
+
+func (x *testPrunedIndexer) prunedRange() (uint64, uint64) {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+	return x.start, x.end
+}
+
+func TestBlockDAO_PruneBlocks(t *testing.T) {
+	require := require.New(t)
+
+	cfg := config.Default.DB
+	fd, err := filedao.NewFileDAOInMemForTest(cfg)
+	require.NoError(err)
+	pruner := &testPruner{FileDAO: fd, headers: map[uint64]*block.Header{}}
+	indexer := &testPrunedIndexer{}
+	dao := createBlockDAO(pruner, []BlockIndexer{indexer}, cfg)
+	ctx := protocol.WithBlockchainCtx(
+		context.Background(),
+		protocol.BlockchainCtx{
+			Genesis: config.Default.Genesis,
+		},
+	)
+	// the indexer is up to date, and not notified before any block is pruned
+	require.NoError(dao.Start(ctx))
+	defer func() {
+		require.NoError(dao.Stop(ctx))
+	}()
+
+	blks := getTestBlocks(t)
+	for _, blk := range blks {
+		require.NoError(dao.PutBlock(ctx, blk))
+	}
+	// the blocks are pruned in the background
+	require.NoError(testutil.WaitUntil(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+		start, end := indexer.prunedRange()
+		return start == 1 && end == 1, nil
+	}))
+
+	// the headers of pruned blocks are still available
+	header, err := dao.HeaderByHeight(1)
+	require.NoError(err)
+	require.Equal(blks[0].HashBlock(), header.HashBlock())
+	header, err = dao.HeaderByHeight(2)
+	require.NoError(err)
+	require.Equal(blks[1].HashBlock(), header.HashBlock())
+	_, err = dao.GetBlockByHeight(1)
+	require.Equal(db.ErrPruned, errors.Cause(err))
+	_, err = dao.FooterByHeight(1)
+	require.Equal(db.ErrPruned, errors.Cause(err))
+}
+
+// testReceiptIndexer counts the receipts of the indexed blocks
+type testReceiptIndexer struct {
+	testPrunedIndexer
+	readsReceipts bool
+	receipts      int
+}
+
+func (x *testReceiptIndexer) ReadsReceipts() bool { return x.readsReceipts }
+
+func (x *testReceiptIndexer) PutBlock(ctx context.Context, blk *block.Block) error {
+	x.receipts += len(blk.Receipts)
+	return x.testPrunedIndexer.PutBlock(ctx, blk)
+}
+
+func TestBlockDAO_CheckIndexers(t *testing.T) {
+	require := require.New(t)
+
+	cfg := config.Default.DB
+	fd, err := filedao.NewFileDAOInMemForTest(cfg)
+	require.NoError(err)
+	dao := createBlockDAO(fd, nil, cfg)
+	ctx := protocol.WithBlockchainCtx(
+		context.Background(),
+		protocol.BlockchainCtx{
+			Genesis: config.Default.Genesis,
+		},
+	)
+	require.NoError(dao.Start(ctx))
+	defer func() {
+		require.NoError(dao.Stop(ctx))
+	}()
+	blks := getTestBlocks(t)
+	for _, blk := range blks {
+		blk.Receipts = []*action.Receipt{{Status: 1, BlockHeight: blk.Height(), ActionHash: blk.HashBlock()}}
+		require.NoError(dao.PutBlock(ctx, blk))
+		blk.Receipts = nil
+	}
+
+	// the receipts are loaded only for the indexer which reads them
+	withReceipts := &testReceiptIndexer{readsReceipts: true}
+	withoutReceipts := &testReceiptIndexer{}
+	d := dao.(*blockDAO)
+	d.indexers = []BlockIndexer{withReceipts, withoutReceipts}
+	require.NoError(d.checkIndexers(ctx))
+	for _, indexer := range d.indexers {
+		height, err := indexer.Height()
+		require.NoError(err)
+		require.EqualValues(len(blks), height)
+	}
+	require.Equal(len(blks), withReceipts.receipts)
+	require.Zero(withoutReceipts.receipts)
+}
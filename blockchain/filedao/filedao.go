@@ -9,6 +9,8 @@ package filedao
 import (
 	"context"
 	"fmt"
+	"os"
+	"sync"
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -19,6 +21,7 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/pkg/log"
 )
 
@@ -60,11 +63,23 @@ type (
 		DeleteTipBlock() error
 	}
 
+	// BlockPruner is the FileDAO which prunes old blocks, while retaining their headers
+	BlockPruner interface {
+		FileDAO
+		// PruneBlocks deletes the split db files of old blocks, and returns the range of pruned heights
+		PruneBlocks() (uint64, uint64, error)
+		// PrunedHeader returns the header of a pruned block
+		PrunedHeader(uint64) (*block.Header, error)
+	}
+
 	// fileDAO implements FileDAO
 	fileDAO struct {
+		lock     sync.RWMutex
+		cfg      config.DB
 		currFd   FileDAO
 		legacyFd FileDAO
 		v2Fd     FileV2Manager // a collection of v2 db files
+		pruned   *prunedHeaders
 	}
 )
 
@@ -116,6 +131,12 @@ func (fd *fileDAO) Start(ctx context.Context) error {
 		if err := fd.v2Fd.Start(ctx); err != nil {
 			return err
 		}
+		if _, exist := fileExists(prunedHeadersFileName(fd.cfg.DbPath)); exist || fd.cfg.EnableBlockPruning() {
+			fd.pruned = newPrunedHeaders(fd.cfg)
+			if err := fd.pruned.Start(ctx); err != nil {
+				return err
+			}
+		}
 	}
 
 	if fd.v2Fd != nil {
@@ -132,6 +153,11 @@ func (fd *fileDAO) Stop(ctx context.Context) error {
 			return err
 		}
 	}
+	if fd.pruned != nil {
+		if err := fd.pruned.Stop(ctx); err != nil {
+			return err
+		}
+	}
 	if fd.v2Fd != nil {
 		return fd.v2Fd.Stop(ctx)
 	}
@@ -139,10 +165,15 @@ func (fd *fileDAO) Stop(ctx context.Context) error {
 }
 
 func (fd *fileDAO) Height() (uint64, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
 	return fd.currFd.Height()
 }
 
 func (fd *fileDAO) GetBlockHash(height uint64) (hash.Hash256, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
+
 	if fd.v2Fd != nil {
 		if height == 0 {
 			return hash.ZeroHash256, nil
@@ -150,6 +181,13 @@ func (fd *fileDAO) GetBlockHash(height uint64) (hash.Hash256, error) {
 		if v2 := fd.v2Fd.FileDAOByHeight(height); v2 != nil {
 			return v2.GetBlockHash(height)
 		}
+		if fd.isPruned(height) {
+			header, err := fd.pruned.Header(height)
+			if err != nil {
+				return hash.ZeroHash256, err
+			}
+			return header.HashBlock(), nil
+		}
 	}
 
 	if fd.legacyFd != nil {
@@ -159,6 +197,12 @@ func (fd *fileDAO) GetBlockHash(height uint64) (hash.Hash256, error) {
 }
 
 func (fd *fileDAO) GetBlockHeight(hash hash.Hash256) (uint64, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
+	return fd.getBlockHeight(hash)
+}
+
+func (fd *fileDAO) getBlockHeight(hash hash.Hash256) (uint64, error) {
 	var (
 		height uint64
 		err    error
@@ -168,6 +212,11 @@ func (fd *fileDAO) GetBlockHeight(hash hash.Hash256) (uint64, error) {
 			return height, nil
 		}
 	}
+	if fd.pruned != nil {
+		if h, e := fd.pruned.Height(hash); e == nil {
+			return h, nil
+		}
+	}
 
 	if fd.legacyFd != nil {
 		return fd.legacyFd.GetBlockHeight(hash)
@@ -176,6 +225,9 @@ func (fd *fileDAO) GetBlockHeight(hash hash.Hash256) (uint64, error) {
 }
 
 func (fd *fileDAO) GetBlock(hash hash.Hash256) (*block.Block, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
+
 	var (
 		blk *block.Block
 		err error
@@ -185,6 +237,11 @@ func (fd *fileDAO) GetBlock(hash hash.Hash256) (*block.Block, error) {
 			return blk, nil
 		}
 	}
+	if fd.pruned != nil {
+		if height, e := fd.pruned.Height(hash); e == nil {
+			return nil, errors.Wrapf(db.ErrPruned, "block %x at height %d", hash, height)
+		}
+	}
 
 	if fd.legacyFd != nil {
 		return fd.legacyFd.GetBlock(hash)
@@ -193,10 +250,16 @@ func (fd *fileDAO) GetBlock(hash hash.Hash256) (*block.Block, error) {
 }
 
 func (fd *fileDAO) GetBlockByHeight(height uint64) (*block.Block, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
+
 	if fd.v2Fd != nil {
 		if v2 := fd.v2Fd.FileDAOByHeight(height); v2 != nil {
 			return v2.GetBlockByHeight(height)
 		}
+		if fd.isPruned(height) {
+			return nil, errors.Wrapf(db.ErrPruned, "block at height %d", height)
+		}
 	}
 
 	if fd.legacyFd != nil {
@@ -206,10 +269,16 @@ func (fd *fileDAO) GetBlockByHeight(height uint64) (*block.Block, error) {
 }
 
 func (fd *fileDAO) GetReceipts(height uint64) ([]*action.Receipt, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
+
 	if fd.v2Fd != nil {
 		if v2 := fd.v2Fd.FileDAOByHeight(height); v2 != nil {
 			return v2.GetReceipts(height)
 		}
+		if fd.isPruned(height) {
+			return nil, errors.Wrapf(db.ErrPruned, "receipts at height %d", height)
+		}
 	}
 
 	if fd.legacyFd != nil {
@@ -219,15 +288,23 @@ func (fd *fileDAO) GetReceipts(height uint64) ([]*action.Receipt, error) {
 }
 
 func (fd *fileDAO) ContainsTransactionLog() bool {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
 	// TODO: change to ContainsTransactionLog(uint64)
 	return fd.currFd.ContainsTransactionLog()
 }
 
 func (fd *fileDAO) TransactionLogs(height uint64) (*iotextypes.TransactionLogs, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
+
 	if fd.v2Fd != nil {
 		if v2 := fd.v2Fd.FileDAOByHeight(height); v2 != nil {
 			return v2.TransactionLogs(height)
 		}
+		if fd.isPruned(height) {
+			return nil, errors.Wrapf(db.ErrPruned, "transaction log at height %d", height)
+		}
 	}
 
 	if fd.legacyFd != nil {
@@ -237,20 +314,191 @@ func (fd *fileDAO) TransactionLogs(height uint64) (*iotextypes.TransactionLogs,
 }
 
 func (fd *fileDAO) PutBlock(ctx context.Context, blk *block.Block) error {
+	fd.lock.Lock()
+	defer fd.lock.Unlock()
+
 	// bail out if block already exists
 	h := blk.HashBlock()
-	if _, err := fd.GetBlockHeight(h); err == nil {
+	if _, err := fd.getBlockHeight(h); err == nil {
 		log.L().Error("Block already exists.", zap.Uint64("height", blk.Height()), log.Hex("hash", h[:]))
 		return ErrAlreadyExist
 	}
-	// TODO: check if need to split DB
-	return fd.currFd.PutBlock(ctx, blk)
+	if err := fd.currFd.PutBlock(ctx, blk); err != nil {
+		return err
+	}
+	if !fd.cfg.EnableBlockPruning() {
+		return nil
+	}
+	// the chain db is split only for the old files to be pruned
+	return fd.splitIfNeeded(ctx, blk.Height())
 }
 
 func (fd *fileDAO) DeleteTipBlock() error {
+	fd.lock.Lock()
+	defer fd.lock.Unlock()
+
+	if err := fd.removeEmptyTop(); err != nil {
+		return err
+	}
 	return fd.currFd.DeleteTipBlock()
 }
 
+// PruneBlocks deletes the split db files whose blocks are all below the pruning target. The headers of these blocks
+// are retained, and the default db file is kept as an empty file
+func (fd *fileDAO) PruneBlocks() (uint64, uint64, error) {
+	start, end, files, err := fd.pruneFiles()
+	if err != nil {
+		return 0, 0, err
+	}
+	// the pruned files have been closed and detached, delete them without holding the lock
+	for _, name := range files {
+		if err := os.Remove(name); err != nil {
+			return 0, 0, errors.Wrapf(err, "failed to delete db file %s", name)
+		}
+	}
+	return start, end, nil
+}
+
+// pruneFiles moves the headers of the files below the pruning target into the pruned headers, and returns the names
+// of the detached files to be deleted
+func (fd *fileDAO) pruneFiles() (uint64, uint64, []string, error) {
+	fd.lock.Lock()
+	defer fd.lock.Unlock()
+
+	if fd.pruned == nil || !fd.cfg.EnableBlockPruning() {
+		return 0, 0, nil, nil
+	}
+	tip, err := fd.currFd.Height()
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	target := fd.cfg.PruneBelowHeight
+	if keep := fd.cfg.PruneKeepBlocks; keep > 0 && tip >= keep && tip-keep+1 > target {
+		target = tip - keep + 1
+	}
+
+	var (
+		ctx   = context.Background()
+		files []string
+	)
+	// the top file is never pruned
+	for i := 0; i < len(fd.v2Fd)-1; {
+		file := fd.v2Fd[i]
+		if file.end < file.start {
+			// empty file
+			i++
+			continue
+		}
+		if file.end >= target {
+			break
+		}
+		headers := make([]*block.Header, 0, file.end-file.start+1)
+		for height := file.start; height <= file.end; height++ {
+			blk, err := file.fd.GetBlockByHeight(height)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			headers = append(headers, &blk.Header)
+		}
+		if err := fd.pruned.Put(headers); err != nil {
+			return 0, 0, nil, err
+		}
+		if err := file.fd.Stop(ctx); err != nil {
+			return 0, 0, nil, err
+		}
+		name := file.fd.cfg.DbPath
+		log.L().Info("Pruned blocks.",
+			zap.String("file", name),
+			zap.Uint64("start", file.start),
+			zap.Uint64("end", file.end))
+		if name != fd.cfg.DbPath {
+			fd.v2Fd = append(fd.v2Fd[:i], fd.v2Fd[i+1:]...)
+			files = append(files, name)
+			continue
+		}
+		// keep the default db file for the chain db to be recognized on restart
+		if err := os.Remove(name); err != nil {
+			return 0, 0, nil, errors.Wrapf(err, "failed to delete db file %s", name)
+		}
+		if err := createNewV2File(1, fd.cfg); err != nil {
+			return 0, 0, nil, err
+		}
+		v2 := openFileDAOv2(fd.cfg)
+		if err := v2.Start(ctx); err != nil {
+			return 0, 0, nil, err
+		}
+		fd.v2Fd[i] = &fileV2Index{start: 1, end: 0, fd: v2}
+		i++
+	}
+	start, end := fd.pruned.Range()
+	return start, end, files, nil
+}
+
+// PrunedHeader returns the header of a pruned block
+func (fd *fileDAO) PrunedHeader(height uint64) (*block.Header, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
+
+	if !fd.isPruned(height) {
+		return nil, errors.Wrapf(db.ErrNotExist, "block at height %d is not pruned", height)
+	}
+	return fd.pruned.Header(height)
+}
+
+func (fd *fileDAO) isPruned(height uint64) bool {
+	return fd.pruned != nil && fd.pruned.Contains(height)
+}
+
+// splitIfNeeded starts a new v2 db file after the height, once the top file exceeds the split size
+func (fd *fileDAO) splitIfNeeded(ctx context.Context, height uint64) error {
+	if fd.v2Fd == nil || fd.cfg.SplitDBSizeMB == 0 || height < fd.cfg.SplitDBHeight {
+		return nil
+	}
+	top := fd.v2Fd[len(fd.v2Fd)-1]
+	if size, _ := fileExists(top.fd.cfg.DbPath); uint64(size) < fd.cfg.SplitDBSize() {
+		return nil
+	}
+
+	cfg := fd.cfg
+	cfg.DbPath = kthAuxFileName(fd.cfg.DbPath, nextAuxFileIndex(fd.cfg.DbPath))
+	v2, err := newFileDAOv2(height+1, cfg)
+	if err != nil {
+		return err
+	}
+	if err := v2.Start(ctx); err != nil {
+		return err
+	}
+	top.end = height
+	fd.v2Fd = append(fd.v2Fd, &fileV2Index{start: height + 1, end: height, fd: v2})
+	fd.currFd = v2
+	log.L().Info("Split chain db file.", zap.String("file", cfg.DbPath), zap.Uint64("start", height+1))
+	return nil
+}
+
+// removeEmptyTop deletes the top v2 file if it has no block, so the tip block is in the file below it
+func (fd *fileDAO) removeEmptyTop() error {
+	if len(fd.v2Fd) < 2 {
+		return nil
+	}
+	top := fd.v2Fd[len(fd.v2Fd)-1]
+	tip, err := top.fd.Height()
+	if err != nil {
+		return err
+	}
+	if tip >= top.start {
+		return nil
+	}
+	if err := top.fd.Stop(context.Background()); err != nil {
+		return err
+	}
+	if err := os.Remove(top.fd.cfg.DbPath); err != nil {
+		return errors.Wrapf(err, "failed to delete db file %s", top.fd.cfg.DbPath)
+	}
+	fd.v2Fd = fd.v2Fd[:len(fd.v2Fd)-1]
+	fd.currFd = fd.v2Fd.TopFd()
+	return nil
+}
+
 // CreateFileDAO creates FileDAO from legacy and new files
 func CreateFileDAO(legacy bool, v2Files []string, cfg config.DB) (FileDAO, error) {
 	if legacy == false && len(v2Files) == 0 {
@@ -268,7 +516,10 @@ func CreateFileDAO(legacy bool, v2Files []string, cfg config.DB) (FileDAO, error
 		}
 	}
 
-	var v2Fd FileV2Manager
+	var (
+		v2Fd       FileV2Manager
+		defaultCfg = cfg
+	)
 	if len(v2Files) > 0 {
 		fds := make([]*fileDAOv2, len(v2Files))
 		for i, name := range v2Files {
@@ -279,11 +530,42 @@ func CreateFileDAO(legacy bool, v2Files []string, cfg config.DB) (FileDAO, error
 	}
 
 	return &fileDAO{
+		cfg:      defaultCfg,
 		legacyFd: legacyFd,
 		v2Fd:     v2Fd,
 	}, nil
 }
 
+// CreateFileDAOFromHeaders creates a new chain db which starts after the given consecutive headers, and retains the
+// headers as the pruned blocks. It is used to start a node from the states at the height of the last header
+func CreateFileDAOFromHeaders(cfg config.DB, headers []*block.Header) error {
+	if len(headers) == 0 {
+		return errors.New("no block header")
+	}
+	if _, _, err := checkChainDBFiles(cfg); err != ErrFileNotExist {
+		return errors.Wrapf(ErrAlreadyExist, "chain db %s", cfg.DbPath)
+	}
+	for i := 1; i < len(headers); i++ {
+		if headers[i].Height() != headers[i-1].Height()+1 || headers[i].PrevHash() != headers[i-1].HashBlock() {
+			return errors.Wrapf(ErrDataCorruption, "block header at height %d is not consecutive", headers[i].Height())
+		}
+	}
+
+	ctx := context.Background()
+	pruned := newPrunedHeaders(cfg)
+	if err := pruned.Start(ctx); err != nil {
+		return err
+	}
+	if err := pruned.Put(headers); err != nil {
+		pruned.Stop(ctx)
+		return err
+	}
+	if err := pruned.Stop(ctx); err != nil {
+		return err
+	}
+	return createNewV2File(headers[len(headers)-1].Height()+1, cfg)
+}
+
 // createNewV2File creates a new v2 chain db file
 func createNewV2File(start uint64, cfg config.DB) error {
 	v2, err := newFileDAOv2(start, cfg)
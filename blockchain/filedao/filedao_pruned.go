@@ -0,0 +1,151 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package filedao
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// namespace for the headers of pruned blocks
+const (
+	prunedHeaderNS = "phd"
+)
+
+var (
+	prunedRangeKey = []byte("pr")
+)
+
+type (
+	// prunedHeaders stores the headers of the pruned blocks, which are still needed by consensus and block sync
+	prunedHeaders struct {
+		kvStore db.KVStore
+		// start and end is the range of pruned heights, start = 0 if no block has been pruned
+		start, end uint64
+	}
+)
+
+// prunedHeadersFileName returns the name of the file storing the pruned headers
+func prunedHeadersFileName(file string) string {
+	ext := path.Ext(file)
+	return strings.TrimSuffix(file, ext) + "-headers" + ext
+}
+
+func newPrunedHeaders(cfg config.DB) *prunedHeaders {
+	cfg.DbPath = prunedHeadersFileName(cfg.DbPath)
+	return &prunedHeaders{
+		kvStore: db.NewBoltDB(cfg),
+	}
+}
+
+func (ph *prunedHeaders) Start(ctx context.Context) error {
+	if err := ph.kvStore.Start(ctx); err != nil {
+		return err
+	}
+	value, err := ph.kvStore.Get(prunedHeaderNS, prunedRangeKey)
+	if err != nil {
+		if errors.Cause(err) == db.ErrNotExist || errors.Cause(err) == db.ErrBucketNotExist {
+			return nil
+		}
+		return errors.Wrap(err, "failed to get pruned range")
+	}
+	if len(value) != 16 {
+		return ErrDataCorruption
+	}
+	ph.start = byteutil.BytesToUint64BigEndian(value[:8])
+	ph.end = byteutil.BytesToUint64BigEndian(value[8:])
+	return nil
+}
+
+func (ph *prunedHeaders) Stop(ctx context.Context) error {
+	return ph.kvStore.Stop(ctx)
+}
+
+// Range returns the range of pruned heights
+func (ph *prunedHeaders) Range() (uint64, uint64) {
+	return ph.start, ph.end
+}
+
+// Contains returns true if the block at the height has been pruned
+func (ph *prunedHeaders) Contains(height uint64) bool {
+	return ph.start > 0 && ph.start <= height && height <= ph.end
+}
+
+// Header returns the header of the pruned block at the height
+func (ph *prunedHeaders) Header(height uint64) (*block.Header, error) {
+	if !ph.Contains(height) {
+		return nil, db.ErrNotExist
+	}
+	value, err := ph.kvStore.Get(prunedHeaderNS, byteutil.Uint64ToBytesBigEndian(height))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get pruned header at height %d", height)
+	}
+	header := &block.Header{}
+	if err := header.Deserialize(value); err != nil {
+		return nil, errors.Wrapf(err, "failed to deserialize pruned header at height %d", height)
+	}
+	return header, nil
+}
+
+// Height returns the height of the pruned block
+func (ph *prunedHeaders) Height(h hash.Hash256) (uint64, error) {
+	value, err := getValueMustBe8Bytes(ph.kvStore, blockHashHeightMappingNS, hashKey(h))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get pruned block height")
+	}
+	return byteutil.BytesToUint64BigEndian(value), nil
+}
+
+// Put stores the headers of a consecutive range of blocks, which are about to be pruned
+func (ph *prunedHeaders) Put(headers []*block.Header) error {
+	if len(headers) == 0 {
+		return nil
+	}
+	start, end := headers[0].Height(), headers[len(headers)-1].Height()
+	if ph.start > 0 && (start > ph.end+1 || end+1 < ph.start) {
+		return errors.Errorf("pruned range [%d, %d] is not adjacent to [%d, %d]", start, end, ph.start, ph.end)
+	}
+	if ph.start > 0 && ph.start < start {
+		start = ph.start
+	}
+	if ph.end > end {
+		end = ph.end
+	}
+
+	b := batch.NewBatch()
+	for _, header := range headers {
+		ser, err := header.Serialize()
+		if err != nil {
+			return err
+		}
+		height := header.Height()
+		b.Put(prunedHeaderNS, byteutil.Uint64ToBytesBigEndian(height), ser, "failed to put pruned header")
+		h := header.HashBlock()
+		b.Put(blockHashHeightMappingNS, hashKey(h), byteutil.Uint64ToBytesBigEndian(height), "failed to put hash -> height mapping")
+	}
+	b.Put(
+		prunedHeaderNS,
+		prunedRangeKey,
+		append(byteutil.Uint64ToBytesBigEndian(start), byteutil.Uint64ToBytesBigEndian(end)...),
+		"failed to put pruned range",
+	)
+	if err := ph.kvStore.WriteBatch(b); err != nil {
+		return errors.Wrap(err, "failed to put pruned headers")
+	}
+	ph.start, ph.end = start, end
+	return nil
+}
@@ -9,6 +9,7 @@ package filedao
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"os"
 	"testing"
@@ -339,3 +340,177 @@ func createTestingBlock(builder *block.TestingBuilder, height uint64, h hash.Has
 		SignAndBuild(identityset.PrivateKey(27))
 	return &blk
 }
+
+func TestFileDAOPruneBlocks(t *testing.T) {
+	r := require.New(t)
+
+	testPath, err := testutil.PathOfTempFile("chain.db")
+	r.NoError(err)
+	defer func() {
+		for _, name := range append(possibleDBFiles(testPath), testPath, prunedHeadersFileName(testPath)) {
+			testutil.CleanupPath(t, name)
+		}
+	}()
+	cfg := config.Default.DB
+	cfg.DbPath = testPath
+	cfg.SplitDBSizeMB = 1
+	cfg.SplitDBHeight = 1
+	cfg.PruneKeepBlocks = 10
+
+	ctx := context.Background()
+	fd, err := NewFileDAO(cfg)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+
+	// commit blocks until the chain db is split into 3 files
+	var (
+		builder = block.NewTestingBuilder()
+		hashes  = []hash.Hash256{hash.ZeroHash256}
+		tip     uint64
+	)
+	for tip = 1; len(fd.(*fileDAO).v2Fd) < 3; tip++ {
+		r.True(tip < 1000)
+		blk := createLargeTestingBlock(builder, tip, hashes[tip-1])
+		r.NoError(fd.PutBlock(ctx, blk))
+		hashes = append(hashes, blk.HashBlock())
+	}
+	tip--
+	files := fd.(*fileDAO).v2Fd
+	r.Equal(testPath, files[0].fd.cfg.DbPath)
+	r.Equal(kthAuxFileName(testPath, 1), files[1].fd.cfg.DbPath)
+	r.Equal(kthAuxFileName(testPath, 2), files[2].fd.cfg.DbPath)
+	r.Equal(tip, files[1].end)
+	r.Equal(tip+1, files[2].start)
+	defaultEnd, pruneEnd := files[0].end, files[1].end
+
+	// the default file is pruned, the file below the top file is kept until 10 blocks are in the top file
+	pruner := fd.(BlockPruner)
+	start, end, err := pruner.PruneBlocks()
+	r.NoError(err)
+	r.EqualValues(1, start)
+	r.Equal(defaultEnd, end)
+	r.Equal(3, len(fd.(*fileDAO).v2Fd))
+	for i := uint64(0); i < cfg.PruneKeepBlocks; i++ {
+		blk := createLargeTestingBlock(builder, tip+1, hashes[tip])
+		r.NoError(fd.PutBlock(ctx, blk))
+		hashes = append(hashes, blk.HashBlock())
+		tip++
+	}
+	start, end, err = pruner.PruneBlocks()
+	r.NoError(err)
+	r.EqualValues(1, start)
+	r.Equal(pruneEnd, end)
+
+	verify := func(fd FileDAO) {
+		pruner := fd.(BlockPruner)
+		for i := uint64(1); i <= tip; i++ {
+			h, err := fd.GetBlockHash(i)
+			r.NoError(err)
+			r.Equal(hashes[i], h)
+			height, err := fd.GetBlockHeight(h)
+			r.NoError(err)
+			r.Equal(i, height)
+			if i > pruneEnd {
+				blk, err := fd.GetBlockByHeight(i)
+				r.NoError(err)
+				r.Equal(h, blk.HashBlock())
+				_, err = pruner.PrunedHeader(i)
+				r.Equal(db.ErrNotExist, errors.Cause(err))
+				continue
+			}
+			_, err = fd.GetBlock(h)
+			r.Equal(db.ErrPruned, errors.Cause(err))
+			_, err = fd.GetBlockByHeight(i)
+			r.Equal(db.ErrPruned, errors.Cause(err))
+			_, err = fd.GetReceipts(i)
+			r.Equal(db.ErrPruned, errors.Cause(err))
+			_, err = fd.TransactionLogs(i)
+			r.Equal(db.ErrPruned, errors.Cause(err))
+			header, err := pruner.PrunedHeader(i)
+			r.NoError(err)
+			r.Equal(h, header.HashBlock())
+		}
+	}
+	verify(fd)
+	_, exist := fileExists(kthAuxFileName(testPath, 1))
+	r.False(exist)
+	r.NoError(fd.Stop(ctx))
+
+	// the default file is kept, and the pruned blocks are still pruned after restart
+	fd, err = NewFileDAO(cfg)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	defer fd.Stop(ctx)
+	verify(fd)
+	blk := createLargeTestingBlock(builder, tip+1, hashes[tip])
+	r.NoError(fd.PutBlock(ctx, blk))
+	r.NoError(fd.DeleteTipBlock())
+	height, err := fd.Height()
+	r.NoError(err)
+	r.Equal(tip, height)
+}
+
+func TestFileDAONotSplitWithoutPruning(t *testing.T) {
+	r := require.New(t)
+
+	testPath, err := testutil.PathOfTempFile("chain.db")
+	r.NoError(err)
+	defer func() {
+		for _, name := range append(possibleDBFiles(testPath), testPath) {
+			testutil.CleanupPath(t, name)
+		}
+	}()
+	cfg := config.Default.DB
+	cfg.DbPath = testPath
+	cfg.SplitDBSizeMB = 1
+	cfg.SplitDBHeight = 1
+	r.False(cfg.EnableBlockPruning())
+
+	ctx := context.Background()
+	fd, err := NewFileDAO(cfg)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	defer fd.Stop(ctx)
+
+	// the db file grows beyond the split size, but is not split
+	var (
+		builder = block.NewTestingBuilder()
+		prev    = hash.ZeroHash256
+	)
+	for tip := uint64(1); ; tip++ {
+		r.True(tip < 1000)
+		blk := createLargeTestingBlock(builder, tip, prev)
+		r.NoError(fd.PutBlock(ctx, blk))
+		prev = blk.HashBlock()
+		if size, _ := fileExists(testPath); uint64(size) > 2*cfg.SplitDBSize() {
+			break
+		}
+	}
+	r.Equal(1, len(fd.(*fileDAO).v2Fd))
+	_, exist := fileExists(kthAuxFileName(testPath, 1))
+	r.False(exist)
+}
+
+func createLargeTestingBlock(builder *block.TestingBuilder, height uint64, h hash.Hash256) *block.Block {
+	r := &action.Receipt{
+		Status:      1,
+		BlockHeight: height,
+		ActionHash:  h,
+	}
+	for i := 0; i < 200; i++ {
+		addr := hash.Hash256b([]byte(fmt.Sprintf("%d-%d", height, i)))
+		r.AddTransactionLogs(&action.TransactionLog{
+			Type:      iotextypes.TransactionLogType_NATIVE_TRANSFER,
+			Amount:    big.NewInt(int64(i)),
+			Sender:    hex.EncodeToString(addr[:]),
+			Recipient: hex.EncodeToString(h[:]),
+		})
+	}
+	blk, _ := builder.
+		SetHeight(height).
+		SetPrevBlockHash(h).
+		SetReceipts([]*action.Receipt{r}).
+		SetTimeStamp(testutil.TimestampNow().UTC()).
+		SignAndBuild(identityset.PrivateKey(27))
+	return &blk
+}
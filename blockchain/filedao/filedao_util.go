@@ -142,6 +142,18 @@ func isAuxFile(file, base string) (int, bool) {
 	return index, true
 }
 
+// nextAuxFileIndex returns the index following all existing auxiliary chain db files
+func nextAuxFileIndex(fullname string) int {
+	next := 1
+	base := path.Base(fullname)
+	for _, name := range possibleDBFiles(fullname) {
+		if index, ok := isAuxFile(path.Base(name), base); ok && index >= next {
+			next = index + 1
+		}
+	}
+	return next
+}
+
 // kthAuxFileName returns k-th auxiliary chain db filename
 func kthAuxFileName(file string, k int) string {
 	ext := path.Ext(file)
@@ -48,6 +48,7 @@ func defaultConfig() Genesis {
 			NumDelegates:            24,
 			NumCandidateDelegates:   36,
 			TimeBasedRotation:       false,
+			EVMNetworkID:            4689,
 			PacificBlockHeight:      432001,
 			AleutianBlockHeight:     864001,
 			BeringBlockHeight:       1512001,
@@ -58,6 +59,8 @@ func defaultConfig() Genesis {
 			FbkMigrationBlockHeight: 5157001,
 			FairbankBlockHeight:     5165641,
 			GreenlandBlockHeight:    6544441,
+			HawaiiBlockHeight:       11267641,
+			IcelandBlockHeight:      12289321,
 		},
 		Account: Account{
 			InitBalanceMap: make(map[string]string),
@@ -148,6 +151,8 @@ type (
 		NumCandidateDelegates uint64 `yaml:"numCandidateDelegates"`
 		// TimeBasedRotation is the flag to enable rotating delegates' time slots on a block height
 		TimeBasedRotation bool `yaml:"timeBasedRotation"`
+		// EVMNetworkID is the EIP-155 chain ID which Ethereum RLP encoded actions are signed for
+		EVMNetworkID uint32 `yaml:"evmNetworkID"`
 		// PacificBlockHeight is the start height of using the logic of Pacific version
 		// TODO: PacificBlockHeight is not added into protobuf definition for backward compatibility
 		PacificBlockHeight uint64 `yaml:"pacificHeight"`
@@ -169,6 +174,11 @@ type (
 		FairbankBlockHeight uint64 `yaml:"fairbankHeight"`
 		// GreenlandBlockHeight is the start height of storing latest 720 block meta and rewarding/staking bucket pool
 		GreenlandBlockHeight uint64 `yaml:"greenlandHeight"`
+		// HawaiiBlockHeight is the start height to reject actions signed for a different chain ID, and to accept
+		// Ethereum RLP encoded actions
+		HawaiiBlockHeight uint64 `yaml:"hawaiiHeight"`
+		// IcelandBlockHeight is the start height to distribute the epoch reward of a delegate to its voters
+		IcelandBlockHeight uint64 `yaml:"icelandHeight"`
 	}
 	// Account contains the configs for account protocol
 	Account struct {
@@ -274,11 +284,12 @@ type (
 
 	// BootstrapCandidate is the candidate data need to be provided to bootstrap candidate.
 	BootstrapCandidate struct {
-		OwnerAddress      string `yaml:"ownerAddress"`
-		OperatorAddress   string `yaml:"operatorAddress"`
-		RewardAddress     string `yaml:"rewardAddress"`
-		Name              string `yaml:"name"`
-		SelfStakingTokens string `yaml:"selfStakingTokens"`
+		OwnerAddress            string `yaml:"ownerAddress"`
+		OperatorAddress         string `yaml:"operatorAddress"`
+		RewardAddress           string `yaml:"rewardAddress"`
+		Name                    string `yaml:"name"`
+		SelfStakingTokens       string `yaml:"selfStakingTokens"`
+		RewardDistributionRatio uint32 `yaml:"rewardDistributionRatio"`
 	}
 )
 
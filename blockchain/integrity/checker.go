@@ -0,0 +1,513 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package integrity
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/blockindex"
+	"github.com/iotexproject/iotex-core/consensus/scheme/rolldpos"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/state/factory"
+)
+
+// names of the checks
+const (
+	CheckBlock        = "block"
+	CheckHashLinkage  = "hash linkage"
+	CheckTxRoot       = "tx root"
+	CheckReceiptRoot  = "receipt root"
+	CheckEndorsements = "footer endorsements"
+	CheckBlockIndex   = "block index"
+	CheckActionIndex  = "action index"
+	CheckAddressIndex = "address index"
+	CheckStateReplay  = "state replay"
+	CheckState        = "state"
+)
+
+type (
+	// Inconsistency describes an inconsistency found in the chain data
+	Inconsistency struct {
+		Height uint64
+		Check  string
+		Detail string
+	}
+
+	// Report is the result of a check
+	Report struct {
+		Start, End uint64
+		// Checked is the number of blocks which passed all checks
+		Checked uint64
+		// StateChecked is true if the state at end height has been compared with the replay
+		StateChecked bool
+		// Inconsistency is the first inconsistency found, nil if the chain data is consistent
+		Inconsistency *Inconsistency
+	}
+
+	// Checker walks the blocks in the blockDAO and checks the integrity of the chain data
+	Checker struct {
+		dao          blockdao.BlockDAO
+		genesis      genesis.Genesis
+		indexer      blockindex.Indexer
+		replay       factory.Factory
+		sr           protocol.StateReader
+		numDelegates uint64
+	}
+
+	// CheckerOption sets Checker construction parameter
+	CheckerOption func(*Checker) error
+
+	// inconsistency is used internally to stop the walk at the first inconsistency
+	inconsistency struct {
+		check  string
+		detail string
+	}
+)
+
+// IndexerOption checks the block index, action index and address index against the blocks
+func IndexerOption(indexer blockindex.Indexer) CheckerOption {
+	return func(c *Checker) error {
+		if indexer == nil {
+			return errors.New("invalid empty indexer")
+		}
+		c.indexer = indexer
+		return nil
+	}
+}
+
+// ReplayOption replays the blocks on the factory, which has to be at height start - 1 when the check begins,
+// and verifies the delta state digest and receipt root of the blocks
+func ReplayOption(replay factory.Factory) CheckerOption {
+	return func(c *Checker) error {
+		if replay == nil {
+			return errors.New("invalid empty replay factory")
+		}
+		c.replay = replay
+		return nil
+	}
+}
+
+// StateOption compares the state of the accounts touched by the blocks with the replay at end height
+func StateOption(sr protocol.StateReader) CheckerOption {
+	return func(c *Checker) error {
+		if sr == nil {
+			return errors.New("invalid empty state reader")
+		}
+		c.sr = sr
+		return nil
+	}
+}
+
+// EndorsementQuorumOption requires the footer of every block to carry the commit endorsements of more than 2/3 of
+// the delegates
+func EndorsementQuorumOption(numDelegates uint64) CheckerOption {
+	return func(c *Checker) error {
+		c.numDelegates = numDelegates
+		return nil
+	}
+}
+
+// NewChecker creates a new chain data checker
+func NewChecker(dao blockdao.BlockDAO, g genesis.Genesis, opts ...CheckerOption) (*Checker, error) {
+	if dao == nil {
+		return nil, errors.New("invalid empty blockDAO")
+	}
+	c := &Checker{
+		dao:     dao,
+		genesis: g,
+	}
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	if c.sr != nil && c.replay == nil {
+		return nil, errors.New("state comparison requires replay")
+	}
+	return c, nil
+}
+
+// String returns a human readable description of the inconsistency
+func (in *Inconsistency) String() string {
+	return fmt.Sprintf("block %d: %s check failed: %s", in.Height, in.Check, in.Detail)
+}
+
+// String returns a human readable report
+func (r *Report) String() string {
+	if r.Inconsistency != nil {
+		return fmt.Sprintf(
+			"checked blocks [%d, %d], first inconsistency at %s",
+			r.Start,
+			r.End,
+			r.Inconsistency.String(),
+		)
+	}
+	s := fmt.Sprintf("checked blocks [%d, %d], no inconsistency found", r.Start, r.End)
+	if r.StateChecked {
+		s += fmt.Sprintf(", state at height %d matches replay", r.End)
+	}
+	return s
+}
+
+// Check walks the blocks in [start, end] and stops at the first inconsistency. The address index and the total
+// action index are only checked if the walk starts from genesis, because their positions depend on all prior blocks.
+func (c *Checker) Check(ctx context.Context, start, end uint64) (*Report, error) {
+	tip, err := c.dao.Height()
+	if err != nil {
+		return nil, err
+	}
+	if start == 0 {
+		start = 1
+	}
+	if end == 0 || end > tip {
+		end = tip
+	}
+	if start > end {
+		return nil, errors.Errorf("invalid range [%d, %d], tip height = %d", start, end, tip)
+	}
+	if c.replay != nil {
+		height, err := c.replay.Height()
+		if err != nil {
+			return nil, err
+		}
+		if height != start-1 {
+			return nil, errors.Errorf("replay factory is at height %d, expecting %d", height, start-1)
+		}
+	}
+	var indexerHeight uint64
+	if c.indexer != nil {
+		if indexerHeight, err = c.indexer.Height(); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &Report{
+		Start: start,
+		End:   end,
+	}
+	var (
+		prevHash    hash.Hash256
+		prevTime    time.Time
+		sequential  = start == 1
+		totalAction uint64
+		addrCount   = make(map[hash.Hash160]uint64)
+		touched     = newAddressSet()
+	)
+	if start == 1 {
+		prevHash = c.genesis.Hash()
+		prevTime = time.Unix(c.genesis.Timestamp, 0)
+	} else {
+		header, err := c.dao.HeaderByHeight(start - 1)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get block header %d", start-1)
+		}
+		prevHash = header.HashBlock()
+		prevTime = header.Timestamp()
+	}
+	for height := start; height <= end; height++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		blk, err := c.dao.GetBlockByHeight(height)
+		if err != nil {
+			if errors.Cause(err) == db.ErrPruned {
+				return nil, errors.Wrapf(err, "cannot check block %d", height)
+			}
+			report.Inconsistency = &Inconsistency{height, CheckBlock, err.Error()}
+			return report, nil
+		}
+		in := c.checkBlock(blk, height, prevHash)
+		if in == nil && c.indexer != nil {
+			if height > indexerHeight {
+				in = &inconsistency{CheckBlockIndex, fmt.Sprintf("block is not indexed, indexer height = %d", indexerHeight)}
+			} else {
+				in = c.checkIndex(blk, sequential, totalAction, addrCount)
+			}
+		}
+		if in == nil && c.replay != nil {
+			in = c.replayBlock(ctx, blk, prevHash, prevTime)
+		}
+		if in != nil {
+			report.Inconsistency = &Inconsistency{height, in.check, in.detail}
+			return report, nil
+		}
+		for _, selp := range blk.Actions {
+			totalAction++
+			if sender, err := address.FromBytes(selp.SrcPubkey().Hash()); err == nil {
+				touched.add(sender.String())
+			}
+			if dst, ok := selp.Destination(); ok && dst != "" {
+				touched.add(dst)
+			}
+		}
+		touched.add(blk.ProducerAddress())
+		prevHash = blk.HashBlock()
+		prevTime = blk.Timestamp()
+		report.Checked++
+	}
+
+	if c.indexer != nil && sequential && indexerHeight == end {
+		for addr, count := range addrCount {
+			indexed, err := c.indexer.GetActionCountByAddress(addr)
+			if err != nil {
+				return nil, err
+			}
+			if indexed != count {
+				report.Inconsistency = &Inconsistency{end, CheckAddressIndex, fmt.Sprintf(
+					"address %x has %d indexed actions, expecting %d", addr, indexed, count,
+				)}
+				return report, nil
+			}
+		}
+	}
+	if c.sr != nil {
+		height, err := c.sr.Height()
+		if err != nil {
+			return nil, err
+		}
+		if height == end {
+			if in := c.checkState(touched); in != nil {
+				report.Inconsistency = &Inconsistency{end, in.check, in.detail}
+				return report, nil
+			}
+			report.StateChecked = true
+		}
+	}
+	return report, nil
+}
+
+func (c *Checker) checkBlock(blk *block.Block, height uint64, prevHash hash.Hash256) *inconsistency {
+	if blk.Height() != height {
+		return &inconsistency{CheckBlock, fmt.Sprintf("block at height %d has height %d", height, blk.Height())}
+	}
+	blkHash := blk.HashBlock()
+	if blk.PrevHash() != prevHash {
+		return &inconsistency{CheckHashLinkage, fmt.Sprintf("prev hash %x, expecting %x", blk.PrevHash(), prevHash)}
+	}
+	h, err := c.dao.GetBlockHash(height)
+	if err != nil {
+		return &inconsistency{CheckHashLinkage, err.Error()}
+	}
+	if h != blkHash {
+		return &inconsistency{CheckHashLinkage, fmt.Sprintf("height -> hash mapping is %x, expecting %x", h, blkHash)}
+	}
+	mapped, err := c.dao.GetBlockHeight(blkHash)
+	if err != nil {
+		return &inconsistency{CheckHashLinkage, err.Error()}
+	}
+	if mapped != height {
+		return &inconsistency{CheckHashLinkage, fmt.Sprintf("hash -> height mapping is %d", mapped)}
+	}
+	if !blk.VerifySignature() {
+		return &inconsistency{CheckBlock, "invalid block signature"}
+	}
+	if err := blk.VerifyTxRoot(blk.CalculateTxRoot()); err != nil {
+		return &inconsistency{CheckTxRoot, fmt.Sprintf("tx root %x, calculated %x", blk.TxRoot(), blk.CalculateTxRoot())}
+	}
+	receipts, err := c.dao.GetReceipts(height)
+	if err != nil {
+		return &inconsistency{CheckReceiptRoot, err.Error()}
+	}
+	if root := calculateReceiptRoot(receipts); blk.VerifyReceiptRoot(root) != nil {
+		return &inconsistency{CheckReceiptRoot, fmt.Sprintf("receipt root %x, calculated %x from %d receipts", blk.ReceiptRoot(), root, len(receipts))}
+	}
+	return c.checkEndorsements(blk)
+}
+
+func (c *Checker) checkEndorsements(blk *block.Block) *inconsistency {
+	blkHash := blk.HashBlock()
+	vote := rolldpos.NewConsensusVote(blkHash[:], rolldpos.COMMIT)
+	endorsers := make(map[string]struct{})
+	for i, en := range blk.Endorsements() {
+		if !endorsement.VerifyEndorsement(vote, en) {
+			return &inconsistency{CheckEndorsements, fmt.Sprintf("invalid endorsement %d", i)}
+		}
+		endorser := en.Endorser().HexString()
+		if _, ok := endorsers[endorser]; ok {
+			return &inconsistency{CheckEndorsements, fmt.Sprintf("duplicate endorsement from %s", endorser)}
+		}
+		endorsers[endorser] = struct{}{}
+	}
+	if c.numDelegates > 0 && 3*uint64(len(endorsers)) <= 2*c.numDelegates {
+		return &inconsistency{CheckEndorsements, fmt.Sprintf(
+			"%d endorsements are not enough for %d delegates", len(endorsers), c.numDelegates,
+		)}
+	}
+	return nil
+}
+
+func (c *Checker) checkIndex(blk *block.Block, sequential bool, totalAction uint64, addrCount map[hash.Hash160]uint64) *inconsistency {
+	height := blk.Height()
+	blkHash := blk.HashBlock()
+	bi, err := c.indexer.GetBlockIndex(height)
+	if err != nil {
+		return &inconsistency{CheckBlockIndex, err.Error()}
+	}
+	if !bytes.Equal(bi.Hash(), blkHash[:]) {
+		return &inconsistency{CheckBlockIndex, fmt.Sprintf("indexed hash %x, expecting %x", bi.Hash(), blkHash)}
+	}
+	if int(bi.NumAction()) != len(blk.Actions) {
+		return &inconsistency{CheckBlockIndex, fmt.Sprintf("indexed %d actions, expecting %d", bi.NumAction(), len(blk.Actions))}
+	}
+	if amount := blk.CalculateTransferAmount(); bi.TsfAmount().Cmp(amount) != 0 {
+		return &inconsistency{CheckBlockIndex, fmt.Sprintf("indexed transfer amount %s, expecting %s", bi.TsfAmount(), amount)}
+	}
+	mapped, err := c.indexer.GetBlockHeight(blkHash)
+	if err != nil {
+		return &inconsistency{CheckBlockIndex, err.Error()}
+	}
+	if mapped != height {
+		return &inconsistency{CheckBlockIndex, fmt.Sprintf("indexed hash -> height mapping is %d", mapped)}
+	}
+
+	for _, selp := range blk.Actions {
+		actHash := selp.Hash()
+		ai, err := c.indexer.GetActionIndex(actHash[:])
+		if err != nil {
+			return &inconsistency{CheckActionIndex, fmt.Sprintf("action %x: %v", actHash, err)}
+		}
+		if ai.BlockHeight() != height {
+			return &inconsistency{CheckActionIndex, fmt.Sprintf("action %x is indexed at height %d", actHash, ai.BlockHeight())}
+		}
+		if !sequential {
+			continue
+		}
+		hashes, err := c.indexer.GetActionHashFromIndex(totalAction, 1)
+		if err != nil {
+			return &inconsistency{CheckActionIndex, fmt.Sprintf("action %x: %v", actHash, err)}
+		}
+		if len(hashes) != 1 || !bytes.Equal(hashes[0], actHash[:]) {
+			return &inconsistency{CheckActionIndex, fmt.Sprintf("action %d in total index is not %x", totalAction, actHash)}
+		}
+		totalAction++
+
+		addrs := []hash.Hash160{hash.BytesToHash160(selp.SrcPubkey().Hash())}
+		if dst, ok := selp.Destination(); ok && dst != "" {
+			dstAddr, err := address.FromString(dst)
+			if err == nil && !bytes.Equal(dstAddr.Bytes(), addrs[0][:]) {
+				addrs = append(addrs, hash.BytesToHash160(dstAddr.Bytes()))
+			}
+		}
+		for _, addr := range addrs {
+			hashes, err := c.indexer.GetActionsByAddress(addr, addrCount[addr], 1)
+			if err != nil {
+				return &inconsistency{CheckAddressIndex, fmt.Sprintf("action %x of address %x: %v", actHash, addr, err)}
+			}
+			if len(hashes) != 1 || !bytes.Equal(hashes[0], actHash[:]) {
+				return &inconsistency{CheckAddressIndex, fmt.Sprintf(
+					"action %d of address %x is not %x", addrCount[addr], addr, actHash,
+				)}
+			}
+			addrCount[addr]++
+		}
+	}
+	return nil
+}
+
+func (c *Checker) replayBlock(ctx context.Context, blk *block.Block, prevHash hash.Hash256, prevTime time.Time) *inconsistency {
+	producer, err := address.FromBytes(blk.PublicKey().Hash())
+	if err != nil {
+		return &inconsistency{CheckBlock, err.Error()}
+	}
+	ctx = protocol.WithBlockCtx(
+		protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{
+			Genesis: c.genesis,
+			Tip: protocol.TipInfo{
+				Height:    blk.Height() - 1,
+				Hash:      prevHash,
+				Timestamp: prevTime,
+			},
+		}),
+		protocol.BlockCtx{
+			BlockHeight:    blk.Height(),
+			BlockTimeStamp: blk.Timestamp(),
+			Producer:       producer,
+			GasLimit:       c.genesis.BlockGasLimit,
+		},
+	)
+	if err := c.replay.Validate(ctx, blk); err != nil {
+		return &inconsistency{CheckStateReplay, err.Error()}
+	}
+	if err := c.replay.PutBlock(ctx, blk); err != nil {
+		return &inconsistency{CheckStateReplay, err.Error()}
+	}
+	return nil
+}
+
+func (c *Checker) checkState(addrs *addressSet) *inconsistency {
+	for _, addr := range addrs.list {
+		expected, err := accountutil.AccountState(c.replay, addr)
+		if err != nil {
+			return &inconsistency{CheckState, fmt.Sprintf("failed to read account %s from replay: %v", addr, err)}
+		}
+		actual, err := accountutil.AccountState(c.sr, addr)
+		if err != nil {
+			return &inconsistency{CheckState, fmt.Sprintf("failed to read account %s: %v", addr, err)}
+		}
+		expectedBytes, err := expected.Serialize()
+		if err != nil {
+			return &inconsistency{CheckState, err.Error()}
+		}
+		actualBytes, err := actual.Serialize()
+		if err != nil {
+			return &inconsistency{CheckState, err.Error()}
+		}
+		if !bytes.Equal(expectedBytes, actualBytes) {
+			return &inconsistency{CheckState, fmt.Sprintf(
+				"account %s has balance %s nonce %d, replay has balance %s nonce %d",
+				addr, actual.Balance, actual.Nonce, expected.Balance, expected.Nonce,
+			)}
+		}
+	}
+	return nil
+}
+
+// addressSet keeps the addresses in the order they are added
+type addressSet struct {
+	set  map[string]struct{}
+	list []string
+}
+
+func newAddressSet() *addressSet {
+	return &addressSet{
+		set: make(map[string]struct{}),
+	}
+}
+
+func (s *addressSet) add(addr string) {
+	if _, ok := s.set[addr]; ok {
+		return
+	}
+	s.set[addr] = struct{}{}
+	s.list = append(s.list, addr)
+}
+
+func calculateReceiptRoot(receipts []*action.Receipt) hash.Hash256 {
+	if len(receipts) == 0 {
+		return hash.ZeroHash256
+	}
+	h := make([]hash.Hash256, 0, len(receipts))
+	for _, receipt := range receipts {
+		h = append(h, receipt.Hash())
+	}
+	return crypto.NewMerkleTree(h).HashTree()
+}
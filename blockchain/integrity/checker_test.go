@@ -0,0 +1,195 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package integrity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/execution"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/blockindex"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/unit"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func copyBalanceMap(balances map[string]string) map[string]string {
+	copied := make(map[string]string, len(balances))
+	for addr, balance := range balances {
+		copied[addr] = balance
+	}
+	return copied
+}
+
+func newReplayFactory(cfg config.Config, dao blockdao.BlockDAO) (factory.Factory, error) {
+	registry := protocol.NewRegistry()
+	sf, err := factory.NewFactory(cfg, factory.InMemTrieOption(), factory.RegistryOption(registry))
+	if err != nil {
+		return nil, err
+	}
+	if err := account.NewProtocol(rewarding.DepositGas).Register(registry); err != nil {
+		return nil, err
+	}
+	if err := rolldpos.NewProtocol(cfg.Genesis.NumCandidateDelegates, cfg.Genesis.NumDelegates, cfg.Genesis.NumSubEpochs).Register(registry); err != nil {
+		return nil, err
+	}
+	if err := execution.NewProtocol(dao.GetBlockHash, rewarding.DepositGas).Register(registry); err != nil {
+		return nil, err
+	}
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Genesis: cfg.Genesis})
+	if err := sf.Start(ctx); err != nil {
+		return nil, err
+	}
+	return sf, nil
+}
+
+func TestChecker(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	testTriePath, err := testutil.PathOfTempFile("trie")
+	require.NoError(err)
+	testDBPath, err := testutil.PathOfTempFile("db")
+	require.NoError(err)
+	testIndexPath, err := testutil.PathOfTempFile("index")
+	require.NoError(err)
+	defer func() {
+		testutil.CleanupPath(t, testTriePath)
+		testutil.CleanupPath(t, testDBPath)
+		testutil.CleanupPath(t, testIndexPath)
+	}()
+
+	cfg := config.Default
+	cfg.Chain.TrieDBPath = testTriePath
+	cfg.Chain.ChainDBPath = testDBPath
+	cfg.Chain.IndexDBPath = testIndexPath
+	cfg.Genesis.EnableGravityChainVoting = false
+	cfg.ActPool.MinGasPriceStr = "0"
+	cfg.Genesis.InitBalanceMap = copyBalanceMap(cfg.Genesis.InitBalanceMap)
+	cfg.Genesis.InitBalanceMap[identityset.Address(27).String()] = unit.ConvertIotxToRau(10000000000).String()
+
+	// create a chain with indexer
+	registry := protocol.NewRegistry()
+	sf, err := factory.NewFactory(cfg, factory.DefaultTrieOption(), factory.RegistryOption(registry))
+	require.NoError(err)
+	ap, err := actpool.NewActPool(sf, cfg.ActPool)
+	require.NoError(err)
+	require.NoError(account.NewProtocol(rewarding.DepositGas).Register(registry))
+	require.NoError(rolldpos.NewProtocol(cfg.Genesis.NumCandidateDelegates, cfg.Genesis.NumDelegates, cfg.Genesis.NumSubEpochs).Register(registry))
+	cfg.DB.DbPath = cfg.Chain.IndexDBPath
+	indexKV := db.NewBoltDB(cfg.DB)
+	indexer, err := blockindex.NewIndexer(indexKV, cfg.Genesis.Hash())
+	require.NoError(err)
+	cfg.DB.DbPath = cfg.Chain.ChainDBPath
+	dao := blockdao.NewBlockDAO([]blockdao.BlockIndexer{sf, indexer}, cfg.DB)
+	bc := blockchain.NewBlockchain(
+		cfg,
+		dao,
+		factory.NewMinter(sf, ap),
+		blockchain.BlockValidatorOption(block.NewValidator(
+			sf,
+			protocol.NewGenericValidator(sf, accountutil.AccountState),
+		)),
+	)
+	require.NoError(execution.NewProtocol(dao.GetBlockHash, rewarding.DepositGas).Register(registry))
+	require.NoError(bc.Start(ctx))
+	defer func() {
+		require.NoError(bc.Stop(ctx))
+	}()
+	require.NoError(addTestingTsfBlocks(cfg, bc, dao, ap))
+	tip := bc.TipHeight()
+
+	t.Run("consistent", func(t *testing.T) {
+		replay, err := newReplayFactory(cfg, dao)
+		require.NoError(err)
+		defer replay.Stop(ctx)
+		checker, err := NewChecker(dao, cfg.Genesis, IndexerOption(indexer), ReplayOption(replay), StateOption(sf))
+		require.NoError(err)
+		report, err := checker.Check(ctx, 0, 0)
+		require.NoError(err)
+		require.Nil(report.Inconsistency)
+		require.EqualValues(1, report.Start)
+		require.Equal(tip, report.End)
+		require.Equal(tip, report.Checked)
+		require.True(report.StateChecked)
+
+		// replay has to start from start - 1
+		_, err = checker.Check(ctx, 2, 0)
+		require.Error(err)
+
+		// check a range
+		checker, err = NewChecker(dao, cfg.Genesis, IndexerOption(indexer))
+		require.NoError(err)
+		report, err = checker.Check(ctx, 2, 3)
+		require.NoError(err)
+		require.Nil(report.Inconsistency)
+		require.EqualValues(2, report.Checked)
+		_, err = checker.Check(ctx, tip+1, 0)
+		require.Error(err)
+	})
+
+	t.Run("state replay", func(t *testing.T) {
+		// replay from a different genesis
+		replayCfg := cfg
+		replayCfg.Genesis.InitBalanceMap = copyBalanceMap(cfg.Genesis.InitBalanceMap)
+		replayCfg.Genesis.InitBalanceMap[identityset.Address(27).String()] = unit.ConvertIotxToRau(1).String()
+		replay, err := newReplayFactory(replayCfg, dao)
+		require.NoError(err)
+		defer replay.Stop(ctx)
+		checker, err := NewChecker(dao, cfg.Genesis, ReplayOption(replay))
+		require.NoError(err)
+		report, err := checker.Check(ctx, 0, 0)
+		require.NoError(err)
+		require.NotNil(report.Inconsistency)
+		require.EqualValues(1, report.Inconsistency.Height)
+		require.Equal(CheckStateReplay, report.Inconsistency.Check)
+		require.Zero(report.Checked)
+	})
+
+	t.Run("endorsements", func(t *testing.T) {
+		checker, err := NewChecker(dao, cfg.Genesis, EndorsementQuorumOption(cfg.Genesis.NumDelegates))
+		require.NoError(err)
+		report, err := checker.Check(ctx, 0, 0)
+		require.NoError(err)
+		require.NotNil(report.Inconsistency)
+		require.EqualValues(1, report.Inconsistency.Height)
+		require.Equal(CheckEndorsements, report.Inconsistency.Check)
+	})
+
+	t.Run("index", func(t *testing.T) {
+		// corrupt the hash -> height mapping of block 3
+		h, err := dao.GetBlockHash(3)
+		require.NoError(err)
+		require.NoError(indexKV.Put("hh", h[12:], byteutil.Uint64ToBytesBigEndian(99)))
+		checker, err := NewChecker(dao, cfg.Genesis, IndexerOption(indexer))
+		require.NoError(err)
+		report, err := checker.Check(ctx, 0, 0)
+		require.NoError(err)
+		require.Equal(&Inconsistency{
+			Height: 3,
+			Check:  CheckBlockIndex,
+			Detail: "indexed hash -> height mapping is 99",
+		}, report.Inconsistency)
+		require.EqualValues(2, report.Checked)
+		require.Equal("checked blocks [1, 5], first inconsistency at block 3: block index check failed: indexed hash -> height mapping is 99", report.String())
+	})
+}
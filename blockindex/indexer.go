@@ -30,11 +30,13 @@ const (
 	hashOffset          = 12
 	blockHashToHeightNS = "hh"
 	actionToBlockHashNS = "ab"
+	prunedBlocksNS      = "pr"
 )
 
 var (
 	totalBlocksBucket  = []byte("bk")
 	totalActionsBucket = []byte("ac")
+	prunedRangeKey     = []byte("pr")
 	// ErrActionIndexNA indicates action index is not supported
 	ErrActionIndexNA = errors.New("action index not supported")
 )
@@ -58,6 +60,7 @@ type (
 		GetActionHashFromIndex(uint64, uint64) ([][]byte, error)
 		GetActionCountByAddress(hash.Hash160) (uint64, error)
 		GetActionsByAddress(hash.Hash160, uint64, uint64) ([][]byte, error)
+		PruneBlocks(uint64, uint64) error
 	}
 
 	// blockIndexer implements the Indexer interface
@@ -69,6 +72,9 @@ type (
 		dirtyAddr   addrIndex
 		tbk         db.CountingIndex
 		tac         db.CountingIndex
+		// prunedStart and prunedEnd is the range of the heights of blocks pruned from chain db
+		prunedStart uint64
+		prunedEnd   uint64
 	}
 )
 
@@ -109,8 +115,24 @@ func (x *blockIndexer) Start(ctx context.Context) error {
 			return err
 		}
 	}
-	x.tac, err = db.NewCountingIndexNX(x.kvStore, totalActionsBucket)
-	return err
+	if x.tac, err = db.NewCountingIndexNX(x.kvStore, totalActionsBucket); err != nil {
+		return err
+	}
+	// load the range of pruned blocks
+	value, err := x.kvStore.Get(prunedBlocksNS, prunedRangeKey)
+	switch errors.Cause(err) {
+	case nil:
+		if len(value) != 16 {
+			return errors.Wrap(db.ErrInvalid, "invalid range of pruned blocks")
+		}
+		x.prunedStart = byteutil.BytesToUint64BigEndian(value[:8])
+		x.prunedEnd = byteutil.BytesToUint64BigEndian(value[8:])
+		return nil
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		return nil
+	default:
+		return err
+	}
 }
 
 // Stop stops the indexer
@@ -118,6 +140,23 @@ func (x *blockIndexer) Stop(ctx context.Context) error {
 	return x.kvStore.Stop(ctx)
 }
 
+// PruneBlocks records the range of the heights of blocks pruned from chain db, the indexes of which are no longer
+// returned
+func (x *blockIndexer) PruneBlocks(start, end uint64) error {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+
+	if start == 0 || start > end {
+		return errors.Wrapf(db.ErrInvalid, "invalid range of pruned blocks [%d, %d]", start, end)
+	}
+	value := append(byteutil.Uint64ToBytesBigEndian(start), byteutil.Uint64ToBytesBigEndian(end)...)
+	if err := x.kvStore.Put(prunedBlocksNS, prunedRangeKey, value); err != nil {
+		return errors.Wrap(err, "failed to put range of pruned blocks")
+	}
+	x.prunedStart, x.prunedEnd = start, end
+	return nil
+}
+
 // PutBlocks writes the batch to DB
 func (x *blockIndexer) PutBlocks(blks []*block.Block) error {
 	x.mutex.Lock()
@@ -182,9 +221,12 @@ func (x *blockIndexer) Height() (uint64, error) {
 	return x.tbk.Size() - 1, nil
 }
 
-// GetBlockHash returns the block hash by height
+// GetBlockHash returns the block hash by height, which is available for the pruned blocks as well
 func (x *blockIndexer) GetBlockHash(height uint64) (hash.Hash256, error) {
-	index, err := x.GetBlockIndex(height)
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+
+	index, err := x.getBlockIndex(height)
 	if err != nil {
 		return hash.ZeroHash256, errors.Wrap(err, "failed to get block hash")
 	}
@@ -211,6 +253,13 @@ func (x *blockIndexer) GetBlockIndex(height uint64) (*blockIndex, error) {
 	x.mutex.RLock()
 	defer x.mutex.RUnlock()
 
+	if x.isPruned(height) {
+		return nil, errors.Wrapf(db.ErrPruned, "block at height %d", height)
+	}
+	return x.getBlockIndex(height)
+}
+
+func (x *blockIndexer) getBlockIndex(height uint64) (*blockIndex, error) {
 	v, err := x.tbk.Get(height)
 	if err != nil {
 		return nil, err
@@ -235,6 +284,9 @@ func (x *blockIndexer) GetActionIndex(h []byte) (*actionIndex, error) {
 	if err := a.Deserialize(v); err != nil {
 		return nil, err
 	}
+	if x.isPruned(a.blkHeight) {
+		return nil, errors.Wrapf(db.ErrPruned, "action %x in block at height %d", h, a.blkHeight)
+	}
 	return a, nil
 }
 
@@ -332,6 +384,10 @@ func (x *blockIndexer) putBlock(blk *block.Block) error {
 }
 
 // commit writes the changes
+func (x *blockIndexer) isPruned(height uint64) bool {
+	return x.prunedStart > 0 && x.prunedStart <= height && height <= x.prunedEnd
+}
+
 func (x *blockIndexer) commit() error {
 	var commitErr error
 	for k, v := range x.dirtyAddr {
@@ -333,3 +333,45 @@ func TestIndexer(t *testing.T) {
 		testDelete(db.NewBoltDB(cfg), t)
 	})
 }
+
+func TestIndexer_PruneBlocks(t *testing.T) {
+	require := require.New(t)
+
+	testPath, err := testutil.PathOfTempFile("test-indexer-prune")
+	require.NoError(err)
+	defer testutil.CleanupPath(t, testPath)
+	cfg := config.Default.DB
+	cfg.DbPath = testPath
+
+	ctx := context.Background()
+	blks := getTestBlocks(t)
+	indexer, err := NewIndexer(db.NewBoltDB(cfg), hash.ZeroHash256)
+	require.NoError(err)
+	require.NoError(indexer.Start(ctx))
+	require.NoError(indexer.PutBlocks(blks))
+	require.Error(indexer.PruneBlocks(0, 1))
+	require.Error(indexer.PruneBlocks(2, 1))
+	require.NoError(indexer.PruneBlocks(1, 2))
+	require.NoError(indexer.Stop(ctx))
+
+	// the range of pruned blocks is persisted
+	indexer, err = NewIndexer(db.NewBoltDB(cfg), hash.ZeroHash256)
+	require.NoError(err)
+	require.NoError(indexer.Start(ctx))
+	defer indexer.Stop(ctx)
+	for _, blk := range blks {
+		h, err := indexer.GetBlockHash(blk.Height())
+		require.NoError(err)
+		require.Equal(blk.HashBlock(), h)
+		_, err = indexer.GetBlockIndex(blk.Height())
+		actHash := blk.Actions[0].Hash()
+		_, actErr := indexer.GetActionIndex(actHash[:])
+		if blk.Height() > 2 {
+			require.NoError(err)
+			require.NoError(actErr)
+			continue
+		}
+		require.Equal(db.ErrPruned, errors.Cause(err))
+		require.Equal(db.ErrPruned, errors.Cause(actErr))
+	}
+}
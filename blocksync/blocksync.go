@@ -12,12 +12,14 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/consensus"
+	"github.com/iotexproject/iotex-core/p2p"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
@@ -28,6 +30,8 @@ type (
 	UnicastOutbound func(ctx context.Context, peer peerstore.PeerInfo, msg proto.Message) error
 	// Neighbors returns the neighbors' addresses
 	Neighbors func(ctx context.Context) ([]peerstore.PeerInfo, error)
+	// BlockPeer stops communicating with a misbehaving peer
+	BlockPeer func(peer peerstore.PeerInfo)
 )
 
 // BlockDAO represents the block data access object
@@ -39,6 +43,7 @@ type BlockDAO interface {
 type Config struct {
 	unicastHandler   UnicastOutbound
 	neighborsHandler Neighbors
+	blockPeerHandler BlockPeer
 }
 
 // Option is the option to override the blocksync config
@@ -60,14 +65,25 @@ func WithNeighbors(neighborsHandler Neighbors) Option {
 	}
 }
 
+// WithBlockPeer is the option to set the callback to block a misbehaving peer
+func WithBlockPeer(blockPeerHandler BlockPeer) Option {
+	return func(cfg *Config) error {
+		cfg.blockPeerHandler = blockPeerHandler
+		return nil
+	}
+}
+
 // BlockSync defines the interface of blocksyncer
 type BlockSync interface {
 	lifecycle.StartStopper
 
 	TargetHeight() uint64
-	ProcessSyncRequest(ctx context.Context, peer peerstore.PeerInfo, sync *iotexrpc.BlockSync) error
+	// ProcessSyncRequest serves the block, header or body sync request of the peer
+	ProcessSyncRequest(ctx context.Context, peer peerstore.PeerInfo, sync proto.Message) error
 	ProcessBlock(ctx context.Context, blk *block.Block) error
 	ProcessBlockSync(ctx context.Context, blk *block.Block) error
+	ProcessBlockHeaders(ctx context.Context, headers *iotexrpc.BlockHeaders) error
+	ProcessBlockBodies(ctx context.Context, bodies *iotexrpc.BlockBodies) error
 }
 
 // blockSyncer implements BlockSync interface
@@ -75,7 +91,9 @@ type blockSyncer struct {
 	commitHeight          uint64 // last commit block height
 	processSyncRequestTTL time.Duration
 	buf                   *blockBuffer
+	headers               *headerChain // set in header-first sync
 	worker                *syncWorker
+	scorer                *peerScorer
 	bc                    blockchain.Blockchain
 	dao                   BlockDAO
 	unicastHandler        UnicastOutbound
@@ -90,26 +108,42 @@ func NewBlockSyncer(
 	cs consensus.Consensus,
 	opts ...Option,
 ) (BlockSync, error) {
-	buf := &blockBuffer{
-		blocks:       make(map[uint64]*block.Block),
-		bc:           chain,
-		cs:           cs,
-		bufferSize:   cfg.BlockSync.BufferSize,
-		intervalSize: cfg.BlockSync.IntervalSize,
-	}
 	bsCfg := Config{}
 	for _, opt := range opts {
 		if err := opt(&bsCfg); err != nil {
 			return nil, err
 		}
 	}
+	scorer := newPeerScorer(bsCfg.blockPeerHandler)
+	buf := &blockBuffer{
+		blocks:         make(map[uint64]*block.Block),
+		bc:             chain,
+		cs:             cs,
+		bufferSize:     cfg.BlockSync.BufferSize,
+		intervalSize:   cfg.BlockSync.IntervalSize,
+		invalidHandler: scorer.Invalid,
+	}
+	var headers *headerChain
+	if cfg.BlockSync.HeaderFirst {
+		headers = newHeaderChain(chain, cs, cfg.BlockSync.BufferSize, scorer.Invalid)
+	}
 	bs := &blockSyncer{
-		bc:                    chain,
-		dao:                   dao,
-		buf:                   buf,
-		unicastHandler:        bsCfg.unicastHandler,
-		neighborsHandler:      bsCfg.neighborsHandler,
-		worker:                newSyncWorker(chain.ChainID(), cfg, bsCfg.unicastHandler, bsCfg.neighborsHandler, buf),
+		bc:               chain,
+		dao:              dao,
+		buf:              buf,
+		headers:          headers,
+		scorer:           scorer,
+		unicastHandler:   bsCfg.unicastHandler,
+		neighborsHandler: bsCfg.neighborsHandler,
+		worker: newSyncWorker(
+			chain.ChainID(),
+			cfg,
+			bsCfg.unicastHandler,
+			bsCfg.neighborsHandler,
+			buf,
+			headers,
+			scorer,
+		),
 		processSyncRequestTTL: cfg.BlockSync.ProcessSyncRequestTTL,
 	}
 	return bs, nil
@@ -136,7 +170,8 @@ func (bs *blockSyncer) Stop(ctx context.Context) error {
 }
 
 // ProcessBlock processes an incoming latest committed block
-func (bs *blockSyncer) ProcessBlock(_ context.Context, blk *block.Block) error {
+func (bs *blockSyncer) ProcessBlock(ctx context.Context, blk *block.Block) error {
+	bs.recordDelivery(ctx, blk)
 	var needSync bool
 	moved, re := bs.buf.Flush(blk)
 	switch re {
@@ -158,7 +193,8 @@ func (bs *blockSyncer) ProcessBlock(_ context.Context, blk *block.Block) error {
 	return nil
 }
 
-func (bs *blockSyncer) ProcessBlockSync(_ context.Context, blk *block.Block) error {
+func (bs *blockSyncer) ProcessBlockSync(ctx context.Context, blk *block.Block) error {
+	bs.recordDelivery(ctx, blk)
 	bs.buf.Flush(blk)
 	if bs.bc.TipHeight() == bs.TargetHeight() {
 		bs.worker.SetTargetHeight(bs.TargetHeight() + bs.buf.bufSize())
@@ -166,21 +202,93 @@ func (bs *blockSyncer) ProcessBlockSync(_ context.Context, blk *block.Block) err
 	return nil
 }
 
-// ProcessSyncRequest processes a block sync request
-func (bs *blockSyncer) ProcessSyncRequest(ctx context.Context, peer peerstore.PeerInfo, sync *iotexrpc.BlockSync) error {
-	end := bs.bc.TipHeight()
-	switch {
-	case sync.End < end:
-		end = sync.End
-	case sync.End > end:
-		log.L().Debug(
-			"Do not have requested blocks",
-			zap.String("peerID", peer.ID.Pretty()),
-			zap.Uint64("start", sync.Start),
-			zap.Uint64("end", sync.End),
-			zap.Uint64("tipHeight", end),
+// recordDelivery records the block delivered by the peer which it is received from, if known
+func (bs *blockSyncer) recordDelivery(ctx context.Context, blk *block.Block) {
+	if blk == nil {
+		return
+	}
+	if peer, ok := p2p.GetPeer(ctx); ok {
+		bs.scorer.Delivered(peer, blk, time.Now())
+	}
+}
+
+// ProcessBlockHeaders processes the headers and footers received in header-first sync, and requests the bodies of the
+// blocks of which the headers are validated
+func (bs *blockSyncer) ProcessBlockHeaders(ctx context.Context, headers *iotexrpc.BlockHeaders) error {
+	if bs.headers == nil {
+		return nil
+	}
+	if len(headers.Headers) != len(headers.Footers) {
+		return errors.Errorf(
+			"number of headers %d does not match number of footers %d",
+			len(headers.Headers),
+			len(headers.Footers),
 		)
 	}
+	blks := make([]*block.Block, 0, len(headers.Headers))
+	for i := range headers.Headers {
+		blk := &block.Block{}
+		if err := blk.LoadFromBlockHeaderProto(headers.Headers[i]); err != nil {
+			return err
+		}
+		if err := blk.ConvertFromBlockFooterPb(headers.Footers[i]); err != nil {
+			return err
+		}
+		bs.recordDelivery(ctx, blk)
+		blks = append(blks, blk)
+	}
+	start := bs.headers.Height() + 1
+	if end := bs.headers.AddHeaders(blks); end >= start {
+		bs.worker.SyncBodies(start, end)
+	}
+	return nil
+}
+
+// ProcessBlockBodies processes the bodies received in header-first sync
+func (bs *blockSyncer) ProcessBlockBodies(ctx context.Context, bodies *iotexrpc.BlockBodies) error {
+	if bs.headers == nil {
+		return nil
+	}
+	for i, pb := range bodies.Bodies {
+		var body block.Body
+		if err := body.LoadProto(pb); err != nil {
+			return err
+		}
+		blk, err := bs.headers.Block(bodies.Start+uint64(i), body)
+		if blk == nil {
+			continue
+		}
+		bs.recordDelivery(ctx, blk)
+		if err != nil {
+			log.L().Error("Failed to assemble the block.", zap.Error(err))
+			bs.scorer.Invalid(blk)
+			continue
+		}
+		bs.buf.Flush(blk)
+	}
+	if bs.bc.TipHeight() == bs.TargetHeight() {
+		bs.worker.SetTargetHeight(bs.TargetHeight() + bs.buf.bufSize())
+	}
+	return nil
+}
+
+// ProcessSyncRequest processes a block sync request, which requests the full blocks, or the headers and footers, or
+// the bodies of the blocks in a height range
+func (bs *blockSyncer) ProcessSyncRequest(ctx context.Context, peer peerstore.PeerInfo, sync proto.Message) error {
+	switch req := sync.(type) {
+	case *iotexrpc.BlockSync:
+		return bs.processBlockRequest(ctx, peer, req)
+	case *iotexrpc.BlockHeaderSync:
+		return bs.processHeaderRequest(ctx, peer, req)
+	case *iotexrpc.BlockBodySync:
+		return bs.processBodyRequest(ctx, peer, req)
+	default:
+		return errors.Errorf("unexpected sync request %T", sync)
+	}
+}
+
+func (bs *blockSyncer) processBlockRequest(ctx context.Context, peer peerstore.PeerInfo, sync *iotexrpc.BlockSync) error {
+	end := bs.syncEnd(peer, sync.Start, sync.End)
 	for i := sync.Start; i <= end; i++ {
 		blk, err := bs.dao.GetBlockByHeight(i)
 		if err != nil {
@@ -195,3 +303,86 @@ func (bs *blockSyncer) ProcessSyncRequest(ctx context.Context, peer peerstore.Pe
 	}
 	return nil
 }
+
+// processHeaderRequest sends back the headers and footers of the requested blocks in one message
+func (bs *blockSyncer) processHeaderRequest(
+	ctx context.Context,
+	peer peerstore.PeerInfo,
+	sync *iotexrpc.BlockHeaderSync,
+) error {
+	end := bs.cappedSyncEnd(peer, sync.Start, sync.End)
+	if end < sync.Start {
+		return nil
+	}
+	res := &iotexrpc.BlockHeaders{}
+	for i := sync.Start; i <= end; i++ {
+		blk, err := bs.dao.GetBlockByHeight(i)
+		if err != nil {
+			return err
+		}
+		footer, err := blk.ConvertToBlockFooterPb()
+		if err != nil {
+			return err
+		}
+		res.Headers = append(res.Headers, blk.ConvertToBlockHeaderPb())
+		res.Footers = append(res.Footers, footer)
+	}
+	syncCtx, cancel := context.WithTimeout(ctx, bs.processSyncRequestTTL)
+	defer cancel()
+	if err := bs.unicastHandler(syncCtx, peer, res); err != nil {
+		log.L().Debug("Failed to response to ProcessSyncRequest.", zap.Error(err))
+	}
+	return nil
+}
+
+// processBodyRequest sends back the bodies of the requested blocks in one message
+func (bs *blockSyncer) processBodyRequest(
+	ctx context.Context,
+	peer peerstore.PeerInfo,
+	sync *iotexrpc.BlockBodySync,
+) error {
+	end := bs.cappedSyncEnd(peer, sync.Start, sync.End)
+	if end < sync.Start {
+		return nil
+	}
+	res := &iotexrpc.BlockBodies{Start: sync.Start}
+	for i := sync.Start; i <= end; i++ {
+		blk, err := bs.dao.GetBlockByHeight(i)
+		if err != nil {
+			return err
+		}
+		res.Bodies = append(res.Bodies, blk.Body.Proto())
+	}
+	syncCtx, cancel := context.WithTimeout(ctx, bs.processSyncRequestTTL)
+	defer cancel()
+	if err := bs.unicastHandler(syncCtx, peer, res); err != nil {
+		log.L().Debug("Failed to response to ProcessSyncRequest.", zap.Error(err))
+	}
+	return nil
+}
+
+// syncEnd returns the end of the requested range which can be served, up to the tip height
+func (bs *blockSyncer) syncEnd(peer peerstore.PeerInfo, start, end uint64) uint64 {
+	tipHeight := bs.bc.TipHeight()
+	if end > tipHeight {
+		log.L().Debug(
+			"Do not have requested blocks",
+			zap.String("peerID", peer.ID.Pretty()),
+			zap.Uint64("start", start),
+			zap.Uint64("end", end),
+			zap.Uint64("tipHeight", tipHeight),
+		)
+		end = tipHeight
+	}
+	return end
+}
+
+// cappedSyncEnd is syncEnd with the range capped to the buffer size, as the requester does not accept blocks beyond
+// it, so that the response fits in one message
+func (bs *blockSyncer) cappedSyncEnd(peer peerstore.PeerInfo, start, end uint64) uint64 {
+	end = bs.syncEnd(peer, start, end)
+	if size := bs.buf.bufSize(); size != 0 && end >= start && end-start >= size {
+		end = start + size - 1
+	}
+	return end
+}
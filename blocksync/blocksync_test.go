@@ -15,6 +15,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	peerstore "github.com/libp2p/go-libp2p-peerstore"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -161,6 +162,91 @@ func TestBlockSyncerProcessSyncRequestError(t *testing.T) {
 	require.Error(bs.ProcessSyncRequest(context.Background(), peerstore.PeerInfo{}, pbBs))
 }
 
+func TestBlockSyncerHeaderFirst(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg, err := newTestConfig()
+	require.NoError(err)
+	cfg.BlockSync.HeaderFirst = true
+	blks := testHeaderChainBlocks(t, hash.ZeroHash256, 1, 3)
+	var msgs []proto.Message
+	opts := []Option{
+		WithUnicastOutBound(func(_ context.Context, _ peerstore.PeerInfo, msg proto.Message) error {
+			msgs = append(msgs, msg)
+			return nil
+		}),
+		WithNeighbors(func(_ context.Context) ([]peerstore.PeerInfo, error) {
+			return []peerstore.PeerInfo{{}}, nil
+		}),
+	}
+
+	// serve the header and body requests
+	chain := mock_blockchain.NewMockBlockchain(ctrl)
+	chain.EXPECT().ChainID().Return(uint32(1)).AnyTimes()
+	chain.EXPECT().TipHeight().Return(uint64(3)).AnyTimes()
+	dao := mock_blockdao.NewMockBlockDAO(ctrl)
+	dao.EXPECT().GetBlockByHeight(gomock.Any()).DoAndReturn(func(h uint64) (*block.Block, error) {
+		return blks[h-1], nil
+	}).AnyTimes()
+	bs, err := NewBlockSyncer(cfg, chain, dao, mock_consensus.NewMockConsensus(ctrl), opts...)
+	require.NoError(err)
+	ctx := context.Background()
+	require.NoError(bs.ProcessSyncRequest(ctx, peerstore.PeerInfo{}, &iotexrpc.BlockHeaderSync{Start: 1, End: 5}))
+	require.NoError(bs.ProcessSyncRequest(ctx, peerstore.PeerInfo{}, &iotexrpc.BlockBodySync{Start: 2, End: 3}))
+	require.Error(bs.ProcessSyncRequest(ctx, peerstore.PeerInfo{}, &iotexrpc.BlockHeaders{}))
+	require.Len(msgs, 2)
+	headers, ok := msgs[0].(*iotexrpc.BlockHeaders)
+	require.True(ok)
+	require.Len(headers.Headers, 3)
+	require.Len(headers.Footers, 3)
+	bodies, ok := msgs[1].(*iotexrpc.BlockBodies)
+	require.True(ok)
+	require.Equal(uint64(2), bodies.Start)
+	require.Len(bodies.Bodies, 2)
+
+	// validate the header chain, then commit the blocks once their bodies arrive
+	msgs = nil
+	var tipHeight uint64
+	chain = mock_blockchain.NewMockBlockchain(ctrl)
+	chain.EXPECT().ChainID().Return(uint32(1)).AnyTimes()
+	chain.EXPECT().TipHeight().DoAndReturn(func() uint64 { return tipHeight }).AnyTimes()
+	chain.EXPECT().TipHash().DoAndReturn(func() hash.Hash256 {
+		if tipHeight == 0 {
+			return hash.ZeroHash256
+		}
+		return blks[tipHeight-1].HashBlock()
+	}).AnyTimes()
+	chain.EXPECT().ValidateBlock(gomock.Any()).Return(nil).Times(3)
+	chain.EXPECT().CommitBlock(gomock.Any()).DoAndReturn(func(blk *block.Block) error {
+		require.Equal(blks[tipHeight].HashBlock(), blk.HashBlock())
+		tipHeight++
+		return nil
+	}).Times(3)
+	cs := mock_consensus.NewMockConsensus(ctrl)
+	cs.EXPECT().ValidateBlockFooter(gomock.Any()).Return(nil).AnyTimes()
+	cs.EXPECT().Calibrate(gomock.Any()).Times(3)
+	bs, err = NewBlockSyncer(cfg, chain, dao, cs, opts...)
+	require.NoError(err)
+	require.Error(bs.ProcessBlockHeaders(ctx, &iotexrpc.BlockHeaders{Headers: headers.Headers}))
+	require.NoError(bs.ProcessBlockHeaders(ctx, headers))
+	// the bodies of the validated headers are requested right away
+	require.Len(msgs, 1)
+	require.Equal(&iotexrpc.BlockBodySync{Start: 1, End: 3}, msgs[0])
+
+	// a body not matching its header is dropped
+	require.NoError(bs.ProcessBlockBodies(ctx, &iotexrpc.BlockBodies{Start: 1, Bodies: bodies.Bodies}))
+	require.Zero(tipHeight)
+	require.NoError(bs.ProcessBlockBodies(ctx, &iotexrpc.BlockBodies{Start: 2, Bodies: bodies.Bodies}))
+	require.Zero(tipHeight)
+	require.NoError(bs.ProcessBlockBodies(ctx, &iotexrpc.BlockBodies{
+		Start:  1,
+		Bodies: []*iotextypes.BlockBody{blks[0].Body.Proto()},
+	}))
+	require.Equal(uint64(3), tipHeight)
+}
+
 func TestBlockSyncerProcessBlockTipHeight(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
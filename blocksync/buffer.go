@@ -9,11 +9,9 @@ package blocksync
 import (
 	"sync"
 
-	"github.com/iotexproject/iotex-election/db"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
-	"github.com/iotexproject/iotex-core/action/protocol/poll"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/consensus"
@@ -39,6 +37,8 @@ type blockBuffer struct {
 	bufferSize   uint64
 	intervalSize uint64
 	commitHeight uint64 // last commit block height
+	// invalidHandler is called with the block failing validation
+	invalidHandler func(*block.Block)
 }
 
 // CommitHeight return the last commit block height
@@ -78,10 +78,13 @@ func (b *blockBuffer) Flush(blk *block.Block) (bool, bCheckinResult) {
 		}
 		delete(b.blocks, heightToSync)
 		if err := commitBlock(b.bc, b.cs, blk); err != nil && errors.Cause(err) != blockchain.ErrInvalidTipHeight {
-			if errors.Cause(err) == poll.ErrProposedDelegatesLength || errors.Cause(err) == poll.ErrDelegatesNotAsExpected || errors.Cause(err) == db.ErrNotExist {
+			if isDelegatesUnknown(err) {
 				l.Debug("Failed to commit the block.", zap.Error(err), zap.Uint64("syncHeight", heightToSync))
 			} else {
 				l.Error("Failed to commit the block.", zap.Error(err), zap.Uint64("syncHeight", heightToSync))
+				if _, ok := err.(*invalidBlockError); ok && b.invalidHandler != nil {
+					b.invalidHandler(blk)
+				}
 			}
 			break
 		}
@@ -104,12 +107,6 @@ func (b *blockBuffer) Flush(blk *block.Block) (bool, bCheckinResult) {
 
 // GetBlocksIntervalsToSync returns groups of syncBlocksInterval are missing upto targetHeight.
 func (b *blockBuffer) GetBlocksIntervalsToSync(targetHeight uint64) []syncBlocksInterval {
-	var (
-		start    uint64
-		startSet bool
-		bi       []syncBlocksInterval
-	)
-
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 
@@ -122,24 +119,47 @@ func (b *blockBuffer) GetBlocksIntervalsToSync(targetHeight uint64) []syncBlocks
 	if targetHeight < confirmedHeight+b.intervalSize {
 		targetHeight = confirmedHeight + b.intervalSize
 	}
+	return b.missingIntervals(confirmedHeight+1, targetHeight)
+}
+
+// GetBodyIntervalsToSync returns groups of syncBlocksInterval of which the bodies are missing up to the height of the
+// validated header chain.
+func (b *blockBuffer) GetBodyIntervalsToSync(headerHeight uint64) []syncBlocksInterval {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
 
-	var iLen uint64
-	for h := confirmedHeight + 1; h <= targetHeight; h++ {
+	confirmedHeight := b.bc.TipHeight()
+	if headerHeight > confirmedHeight+b.bufferSize {
+		headerHeight = confirmedHeight + b.bufferSize
+	}
+	return b.missingIntervals(confirmedHeight+1, headerHeight)
+}
+
+// missingIntervals returns the intervals in [start, end] of which the blocks are not in the buffer, each interval is
+// at most intervalSize long
+func (b *blockBuffer) missingIntervals(start, end uint64) []syncBlocksInterval {
+	var (
+		iStart   uint64
+		startSet bool
+		bi       []syncBlocksInterval
+		iLen     uint64
+	)
+	for h := start; h <= end; h++ {
 		if _, ok := b.blocks[h]; !ok {
 			iLen++
 			if !startSet {
-				start = h
+				iStart = h
 				startSet = true
 			}
 			if iLen >= b.intervalSize {
-				bi = append(bi, syncBlocksInterval{Start: start, End: h})
+				bi = append(bi, syncBlocksInterval{Start: iStart, End: h})
 				startSet = false
 				iLen = 0
 			}
 			continue
 		}
 		if startSet {
-			bi = append(bi, syncBlocksInterval{Start: start, End: h - 1})
+			bi = append(bi, syncBlocksInterval{Start: iStart, End: h - 1})
 			startSet = false
 			iLen = 0
 		}
@@ -147,7 +167,7 @@ func (b *blockBuffer) GetBlocksIntervalsToSync(targetHeight uint64) []syncBlocks
 
 	// handle last interval
 	if startSet {
-		bi = append(bi, syncBlocksInterval{Start: start, End: targetHeight})
+		bi = append(bi, syncBlocksInterval{Start: iStart, End: end})
 	}
 	return bi
 }
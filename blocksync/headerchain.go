@@ -0,0 +1,140 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"sync"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/consensus"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// headerChain keeps the validated headers and footers of the blocks above the tip, so that the bodies can be
+// downloaded from any peer and checked against the transaction roots of the headers.
+type headerChain struct {
+	mu         sync.Mutex
+	bc         blockchain.Blockchain
+	cs         consensus.Consensus
+	bufferSize uint64
+	headers    map[uint64]*block.Block
+	height     uint64       // height of the last validated header
+	hash       hash.Hash256 // hash of the last validated header
+	// invalidHandler is called with the block of which the header fails validation
+	invalidHandler func(*block.Block)
+}
+
+func newHeaderChain(
+	bc blockchain.Blockchain,
+	cs consensus.Consensus,
+	bufferSize uint64,
+	invalidHandler func(*block.Block),
+) *headerChain {
+	return &headerChain{
+		bc:             bc,
+		cs:             cs,
+		bufferSize:     bufferSize,
+		headers:        make(map[uint64]*block.Block),
+		invalidHandler: invalidHandler,
+	}
+}
+
+// Height returns the height of the last validated header
+func (hc *headerChain) Height() uint64 {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	hc.trim()
+	return hc.height
+}
+
+// AddHeaders validates the headers following the last validated one, the blocks only carry the headers and footers.
+// It returns the height of the last validated header.
+func (hc *headerChain) AddHeaders(blks []*block.Block) uint64 {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	hc.trim()
+	maxHeight := hc.bc.TipHeight() + hc.bufferSize
+	for _, blk := range blks {
+		height := blk.Height()
+		if height <= hc.height {
+			continue
+		}
+		if height != hc.height+1 || height > maxHeight {
+			break
+		}
+		if err := hc.validate(blk); err != nil {
+			if isDelegatesUnknown(err) {
+				log.L().Debug("Failed to validate the block header.", zap.Error(err), zap.Uint64("height", height))
+			} else {
+				log.L().Error("Failed to validate the block header.", zap.Error(err), zap.Uint64("height", height))
+				if hc.invalidHandler != nil {
+					hc.invalidHandler(blk)
+				}
+			}
+			break
+		}
+		hc.headers[height] = blk
+		hc.height = height
+		hc.hash = blk.HashBlock()
+	}
+	return hc.height
+}
+
+// Block assembles the block at the height with the body. It returns nil if the header at the height is not validated,
+// and the block along with an error if the body does not match the transaction root of the header.
+func (hc *headerChain) Block(height uint64, body block.Body) (*block.Block, error) {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	h, ok := hc.headers[height]
+	if !ok {
+		return nil, nil
+	}
+	blk := &block.Block{
+		Header: h.Header,
+		Body:   body,
+		Footer: h.Footer,
+	}
+	if err := blk.VerifyTxRoot(blk.CalculateTxRoot()); err != nil {
+		return blk, errors.Wrapf(err, "invalid body of block %d", height)
+	}
+	return blk, nil
+}
+
+func (hc *headerChain) validate(blk *block.Block) error {
+	if blk.PrevHash() != hc.hash {
+		return errors.Errorf("previous hash %x of block %d does not match %x", blk.PrevHash(), blk.Height(), hc.hash)
+	}
+	if !blk.VerifySignature() {
+		return errors.Errorf("failed to verify the signature of block %d", blk.Height())
+	}
+	// the footer carries the endorsements of the header by the delegates
+	return hc.cs.ValidateBlockFooter(blk)
+}
+
+// trim drops the headers of the committed blocks, and starts over from the tip if the header chain falls behind or
+// does not extend it
+func (hc *headerChain) trim() {
+	tipHeight := hc.bc.TipHeight()
+	if hc.height > tipHeight {
+		for h := range hc.headers {
+			if h <= tipHeight {
+				delete(hc.headers, h)
+			}
+		}
+		if next, ok := hc.headers[tipHeight+1]; ok && next.PrevHash() == hc.bc.TipHash() {
+			return
+		}
+	}
+	hc.headers = make(map[uint64]*block.Block)
+	hc.height = tipHeight
+	hc.hash = hc.bc.TipHash()
+}
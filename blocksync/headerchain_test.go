@@ -0,0 +1,125 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action/protocol/poll"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
+	"github.com/iotexproject/iotex-core/test/mock/mock_consensus"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func testHeaderChainBlocks(t *testing.T, prevHash hash.Hash256, start uint64, n int) []*block.Block {
+	require := require.New(t)
+	var blks []*block.Block
+	for i := 0; i < n; i++ {
+		selp, err := testutil.SignedTransfer(
+			identityset.Address(28).String(),
+			identityset.PrivateKey(27),
+			uint64(i+1),
+			big.NewInt(1),
+			nil,
+			100,
+			big.NewInt(0),
+		)
+		require.NoError(err)
+		blk, err := block.NewTestingBuilder().
+			SetHeight(start + uint64(i)).
+			SetPrevBlockHash(prevHash).
+			SetTimeStamp(testutil.TimestampNow()).
+			AddActions(selp).
+			SignAndBuild(identityset.PrivateKey(27))
+		require.NoError(err)
+		blks = append(blks, &blk)
+		prevHash = blk.HashBlock()
+	}
+	return blks
+}
+
+// headersOf returns the blocks carrying only the headers and footers, as received in header-first sync
+func headersOf(blks []*block.Block) []*block.Block {
+	res := make([]*block.Block, 0, len(blks))
+	for _, blk := range blks {
+		res = append(res, &block.Block{Header: blk.Header, Footer: blk.Footer})
+	}
+	return res
+}
+
+func TestHeaderChain(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tipHash := hash.Hash256b([]byte("tip"))
+	tipHeight := uint64(10)
+	chain := mock_blockchain.NewMockBlockchain(ctrl)
+	chain.EXPECT().TipHeight().DoAndReturn(func() uint64 { return tipHeight }).AnyTimes()
+	chain.EXPECT().TipHash().DoAndReturn(func() hash.Hash256 { return tipHash }).AnyTimes()
+	cs := mock_consensus.NewMockConsensus(ctrl)
+	cs.EXPECT().ValidateBlockFooter(gomock.Any()).Return(nil).AnyTimes()
+	var invalid []*block.Block
+	hc := newHeaderChain(chain, cs, 4, func(blk *block.Block) { invalid = append(invalid, blk) })
+	require.Equal(tipHeight, hc.Height())
+
+	blks := testHeaderChainBlocks(t, tipHash, tipHeight+1, 5)
+	// headers not following the last validated one are ignored
+	require.Equal(tipHeight, hc.AddHeaders(headersOf(blks[1:3])))
+	// headers beyond the buffer size are not accepted
+	require.Equal(tipHeight+4, hc.AddHeaders(headersOf(blks)))
+	require.Empty(invalid)
+
+	// the bodies are checked against the transaction roots of the headers
+	blk, err := hc.Block(tipHeight+2, blks[1].Body)
+	require.NoError(err)
+	require.Equal(blks[1].HashBlock(), blk.HashBlock())
+	require.Equal(blks[1].Actions, blk.Actions)
+	blk, err = hc.Block(tipHeight+2, blks[2].Body)
+	require.Error(err)
+	require.Equal(blks[1].HashBlock(), blk.HashBlock())
+	blk, err = hc.Block(tipHeight+5, blks[4].Body)
+	require.NoError(err)
+	require.Nil(blk)
+
+	// the committed headers are dropped
+	tipHeight, tipHash = tipHeight+2, blks[1].HashBlock()
+	require.Equal(tipHeight+2, hc.Height())
+	blk, err = hc.Block(tipHeight, blks[1].Body)
+	require.NoError(err)
+	require.Nil(blk)
+	require.Equal(tipHeight+3, hc.AddHeaders(headersOf(blks[4:])))
+
+	// the header chain starts over if it does not extend the tip
+	tipHash = hash.Hash256b([]byte("fork"))
+	require.Equal(tipHeight, hc.Height())
+
+	// a header not linked to the last validated one is invalid
+	forked := testHeaderChainBlocks(t, hash.ZeroHash256, tipHeight+1, 1)
+	require.Equal(tipHeight, hc.AddHeaders(headersOf(forked)))
+	require.Len(invalid, 1)
+	require.Equal(forked[0].HashBlock(), invalid[0].HashBlock())
+
+	// a header without the endorsements of the delegates is invalid, unless the delegates are not known yet
+	blks = testHeaderChainBlocks(t, tipHash, tipHeight+1, 1)
+	cs = mock_consensus.NewMockConsensus(ctrl)
+	cs.EXPECT().ValidateBlockFooter(gomock.Any()).Return(poll.ErrDelegatesNotAsExpected).Times(1)
+	cs.EXPECT().ValidateBlockFooter(gomock.Any()).Return(errors.New("insufficient endorsements")).Times(1)
+	hc.cs = cs
+	require.Equal(tipHeight, hc.AddHeaders(headersOf(blks)))
+	require.Len(invalid, 1)
+	require.Equal(tipHeight, hc.AddHeaders(headersOf(blks)))
+	require.Len(invalid, 2)
+}
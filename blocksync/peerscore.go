@@ -0,0 +1,199 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/cache"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+const (
+	// blockSourceCacheSize is the number of recently received blocks of which the source peers are remembered
+	blockSourceCacheSize = 1000
+	// maxInvalidBlocks is the number of invalid blocks a peer can deliver before it is reported
+	maxInvalidBlocks = 3
+	// invalidBlockPenalty is the weight of an invalid block against the delivered blocks in the score
+	invalidBlockPenalty = 10
+	// unansweredPenalty is the weight of an unanswered request against the delivered blocks in the score
+	unansweredPenalty = 5
+	// latencyDecay is the weight of the latest sample in the moving average of latency
+	latencyDecay = 0.3
+	// goodScoreRatio is the ratio of the best score a peer has to reach to be preferred
+	goodScoreRatio = 0.5
+)
+
+type (
+	// peerStats is the statistics of a peer serving block sync requests
+	peerStats struct {
+		info peerstore.PeerInfo
+		// latency is the moving average of the time between a request and its first response
+		latency time.Duration
+		// delivered and invalid are the numbers of the blocks and invalid blocks received from the peer
+		delivered uint64
+		invalid   uint64
+		// unanswered is the number of requests the peer did not respond to
+		unanswered uint64
+		// height is the highest block height received from the peer
+		height uint64
+		// tip is set once the peer is known to be behind, i.e. it answered a request without reaching its end
+		tip uint64
+		// requestTime and requestEnd are of the pending request, requestTime is zero if the request has been answered
+		requestTime time.Time
+		requestEnd  uint64
+	}
+
+	// peerScorer scores the peers by their responses to block sync requests
+	peerScorer struct {
+		mu        sync.Mutex
+		peers     map[string]*peerStats
+		sources   *cache.ThreadSafeLruCache
+		blockPeer BlockPeer
+	}
+)
+
+func newPeerScorer(blockPeer BlockPeer) *peerScorer {
+	return &peerScorer{
+		peers:     make(map[string]*peerStats),
+		sources:   cache.NewThreadSafeLruCache(blockSourceCacheSize),
+		blockPeer: blockPeer,
+	}
+}
+
+// score returns the score of the peer, a peer never requested has the highest score of 1 so it gets explored
+func (s *peerStats) score() float64 {
+	reliability := float64(s.delivered+1) / float64(s.delivered+1+invalidBlockPenalty*s.invalid+unansweredPenalty*s.unanswered)
+	return reliability / (1 + s.latency.Seconds())
+}
+
+// behind returns true if the peer is known not to have the block at the height
+func (s *peerStats) behind(height uint64) bool {
+	return s.tip != 0 && s.tip < height
+}
+
+func (ps *peerScorer) stats(info peerstore.PeerInfo) *peerStats {
+	name := info.ID.Pretty()
+	s, ok := ps.peers[name]
+	if !ok {
+		s = &peerStats{info: info}
+		ps.peers[name] = s
+	}
+	return s
+}
+
+// Rank returns the peers preferred to serve the blocks from the height, in descending order of their scores. Peers
+// known to be behind the height and peers scoring less than a ratio of the best score are left out, unless there are
+// not enough peers to send count requests to.
+func (ps *peerScorer) Rank(peers []peerstore.PeerInfo, height uint64, count int) []peerstore.PeerInfo {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	type scored struct {
+		info   peerstore.PeerInfo
+		score  float64
+		behind bool
+	}
+	ranked := make([]scored, 0, len(peers))
+	for _, p := range peers {
+		s := ps.stats(p)
+		ranked = append(ranked, scored{p, s.score(), s.behind(height)})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].behind != ranked[j].behind {
+			return !ranked[i].behind
+		}
+		return ranked[i].score > ranked[j].score
+	})
+	res := make([]peerstore.PeerInfo, 0, len(ranked))
+	for _, p := range ranked {
+		if len(res) >= count && (p.behind || p.score < ranked[0].score*goodScoreRatio) {
+			break
+		}
+		res = append(res, p.info)
+	}
+	return res
+}
+
+// Requested records the block sync requests sent to the peer, up to the end height
+func (ps *peerScorer) Requested(info peerstore.PeerInfo, end uint64, t time.Time) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	s := ps.stats(info)
+	switch {
+	case !s.requestTime.IsZero():
+		// the previous request has not been answered
+		s.unanswered++
+	case s.height < s.requestEnd:
+		// the previous request has been answered without reaching its end
+		s.tip = s.height
+	}
+	s.requestTime = t
+	s.requestEnd = end
+}
+
+// Delivered records a block received from the peer
+func (ps *peerScorer) Delivered(info peerstore.PeerInfo, blk *block.Block, t time.Time) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	s := ps.stats(info)
+	s.delivered++
+	if !s.requestTime.IsZero() {
+		latency := t.Sub(s.requestTime)
+		if s.latency == 0 {
+			s.latency = latency
+		} else {
+			s.latency = time.Duration(latencyDecay*float64(latency) + (1-latencyDecay)*float64(s.latency))
+		}
+		s.requestTime = time.Time{}
+	}
+	if h := blk.Height(); h > s.height {
+		s.height = h
+	}
+	if s.tip != 0 && s.height > s.tip {
+		// the peer has caught up
+		s.tip = 0
+	}
+	ps.sources.Add(blk.HashBlock(), info)
+}
+
+// Invalid records an invalid block, the peer which delivered it is reported after delivering too many invalid blocks
+func (ps *peerScorer) Invalid(blk *block.Block) {
+	blkHash := blk.HashBlock()
+	v, ok := ps.sources.Get(blkHash)
+	if !ok {
+		return
+	}
+	ps.sources.Remove(blkHash)
+	info := v.(peerstore.PeerInfo)
+
+	ps.mu.Lock()
+	s := ps.stats(info)
+	s.invalid++
+	report := s.invalid >= maxInvalidBlocks
+	if report {
+		// the peer starts over once it is back from the blocklist
+		delete(ps.peers, info.ID.Pretty())
+	}
+	ps.mu.Unlock()
+
+	if report && ps.blockPeer != nil {
+		log.L().Warn("Peer delivered too many invalid blocks.",
+			zap.String("peerID", info.ID.Pretty()),
+			zap.Uint64("height", blk.Height()),
+			log.Hex("hash", blkHash[:]))
+		ps.blockPeer(info)
+	}
+}
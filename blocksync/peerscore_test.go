@@ -0,0 +1,142 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func newTestBlock(height uint64) *block.Block {
+	return block.NewBlockDeprecated(
+		config.Default.Chain.ID,
+		height,
+		hash.Hash256{},
+		testutil.TimestampNow(),
+		identityset.PrivateKey(27).PublicKey(),
+		nil,
+	)
+}
+
+func TestPeerScorer(t *testing.T) {
+	r := require.New(t)
+
+	var blocked []peerstore.PeerInfo
+	ps := newPeerScorer(func(peer peerstore.PeerInfo) {
+		blocked = append(blocked, peer)
+	})
+	fast, slow, behind, bad := peerstore.PeerInfo{ID: "fast"}, peerstore.PeerInfo{ID: "slow"},
+		peerstore.PeerInfo{ID: "behind"}, peerstore.PeerInfo{ID: "bad"}
+	peers := []peerstore.PeerInfo{bad, behind, slow, fast}
+
+	// all peers are preferred before being requested
+	r.Len(ps.Rank(peers, 1, 1), 4)
+
+	now := time.Now()
+	for _, p := range peers {
+		ps.Requested(p, 10, now)
+	}
+	for h := uint64(1); h <= 10; h++ {
+		ps.Delivered(fast, newTestBlock(h), now.Add(100*time.Millisecond))
+		ps.Delivered(slow, newTestBlock(h), now.Add(3*time.Second))
+		if h <= 5 {
+			ps.Delivered(behind, newTestBlock(h), now.Add(200*time.Millisecond))
+		}
+	}
+	// bad does not answer the request
+	now = now.Add(10 * time.Second)
+	for _, p := range peers {
+		ps.Requested(p, 20, now)
+	}
+
+	// peers are ranked by scores, the peer behind the height goes last
+	r.Equal([]peerstore.PeerInfo{fast, slow, bad, behind}, ps.Rank(peers, 11, 4))
+	r.Equal([]peerstore.PeerInfo{fast, behind, slow, bad}, ps.Rank(peers, 5, 4))
+	// peers scoring low are left out if there are enough peers
+	r.Equal([]peerstore.PeerInfo{fast}, ps.Rank(peers, 11, 1))
+	r.Equal([]peerstore.PeerInfo{fast, slow}, ps.Rank(peers, 11, 2))
+
+	// the peer is no longer behind once it delivers higher blocks
+	ps.Delivered(behind, newTestBlock(11), now.Add(100*time.Millisecond))
+	r.Equal(behind, ps.Rank(peers, 11, 4)[1])
+
+	// peer is reported after delivering too many invalid blocks
+	for h := uint64(11); h < 11+maxInvalidBlocks; h++ {
+		blk := newTestBlock(h)
+		ps.Delivered(fast, blk, now)
+		r.Empty(blocked)
+		ps.Invalid(blk)
+	}
+	r.Equal([]peerstore.PeerInfo{fast}, blocked)
+	// block of unknown source
+	ps.Invalid(newTestBlock(100))
+	r.Len(blocked, 1)
+}
+
+func TestSyncWorkerSpreadRequests(t *testing.T) {
+	r := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chain := mock_blockchain.NewMockBlockchain(ctrl)
+	chain.EXPECT().TipHeight().Return(uint64(0)).AnyTimes()
+	cfg := config.Default
+	cfg.BlockSync.Interval = 0
+	cfg.BlockSync.MaxRepeat = 2
+	cfg.BlockSync.RepeatDecayStep = 2
+	cfg.BlockSync.IntervalSize = 10
+	peers := []peerstore.PeerInfo{{ID: "a"}, {ID: "b"}, {ID: "c"}, {ID: "d"}}
+
+	var (
+		mu       sync.Mutex
+		requests = make(map[string][]*iotexrpc.BlockSync)
+	)
+	unicast := func(_ context.Context, peer peerstore.PeerInfo, msg proto.Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+		requests[peer.ID.Pretty()] = append(requests[peer.ID.Pretty()], msg.(*iotexrpc.BlockSync))
+		return nil
+	}
+	neighbors := func(_ context.Context) ([]peerstore.PeerInfo, error) { return peers, nil }
+	buf := &blockBuffer{
+		blocks:       make(map[uint64]*block.Block),
+		bc:           chain,
+		bufferSize:   cfg.BlockSync.BufferSize,
+		intervalSize: cfg.BlockSync.IntervalSize,
+	}
+	w := newSyncWorker(cfg.Chain.ID, cfg, unicast, neighbors, buf, nil, newPeerScorer(nil))
+	w.SetTargetHeight(40)
+	w.Sync()
+
+	// 4 intervals, the first two are requested twice, from distinct peers
+	counts := make(map[uint64]int)
+	for p, reqs := range requests {
+		starts := make(map[uint64]bool)
+		for _, req := range reqs {
+			r.False(starts[req.Start], "peer %s", p)
+			starts[req.Start] = true
+			counts[req.Start]++
+		}
+	}
+	r.Equal(map[uint64]int{1: 2, 11: 2, 21: 1, 31: 1}, counts)
+	r.Len(requests, 4)
+}
@@ -7,17 +7,41 @@
 package blocksync
 
 import (
+	"github.com/iotexproject/iotex-election/db"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol/poll"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/consensus"
 )
 
+// invalidBlockError is the error of a block failing validation
+type invalidBlockError struct {
+	err error
+}
+
+func (e *invalidBlockError) Error() string { return e.err.Error() }
+
+func (e *invalidBlockError) Cause() error { return errors.Cause(e.err) }
+
+// isDelegatesUnknown returns true if the error is caused by the delegates of the block not being known yet, which is
+// the case until the blocks before it are committed
+func isDelegatesUnknown(err error) bool {
+	switch errors.Cause(err) {
+	case poll.ErrProposedDelegatesLength, poll.ErrDelegatesNotAsExpected, db.ErrNotExist:
+		return true
+	default:
+		return false
+	}
+}
+
 func commitBlock(bc blockchain.Blockchain, cs consensus.Consensus, blk *block.Block) error {
 	if err := cs.ValidateBlockFooter(blk); err != nil {
-		return err
+		return &invalidBlockError{err}
 	}
 	if err := bc.ValidateBlock(blk); err != nil {
-		return err
+		return &invalidBlockError{err}
 	}
 	if err := bc.CommitBlock(blk); err != nil {
 		return err
@@ -8,9 +8,11 @@ package blocksync
 
 import (
 	"context"
-	"math/rand"
 	"sync"
+	"time"
 
+	"github.com/golang/protobuf/proto"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
 	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/config"
@@ -31,6 +33,8 @@ type syncWorker struct {
 	unicastHandler   UnicastOutbound
 	neighborsHandler Neighbors
 	buf              *blockBuffer
+	headers          *headerChain // set in header-first sync
+	scorer           *peerScorer
 	task             *routine.RecurringTask
 	maxRepeat        int
 	repeatDecayStep  int
@@ -42,12 +46,16 @@ func newSyncWorker(
 	unicastHandler UnicastOutbound,
 	neighborsHandler Neighbors,
 	buf *blockBuffer,
+	headers *headerChain,
+	scorer *peerScorer,
 ) *syncWorker {
 	w := &syncWorker{
 		chainID:          chainID,
 		unicastHandler:   unicastHandler,
 		neighborsHandler: neighborsHandler,
 		buf:              buf,
+		headers:          headers,
+		scorer:           scorer,
 		targetHeight:     0,
 		maxRepeat:        cfg.BlockSync.MaxRepeat,
 		repeatDecayStep:  cfg.BlockSync.RepeatDecayStep,
@@ -95,26 +103,152 @@ func (w *syncWorker) Sync() {
 		log.L().Warn("Error when get neighbor peers.", zap.Error(err))
 		return
 	}
+	if w.headers != nil {
+		w.syncHeaderFirst(ctx, peers)
+		return
+	}
 	intervals := w.buf.GetBlocksIntervalsToSync(w.targetHeight)
 	if intervals != nil {
 		log.L().Info("block sync intervals.",
 			zap.Any("intervals", intervals),
 			zap.Uint64("targetHeight", w.targetHeight))
 	}
+	targets, requests := w.spread(peers, intervals)
+	w.request(ctx, targets, requests, nil, func(interval syncBlocksInterval) proto.Message {
+		return &iotexrpc.BlockSync{Start: interval.Start, End: interval.End}
+	})
+}
+
+// syncHeaderFirst requests the header chain up to the target height from the preferred peer, and the bodies of the
+// blocks with validated headers from the preferred peers in parallel
+func (w *syncWorker) syncHeaderFirst(ctx context.Context, peers []peerstore.PeerInfo) {
+	var (
+		headerHeight = w.headers.Height()
+		end          = w.targetHeight
+		headerPeer   peerstore.PeerInfo
+		headerEnds   = make(map[string]uint64)
+	)
+	if maxHeight := w.buf.bc.TipHeight() + w.buf.bufSize(); end > maxHeight {
+		end = maxHeight
+	}
+	if headerHeight < end {
+		// headers are small, so the whole range is requested from one peer
+		headerPeer = w.scorer.Rank(peers, headerHeight+1, 1)[0]
+		headerEnds[headerPeer.ID.Pretty()] = end
+		if err := w.unicastHandler(ctx, headerPeer, &iotexrpc.BlockHeaderSync{
+			Start: headerHeight + 1, End: end,
+		}); err != nil {
+			log.L().Debug("Failed to sync block headers.", zap.Error(err))
+		}
+	}
+	intervals := w.buf.GetBodyIntervalsToSync(headerHeight)
+	if intervals != nil {
+		log.L().Info("block body sync intervals.",
+			zap.Any("intervals", intervals),
+			zap.Uint64("headerHeight", headerHeight),
+			zap.Uint64("targetHeight", w.targetHeight))
+	}
+	targets, requests := w.spread(peers, intervals)
+	if len(headerEnds) != 0 {
+		if _, ok := requests[headerPeer.ID.Pretty()]; !ok {
+			w.scorer.Requested(headerPeer, end, time.Now())
+		}
+	}
+	w.request(ctx, targets, requests, headerEnds, bodySyncRequest)
+}
+
+// SyncBodies requests the bodies of the blocks in [start, end] from the preferred peers in parallel, it is called
+// once the headers of the blocks are validated
+func (w *syncWorker) SyncBodies(start, end uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	ctx := context.Background()
+	peers, err := w.neighborsHandler(ctx)
+	if err != nil {
+		log.L().Warn("Error when get neighbor peers.", zap.Error(err))
+		return
+	}
+	if len(peers) == 0 {
+		log.L().Debug("No peer exist to sync with.")
+		return
+	}
+	size := w.buf.intervalSize
+	if size == 0 {
+		size = end - start + 1
+	}
+	var intervals []syncBlocksInterval
+	for s := start; s <= end; s += size {
+		e := s + size - 1
+		if e > end {
+			e = end
+		}
+		intervals = append(intervals, syncBlocksInterval{Start: s, End: e})
+	}
+	targets, requests := w.spread(peers, intervals)
+	w.request(ctx, targets, requests, nil, bodySyncRequest)
+}
 
+func bodySyncRequest(interval syncBlocksInterval) proto.Message {
+	return &iotexrpc.BlockBodySync{Start: interval.Start, End: interval.End}
+}
+
+// spread spreads the intervals across the preferred peers, each interval is requested from repeat distinct peers
+func (w *syncWorker) spread(
+	peers []peerstore.PeerInfo,
+	intervals []syncBlocksInterval,
+) ([]peerstore.PeerInfo, map[string][]syncBlocksInterval) {
+	var (
+		requests = make(map[string][]syncBlocksInterval)
+		targets  []peerstore.PeerInfo
+	)
 	for i, interval := range intervals {
 		repeat := w.maxRepeat - i/w.repeatDecayStep
 		if repeat <= 0 {
 			repeat = 1
 		}
+		ranked := w.scorer.Rank(peers, interval.Start, repeat)
+		if repeat > len(ranked) {
+			repeat = len(ranked)
+		}
 		for j := 0; j < repeat; j++ {
-			rrIdx := rand.Intn(len(peers))
-			p := peers[rrIdx]
-			if err := w.unicastHandler(ctx, p, &iotexrpc.BlockSync{
-				Start: interval.Start, End: interval.End,
-			}); err != nil {
-				log.L().Debug("Failed to sync block.", zap.Error(err))
+			p := ranked[(i*repeat+j)%len(ranked)]
+			name := p.ID.Pretty()
+			if _, ok := requests[name]; !ok {
+				targets = append(targets, p)
 			}
+			requests[name] = append(requests[name], interval)
 		}
 	}
+	return targets, requests
+}
+
+// request sends the requests of the intervals to the targets in parallel, the pending requests recorded for a target
+// reach the end of its last interval or the end of the request sent to it beforehand, whichever is higher
+func (w *syncWorker) request(
+	ctx context.Context,
+	targets []peerstore.PeerInfo,
+	requests map[string][]syncBlocksInterval,
+	sentEnds map[string]uint64,
+	msg func(syncBlocksInterval) proto.Message,
+) {
+	var wg sync.WaitGroup
+	for _, p := range targets {
+		reqs := requests[p.ID.Pretty()]
+		end := reqs[len(reqs)-1].End
+		if e, ok := sentEnds[p.ID.Pretty()]; ok && e > end {
+			end = e
+		}
+		w.scorer.Requested(p, end, time.Now())
+		wg.Add(1)
+		go func(p peerstore.PeerInfo, reqs []syncBlocksInterval) {
+			defer wg.Done()
+			for _, interval := range reqs {
+				if err := w.unicastHandler(ctx, p, msg(interval)); err != nil {
+					log.L().Debug("Failed to sync block.", zap.Error(err))
+				}
+			}
+		}(p, reqs)
+	}
+	wg.Wait()
 }
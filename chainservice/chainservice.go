@@ -101,8 +101,12 @@ func New(
 		indexer            blockindex.Indexer
 		candidateIndexer   *poll.CandidateIndexer
 		candBucketsIndexer *staking.CandidatesBucketsIndexer
-		err                error
-		ops                optionParams
+		// bucketHistoryIndexer and rewardHistoryIndexer are started and stopped by the block dao
+		bucketHistoryIndexer *staking.BucketHistoryIndexer
+		rewardHistoryIndexer *rewarding.RewardHistoryIndexer
+		rDPoSProtocol        *rolldpos.Protocol
+		err                  error
+		ops                  optionParams
 	)
 	for _, opt := range opts {
 		if err = opt(&ops); err != nil {
@@ -157,10 +161,18 @@ func New(
 			}
 		}
 	}
+	if cfg.Consensus.Scheme == config.RollDPoSScheme {
+		rDPoSProtocol = rolldpos.NewProtocol(
+			cfg.Genesis.NumCandidateDelegates,
+			cfg.Genesis.NumDelegates,
+			cfg.Genesis.NumSubEpochs,
+			rolldpos.EnableDardanellesSubEpoch(cfg.Genesis.DardanellesBlockHeight, cfg.Genesis.DardanellesNumSubEpochs),
+		)
+	}
 	_, gateway := cfg.Plugins[config.GatewayPlugin]
 	if gateway {
 		cfg.DB.DbPath = cfg.Chain.IndexDBPath
-		indexer, err = blockindex.NewIndexer(db.NewBoltDB(cfg.DB), cfg.Genesis.Hash())
+		indexer, err = blockindex.NewIndexer(db.NewKVStore(cfg.DB), cfg.Genesis.Hash())
 		if err != nil {
 			return nil, err
 		}
@@ -169,16 +181,33 @@ func New(
 		}
 		// create candidate indexer
 		cfg.DB.DbPath = cfg.Chain.CandidateIndexDBPath
-		candidateIndexer, err = poll.NewCandidateIndexer(db.NewBoltDB(cfg.DB))
+		candidateIndexer, err = poll.NewCandidateIndexer(db.NewKVStore(cfg.DB))
 		if err != nil {
 			return nil, err
 		}
 		if cfg.Chain.EnableStakingIndexer {
 			cfg.DB.DbPath = cfg.Chain.StakingIndexDBPath
-			candBucketsIndexer, err = staking.NewStakingCandidatesBucketsIndexer(db.NewBoltDB(cfg.DB))
+			candBucketsIndexer, err = staking.NewStakingCandidatesBucketsIndexer(db.NewKVStore(cfg.DB))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if cfg.Chain.EnableStakingHistoryIndexer {
+			cfg.DB.DbPath = cfg.Chain.StakingHistoryIndexDBPath
+			bucketHistoryIndexer, err = staking.NewBucketHistoryIndexer(db.NewKVStore(cfg.DB))
+			if err != nil {
+				return nil, err
+			}
+			indexers = append(indexers, bucketHistoryIndexer)
+		}
+		// epochs of the rewards are only defined with rolldpos
+		if cfg.Chain.EnableRewardHistoryIndexer && rDPoSProtocol != nil {
+			cfg.DB.DbPath = cfg.Chain.RewardHistoryIndexDBPath
+			rewardHistoryIndexer, err = rewarding.NewRewardHistoryIndexer(db.NewKVStore(cfg.DB), rDPoSProtocol)
 			if err != nil {
 				return nil, err
 			}
+			indexers = append(indexers, rewardHistoryIndexer)
 		}
 	}
 
@@ -233,13 +262,12 @@ func New(
 		}),
 	}
 	var (
-		rDPoSProtocol   *rolldpos.Protocol
 		pollProtocol    poll.Protocol
 		stakingProtocol *staking.Protocol
 	)
 	// staking protocol need to be put in registry before poll protocol when enabling
 	if cfg.Chain.EnableStakingProtocol {
-		stakingProtocol, err = staking.NewProtocol(rewarding.DepositGas, cfg.Genesis.Staking, candBucketsIndexer, cfg.Genesis.GreenlandBlockHeight)
+		stakingProtocol, err = staking.NewProtocol(rewarding.DepositGas, cfg.Genesis.Staking, candBucketsIndexer, bucketHistoryIndexer, cfg.Genesis.GreenlandBlockHeight)
 		if err != nil {
 			return nil, err
 		}
@@ -248,12 +276,6 @@ func New(
 		}
 	}
 	if cfg.Consensus.Scheme == config.RollDPoSScheme {
-		rDPoSProtocol = rolldpos.NewProtocol(
-			cfg.Genesis.NumCandidateDelegates,
-			cfg.Genesis.NumDelegates,
-			cfg.Genesis.NumSubEpochs,
-			rolldpos.EnableDardanellesSubEpoch(cfg.Genesis.DardanellesBlockHeight, cfg.Genesis.DardanellesNumSubEpochs),
-		)
 		copts = append(copts, consensus.WithRollDPoSProtocol(rDPoSProtocol))
 		pollProtocol, err = poll.NewProtocol(
 			cfg,
@@ -308,6 +330,7 @@ func New(
 	rewardingProtocol := rewarding.NewProtocol(
 		cfg.Genesis.FoundationBonusP2StartEpoch,
 		cfg.Genesis.FoundationBonusP2EndEpoch,
+		rewarding.WithRewardHistoryIndexer(rewardHistoryIndexer),
 	)
 	// TODO: explorer dependency deleted at #1085, need to revive by migrating to api
 	consensus, err := consensus.NewConsensus(cfg, chain, sf, copts...)
@@ -324,6 +347,7 @@ func New(
 			return p2pAgent.UnicastOutbound(ctx, peer, msg)
 		}),
 		blocksync.WithNeighbors(p2pAgent.Neighbors),
+		blocksync.WithBlockPeer(p2pAgent.BlockPeer),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create blockSyncer")
@@ -343,6 +367,7 @@ func New(
 			return p2pAgent.BroadcastOutbound(ctx, msg)
 		}),
 		api.WithNativeElection(electionCommittee),
+		api.WithConsensus(consensus),
 	)
 	if err != nil {
 		return nil, err
@@ -411,6 +436,9 @@ func (cs *ChainService) Start(ctx context.Context) error {
 	if err := cs.chain.Start(ctx); err != nil {
 		return errors.Wrap(err, "error when starting blockchain")
 	}
+	if err := cs.actpool.Start(cs.actionContext(ctx)); err != nil {
+		return errors.Wrap(err, "error when starting actpool")
+	}
 	if err := cs.consensus.Start(ctx); err != nil {
 		return errors.Wrap(err, "error when starting consensus")
 	}
@@ -454,6 +482,9 @@ func (cs *ChainService) Stop(ctx context.Context) error {
 	if err := cs.blocksync.Stop(ctx); err != nil {
 		return errors.Wrap(err, "error when stopping blocksync")
 	}
+	if err := cs.actpool.Stop(ctx); err != nil {
+		return errors.Wrap(err, "error when stopping actpool")
+	}
 	if err := cs.chain.Stop(ctx); err != nil {
 		return errors.Wrap(err, "error when stopping blockchain")
 	}
@@ -479,14 +510,23 @@ func (cs *ChainService) HandleAction(ctx context.Context, actPb *iotextypes.Acti
 	if err := act.LoadProto(actPb); err != nil {
 		return err
 	}
-	ctx = protocol.WithRegistry(ctx, cs.registry)
-	err := cs.actpool.Add(ctx, act)
+	err := cs.actpool.Add(cs.actionContext(ctx), act)
 	if err != nil {
 		log.L().Debug(err.Error())
 	}
 	return err
 }
 
+// actionContext returns the context to validate actions at the tip of the chain
+func (cs *ChainService) actionContext(ctx context.Context) context.Context {
+	ctx = protocol.WithRegistry(ctx, cs.registry)
+	return protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{
+		Genesis: cs.chain.Genesis(),
+		ChainID: cs.chain.ChainID(),
+		Tip:     protocol.TipInfo{Height: cs.chain.TipHeight()},
+	})
+}
+
 // HandleBlock handles incoming block request.
 func (cs *ChainService) HandleBlock(ctx context.Context, pbBlock *iotextypes.Block) error {
 	blk := &block.Block{}
@@ -506,10 +546,20 @@ func (cs *ChainService) HandleBlockSync(ctx context.Context, pbBlock *iotextypes
 }
 
 // HandleSyncRequest handles incoming sync request.
-func (cs *ChainService) HandleSyncRequest(ctx context.Context, peer peerstore.PeerInfo, sync *iotexrpc.BlockSync) error {
+func (cs *ChainService) HandleSyncRequest(ctx context.Context, peer peerstore.PeerInfo, sync proto.Message) error {
 	return cs.blocksync.ProcessSyncRequest(ctx, peer, sync)
 }
 
+// HandleBlockHeaders handles incoming block headers in header-first sync.
+func (cs *ChainService) HandleBlockHeaders(ctx context.Context, headers *iotexrpc.BlockHeaders) error {
+	return cs.blocksync.ProcessBlockHeaders(ctx, headers)
+}
+
+// HandleBlockBodies handles incoming block bodies in header-first sync.
+func (cs *ChainService) HandleBlockBodies(ctx context.Context, bodies *iotexrpc.BlockBodies) error {
+	return cs.blocksync.ProcessBlockBodies(ctx, bodies)
+}
+
 // HandleConsensusMsg handles incoming consensus message.
 func (cs *ChainService) HandleConsensusMsg(msg *iotextypes.ConsensusMessage) error {
 	return cs.consensus.HandleConsensusMsg(msg)
@@ -86,6 +86,13 @@ const (
 	SigP256sm2 = "p256sm2"
 )
 
+const (
+	// BoltDBType is the B+tree based bolt DB engine
+	BoltDBType = "boltdb"
+	// LevelDBType is the LSM-tree based level DB engine
+	LevelDBType = "leveldb"
+)
+
 var (
 	// Default is the default config
 	Default = Config{
@@ -103,17 +110,19 @@ var (
 			PrivateNetworkPSK: "",
 		},
 		Chain: Chain{
-			ChainDBPath:          "/var/data/chain.db",
-			TrieDBPath:           "/var/data/trie.db",
-			IndexDBPath:          "/var/data/index.db",
-			CandidateIndexDBPath: "/var/data/candidate.index.db",
-			StakingIndexDBPath:   "/var/data/staking.index.db",
-			ID:                   1,
-			Address:              "",
-			ProducerPrivKey:      generateRandomKey(SigP256k1),
-			SignatureScheme:      []string{SigP256k1},
-			EmptyGenesis:         false,
-			GravityChainDB:       DB{DbPath: "/var/data/poll.db", NumRetries: 10},
+			ChainDBPath:               "/var/data/chain.db",
+			TrieDBPath:                "/var/data/trie.db",
+			IndexDBPath:               "/var/data/index.db",
+			CandidateIndexDBPath:      "/var/data/candidate.index.db",
+			StakingIndexDBPath:        "/var/data/staking.index.db",
+			StakingHistoryIndexDBPath: "/var/data/staking.history.index.db",
+			RewardHistoryIndexDBPath:  "/var/data/reward.history.index.db",
+			ID:                        1,
+			Address:                   "",
+			ProducerPrivKey:           generateRandomKey(SigP256k1),
+			SignatureScheme:           []string{SigP256k1},
+			EmptyGenesis:              false,
+			GravityChainDB:            DB{DbPath: "/var/data/poll.db", NumRetries: 10},
 			Committee: committee.Config{
 				GravityChainAPIs: []string{},
 			},
@@ -122,6 +131,8 @@ var (
 			EnableSystemLogIndexer:        false,
 			EnableStakingProtocol:         true,
 			EnableStakingIndexer:          false,
+			EnableStakingHistoryIndexer:   false,
+			EnableRewardHistoryIndexer:    false,
 			CompressBlock:                 false,
 			AllowedBlockGasResidue:        10000,
 			MaxCacheSize:                  0,
@@ -130,12 +141,14 @@ var (
 			EnableArchiveMode:             false,
 		},
 		ActPool: ActPool{
-			MaxNumActsPerPool:  32000,
-			MaxGasLimitPerPool: 320000000,
-			MaxNumActsPerAcct:  2000,
-			ActionExpiry:       10 * time.Minute,
-			MinGasPriceStr:     big.NewInt(unit.Qev).String(),
-			BlackList:          []string{},
+			MaxNumActsPerPool:   32000,
+			MaxGasLimitPerPool:  320000000,
+			MaxNumActsPerAcct:   2000,
+			ActionExpiry:        10 * time.Minute,
+			MinGasPriceStr:      big.NewInt(unit.Qev).String(),
+			BlackList:           []string{},
+			GasPriceBumpPercent: 10,
+			MaxAcctSharePercent: 5,
 		},
 		Consensus: Consensus{
 			Scheme: StandaloneScheme,
@@ -185,6 +198,7 @@ var (
 			SystemLogDBPath:       "/var/data/systemlog.db",
 		},
 		DB: DB{
+			DBType:              BoltDBType,
 			NumRetries:          3,
 			MaxCacheSize:        64,
 			BlockStoreBatchSize: 16,
@@ -195,7 +209,9 @@ var (
 			},
 			SplitDBSizeMB:         0,
 			SplitDBHeight:         900000,
-			HistoryStateRetention: 2000,
+			HistoryStateRetention: 0,
+			PruneKeepBlocks:       0,
+			PruneBelowHeight:      0,
 		},
 		Genesis: genesis.Default,
 	}
@@ -207,6 +223,8 @@ var (
 	Validates = []Validate{
 		ValidateRollDPoS,
 		ValidateArchiveMode,
+		ValidateBlockPruning,
+		ValidateDBType,
 		ValidateDispatcher,
 		ValidateAPI,
 		ValidateActPool,
@@ -233,18 +251,20 @@ type (
 
 	// Chain is the config struct for blockchain package
 	Chain struct {
-		ChainDBPath          string           `yaml:"chainDBPath"`
-		TrieDBPath           string           `yaml:"trieDBPath"`
-		IndexDBPath          string           `yaml:"indexDBPath"`
-		CandidateIndexDBPath string           `yaml:"candidateIndexDBPath"`
-		StakingIndexDBPath   string           `yaml:"stakingIndexDBPath"`
-		ID                   uint32           `yaml:"id"`
-		Address              string           `yaml:"address"`
-		ProducerPrivKey      string           `yaml:"producerPrivKey"`
-		SignatureScheme      []string         `yaml:"signatureScheme"`
-		EmptyGenesis         bool             `yaml:"emptyGenesis"`
-		GravityChainDB       DB               `yaml:"gravityChainDB"`
-		Committee            committee.Config `yaml:"committee"`
+		ChainDBPath               string           `yaml:"chainDBPath"`
+		TrieDBPath                string           `yaml:"trieDBPath"`
+		IndexDBPath               string           `yaml:"indexDBPath"`
+		CandidateIndexDBPath      string           `yaml:"candidateIndexDBPath"`
+		StakingIndexDBPath        string           `yaml:"stakingIndexDBPath"`
+		StakingHistoryIndexDBPath string           `yaml:"stakingHistoryIndexDBPath"`
+		RewardHistoryIndexDBPath  string           `yaml:"rewardHistoryIndexDBPath"`
+		ID                        uint32           `yaml:"id"`
+		Address                   string           `yaml:"address"`
+		ProducerPrivKey           string           `yaml:"producerPrivKey"`
+		SignatureScheme           []string         `yaml:"signatureScheme"`
+		EmptyGenesis              bool             `yaml:"emptyGenesis"`
+		GravityChainDB            DB               `yaml:"gravityChainDB"`
+		Committee                 committee.Config `yaml:"committee"`
 
 		EnableTrielessStateDB bool `yaml:"enableTrielessStateDB"`
 		// EnableArchiveMode is only meaningful when EnableTrielessStateDB is false
@@ -257,6 +277,10 @@ type (
 		EnableStakingProtocol bool `yaml:"enableStakingProtocol"`
 		// EnableStakingIndexer enables staking indexer
 		EnableStakingIndexer bool `yaml:"enableStakingIndexer"`
+		// EnableStakingHistoryIndexer enables indexing the events of vote buckets
+		EnableStakingHistoryIndexer bool `yaml:"enableStakingHistoryIndexer"`
+		// EnableRewardHistoryIndexer enables indexing the rewards granted to and claimed by accounts
+		EnableRewardHistoryIndexer bool `yaml:"enableRewardHistoryIndexer"`
 		// deprecated by DB.CompressBlock
 		CompressBlock bool `yaml:"compressBlock"`
 		// AllowedBlockGasResidue is the amount of gas remained when block producer could stop processing more actions
@@ -286,6 +310,8 @@ type (
 		MaxRepeat int `yaml:"maxRepeat"`
 		// RepeatDecayStep is the step for repeat number decreasing by 1
 		RepeatDecayStep int `yaml:"repeatDecayStep"`
+		// HeaderFirst enables syncing the header chain before downloading the block bodies from the peers in parallel
+		HeaderFirst bool `yaml:"headerFirst"`
 	}
 
 	// RollDPoS is the config struct for RollDPoS consensus package
@@ -315,8 +341,10 @@ type (
 
 	// API is the api service config
 	API struct {
-		UseRDS          bool       `yaml:"useRDS"`
-		Port            int        `yaml:"port"`
+		UseRDS bool `yaml:"useRDS"`
+		Port   int  `yaml:"port"`
+		// Web3Port is the port of the Ethereum JSON-RPC server, which is disabled if it is 0
+		Web3Port        int        `yaml:"web3Port"`
 		TpsWindow       int        `yaml:"tpsWindow"`
 		GasStation      GasStation `yaml:"gasStation"`
 		RangeQueryLimit uint64     `yaml:"rangeQueryLimit"`
@@ -356,11 +384,23 @@ type (
 		MinGasPriceStr string `yaml:"minGasPrice"`
 		// BlackList lists the account address that are banned from initiating actions
 		BlackList []string `yaml:"blackList"`
+		// GasPriceBumpPercent is the minimal percentage by which the gas price of an action has to be higher than
+		// the pending action of the same sender and nonce to replace it
+		GasPriceBumpPercent uint64 `yaml:"gasPriceBumpPercent"`
+		// MaxAcctSharePercent is the maximum percentage of MaxNumActsPerPool an account can hold in the pool, such that
+		// a single sender cannot flood the pool no matter how high its gas price is. 0 means no limit
+		MaxAcctSharePercent uint64 `yaml:"maxAcctSharePercent"`
+		// JournalPath is the path of the journal file, which records the locally received actions to be replayed into
+		// the pool on restart. The journal is disabled if it is empty
+		JournalPath string `yaml:"journalPath"`
 	}
 
 	// DB is the config for database
 	DB struct {
 		DbPath string `yaml:"dbPath"`
+		// DBType is the KV store engine of the state DB and index DB files, boltdb or leveldb. The chain DB files
+		// always use boltdb
+		DBType string `yaml:"dbType"`
 		// NumRetries is the number of retries
 		NumRetries uint8 `yaml:"numRetries"`
 		// MaxCacheSize is the max number of blocks that will be put into an LRU cache. 0 means disabled
@@ -379,8 +419,15 @@ type (
 		SplitDBSizeMB uint64 `yaml:"splitDBSizeMB"`
 		// SplitDBHeight is the config for DB's split start height
 		SplitDBHeight uint64 `yaml:"splitDBHeight"`
-		// HistoryStateRetention is the number of blocks account/contract state will be retained
+		// HistoryStateRetention is the number of blocks account/contract state will be retained in archive mode,
+		// 0 means all history states are retained
 		HistoryStateRetention uint64 `yaml:"historyStateRetention"`
+		// PruneKeepBlocks is the number of most recent blocks retained in the split DB files, older files are deleted,
+		// 0 means the blocks are not pruned by number
+		PruneKeepBlocks uint64 `yaml:"pruneKeepBlocks"`
+		// PruneBelowHeight is the height below which the split DB files are deleted, 0 means the blocks are not pruned
+		// by height
+		PruneBelowHeight uint64 `yaml:"pruneBelowHeight"`
 	}
 
 	// RDS is the cloud rds config
@@ -429,6 +476,11 @@ func (db DB) SplitDBSize() uint64 {
 	return db.SplitDBSizeMB * 1024 * 1024
 }
 
+// EnableBlockPruning returns whether the old blocks are pruned
+func (db DB) EnableBlockPruning() bool {
+	return db.PruneKeepBlocks > 0 || db.PruneBelowHeight > 0
+}
+
 // New creates a config instance. It first loads the default configs. If the config path is not empty, it will read from
 // the file and override the default configs. By default, it will apply all validation functions. To bypass validation,
 // use DoNotValidate instead.
@@ -616,6 +668,30 @@ func ValidateArchiveMode(cfg Config) error {
 	return errors.Wrap(ErrInvalidCfg, "Archive mode is incompatible with trieless state DB")
 }
 
+// ValidateBlockPruning validates the block pruning setting
+func ValidateBlockPruning(cfg Config) error {
+	if !cfg.DB.EnableBlockPruning() {
+		return nil
+	}
+	if cfg.Chain.EnableArchiveMode {
+		return errors.Wrap(ErrInvalidCfg, "block pruning is incompatible with archive mode")
+	}
+	if cfg.DB.SplitDBSizeMB == 0 {
+		return errors.Wrap(ErrInvalidCfg, "block pruning requires split DB files")
+	}
+	return nil
+}
+
+// ValidateDBType validates the KV store engine
+func ValidateDBType(cfg Config) error {
+	switch cfg.DB.DBType {
+	case BoltDBType, LevelDBType:
+		return nil
+	default:
+		return errors.Wrapf(ErrInvalidCfg, "unknown DB type %s", cfg.DB.DBType)
+	}
+}
+
 // ValidateAPI validates the api configs
 func ValidateAPI(cfg Config) error {
 	if cfg.API.TpsWindow <= 0 {
@@ -640,6 +716,9 @@ func ValidateActPool(cfg Config) error {
 			"maximum number of actions per pool cannot be less than maximum number of actions per account",
 		)
 	}
+	if cfg.ActPool.MaxAcctSharePercent > 100 {
+		return errors.Wrap(ErrInvalidCfg, "maximum share of an account in pool cannot be more than 100 percent")
+	}
 	return nil
 }
 
@@ -665,6 +744,10 @@ func ValidateForkHeights(cfg Config) error {
 		return errors.Wrap(ErrInvalidCfg, "FairbankMigration is heigher than Fairbank")
 	case hu.FairbankBlockHeight() > hu.GreenlandBlockHeight():
 		return errors.Wrap(ErrInvalidCfg, "Fairbank is heigher than Greenland")
+	case hu.GreenlandBlockHeight() > hu.HawaiiBlockHeight():
+		return errors.Wrap(ErrInvalidCfg, "Greenland is heigher than Hawaii")
+	case hu.HawaiiBlockHeight() > hu.IcelandBlockHeight():
+		return errors.Wrap(ErrInvalidCfg, "Hawaii is heigher than Iceland")
 	}
 	return nil
 }
@@ -253,6 +253,35 @@ func TestValidateArchiveMode(t *testing.T) {
 	require.NoError(t, errors.Cause(ValidateArchiveMode(cfg)))
 }
 
+func TestValidateBlockPruning(t *testing.T) {
+	require := require.New(t)
+	cfg := Default
+	require.NoError(ValidateBlockPruning(cfg))
+	cfg.DB.PruneKeepBlocks = 1000
+	require.EqualError(ValidateBlockPruning(cfg), "block pruning requires split DB files: invalid config value")
+	cfg.DB.SplitDBSizeMB = 100
+	require.NoError(ValidateBlockPruning(cfg))
+	cfg.DB.PruneKeepBlocks = 0
+	cfg.DB.PruneBelowHeight = 1000
+	require.NoError(ValidateBlockPruning(cfg))
+	cfg.Chain.EnableArchiveMode = true
+	err := ValidateBlockPruning(cfg)
+	require.Equal(ErrInvalidCfg, errors.Cause(err))
+	require.EqualError(err, "block pruning is incompatible with archive mode: invalid config value")
+}
+
+func TestValidateDBType(t *testing.T) {
+	require := require.New(t)
+	cfg := Default
+	require.NoError(ValidateDBType(cfg))
+	cfg.DB.DBType = LevelDBType
+	require.NoError(ValidateDBType(cfg))
+	cfg.DB.DBType = "rocksdb"
+	err := ValidateDBType(cfg)
+	require.Equal(ErrInvalidCfg, errors.Cause(err))
+	require.EqualError(err, "unknown DB type rocksdb: invalid config value")
+}
+
 func TestValidateActPool(t *testing.T) {
 	cfg := Default
 	cfg.ActPool.MaxNumActsPerAcct = 0
@@ -291,6 +320,19 @@ func TestValidateActPool(t *testing.T) {
 			"maximum number of actions per pool cannot be less than maximum number of actions per account",
 		),
 	)
+
+	cfg.ActPool.MaxNumActsPerPool = 100
+	cfg.ActPool.MaxAcctSharePercent = 101
+	err = ValidateActPool(cfg)
+	require.Error(t, err)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(
+		t,
+		strings.Contains(
+			err.Error(),
+			"maximum share of an account in pool cannot be more than 100 percent",
+		),
+	)
 }
 
 func TestValidateMinGasPrice(t *testing.T) {
@@ -347,6 +389,12 @@ func TestValidateForkHeights(t *testing.T) {
 		{
 			"Fairbank", ErrInvalidCfg, "Fairbank is heigher than Greenland",
 		},
+		{
+			"Greenland", ErrInvalidCfg, "Greenland is heigher than Hawaii",
+		},
+		{
+			"Hawaii", ErrInvalidCfg, "Hawaii is heigher than Iceland",
+		},
 		{
 			"", nil, "",
 		},
@@ -383,6 +431,10 @@ func newTestCfg(fork string) Config {
 		cfg.Genesis.FbkMigrationBlockHeight = cfg.Genesis.FairbankBlockHeight + 1
 	case "Fairbank":
 		cfg.Genesis.FairbankBlockHeight = cfg.Genesis.GreenlandBlockHeight + 1
+	case "Greenland":
+		cfg.Genesis.GreenlandBlockHeight = cfg.Genesis.HawaiiBlockHeight + 1
+	case "Hawaii":
+		cfg.Genesis.HawaiiBlockHeight = cfg.Genesis.IcelandBlockHeight + 1
 	}
 	return cfg
 }
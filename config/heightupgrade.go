@@ -24,6 +24,8 @@ const (
 	Fairbank
 	FbkMigration
 	Greenland
+	Hawaii
+	Iceland
 )
 
 type (
@@ -47,6 +49,8 @@ type (
 		fairbankHeight     uint64
 		fbkMigrationHeight uint64
 		greanlandHeight    uint64
+		hawaiiHeight       uint64
+		icelandHeight      uint64
 	}
 )
 
@@ -63,6 +67,8 @@ func NewHeightUpgrade(cfg *genesis.Genesis) HeightUpgrade {
 		cfg.FairbankBlockHeight,
 		cfg.FbkMigrationBlockHeight,
 		cfg.GreenlandBlockHeight,
+		cfg.HawaiiBlockHeight,
+		cfg.IcelandBlockHeight,
 	}
 }
 
@@ -90,6 +96,10 @@ func (hu *HeightUpgrade) IsPost(name HeightName, height uint64) bool {
 		h = hu.fbkMigrationHeight
 	case Greenland:
 		h = hu.greanlandHeight
+	case Hawaii:
+		h = hu.hawaiiHeight
+	case Iceland:
+		h = hu.icelandHeight
 	default:
 		log.Panic("invalid height name!")
 	}
@@ -130,3 +140,9 @@ func (hu *HeightUpgrade) FbkMigrationBlockHeight() uint64 { return hu.fbkMigrati
 
 // GreenlandBlockHeight returns the greenland height
 func (hu *HeightUpgrade) GreenlandBlockHeight() uint64 { return hu.greanlandHeight }
+
+// HawaiiBlockHeight returns the hawaii height
+func (hu *HeightUpgrade) HawaiiBlockHeight() uint64 { return hu.hawaiiHeight }
+
+// IcelandBlockHeight returns the iceland height
+func (hu *HeightUpgrade) IcelandBlockHeight() uint64 { return hu.icelandHeight }
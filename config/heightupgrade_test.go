@@ -25,6 +25,8 @@ func TestNewHeightChange(t *testing.T) {
 	require.Equal(7, Fairbank)
 	require.Equal(8, FbkMigration)
 	require.Equal(9, Greenland)
+	require.Equal(10, Hawaii)
+	require.Equal(11, Iceland)
 
 	cfg := Default
 	cfg.Genesis.PacificBlockHeight = uint64(432001)
@@ -50,6 +52,10 @@ func TestNewHeightChange(t *testing.T) {
 	require.True(hu.IsPost(FbkMigration, uint64(5157001)))
 	require.True(hu.IsPre(Greenland, uint64(6544440)))
 	require.True(hu.IsPost(Greenland, uint64(6544441)))
+	require.True(hu.IsPre(Hawaii, uint64(11267640)))
+	require.True(hu.IsPost(Hawaii, uint64(11267641)))
+	require.True(hu.IsPre(Iceland, uint64(12289320)))
+	require.True(hu.IsPost(Iceland, uint64(12289321)))
 	require.Panics(func() {
 		hu.IsPost(-1, 0)
 	})
@@ -64,4 +70,6 @@ func TestNewHeightChange(t *testing.T) {
 	require.Equal(hu.FairbankBlockHeight(), uint64(5165641))
 	require.Equal(hu.FbkMigrationBlockHeight(), uint64(5157001))
 	require.Equal(hu.GreenlandBlockHeight(), uint64(6544441))
+	require.Equal(hu.HawaiiBlockHeight(), uint64(11267641))
+	require.Equal(hu.IcelandBlockHeight(), uint64(12289321))
 }
@@ -36,6 +36,8 @@ type Consensus interface {
 	Calibrate(uint64)
 	ValidateBlockFooter(*block.Block) error
 	Metrics() (scheme.ConsensusMetrics, error)
+	RoundLogs() ([]scheme.RoundLog, error)
+	AddRoundLogSubscriber(scheme.RoundLogSubscriber) error
 	Activate(bool)
 	Active() bool
 }
@@ -95,7 +97,6 @@ func NewConsensus(
 
 	clock := clock.New()
 	cs := &IotxConsensus{cfg: cfg.Consensus}
-	var err error
 	switch cfg.Consensus.Scheme {
 	case config.RollDPoSScheme:
 		bd := rolldpos.NewRollDPoSBuilder().
@@ -139,10 +140,15 @@ func NewConsensus(
 			}).
 			RegisterProtocol(ops.rp)
 		// TODO: explorer dependency deleted here at #1085, need to revive by migrating to api
-		cs.scheme, err = bd.Build()
+		r, err := bd.Build()
 		if err != nil {
 			log.Logger("consensus").Panic("Error when constructing RollDPoS.", zap.Error(err))
 		}
+		// the block producer puts the double sign evidences detected by consensus on chain
+		if reporter, ok := ops.pp.(poll.DoubleSignEvidenceReporter); ok {
+			reporter.SetDoubleSignEvidences(r.DoubleSignEvidences)
+		}
+		cs.scheme = r
 	case config.NOOPScheme:
 		cs.scheme = scheme.NewNoop()
 	case config.StandaloneScheme:
@@ -211,6 +217,16 @@ func (c *IotxConsensus) Metrics() (scheme.ConsensusMetrics, error) {
 	return c.scheme.Metrics()
 }
 
+// RoundLogs returns the logs of the recent consensus rounds
+func (c *IotxConsensus) RoundLogs() ([]scheme.RoundLog, error) {
+	return c.scheme.RoundLogs()
+}
+
+// AddRoundLogSubscriber adds a subscriber to the logs of the consensus rounds
+func (c *IotxConsensus) AddRoundLogSubscriber(s scheme.RoundLogSubscriber) error {
+	return c.scheme.AddRoundLogSubscriber(s)
+}
+
 // HandleConsensusMsg handles consensus messages
 func (c *IotxConsensus) HandleConsensusMsg(msg *iotextypes.ConsensusMessage) error {
 	return c.scheme.HandleConsensusMsg(msg)
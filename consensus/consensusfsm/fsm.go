@@ -86,14 +86,18 @@ var (
 	}
 )
 
+// TransitionObserver is called after each state transition of the fsm
+type TransitionObserver func(src fsm.State, dst fsm.State, et fsm.EventType)
+
 // ConsensusFSM wraps over the general purpose FSM and implements the consensus logic
 type ConsensusFSM struct {
-	fsm   fsm.FSM
-	evtq  chan *ConsensusEvent
-	close chan interface{}
-	clock clock.Clock
-	ctx   Context
-	wg    sync.WaitGroup
+	fsm      fsm.FSM
+	evtq     chan *ConsensusEvent
+	close    chan interface{}
+	clock    clock.Clock
+	ctx      Context
+	wg       sync.WaitGroup
+	observer TransitionObserver
 }
 
 // NewConsensusFSM returns a new fsm
@@ -215,6 +219,11 @@ func NewConsensusFSM(ctx Context, clock clock.Clock) (*ConsensusFSM, error) {
 	return cm, nil
 }
 
+// SetTransitionObserver sets the observer of the state transitions, it should be set before the fsm starts
+func (m *ConsensusFSM) SetTransitionObserver(observer TransitionObserver) {
+	m.observer = observer
+}
+
 // Start starts the fsm and get in initial state
 func (m *ConsensusFSM) Start(c context.Context) error {
 	m.wg.Add(1)
@@ -338,6 +347,9 @@ func (m *ConsensusFSM) handle(evt *ConsensusEvent) error {
 			zap.String("evt", string(evt.Type())),
 		)
 		consensusEvtsMtc.WithLabelValues(string(evt.Type()), "consumed").Inc()
+		if m.observer != nil {
+			m.observer(src, m.fsm.CurrentState(), evt.Type())
+		}
 	case fsm.ErrTransitionNotFound:
 		if m.ctx.IsStaleUnmatchedEvent(evt) {
 			consensusEvtsMtc.WithLabelValues(string(evt.Type()), "stale").Inc()
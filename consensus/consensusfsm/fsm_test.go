@@ -8,6 +8,7 @@ package consensusfsm
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -43,10 +44,23 @@ func TestBackdoorEvt(t *testing.T) {
 	require.NoError(err)
 	require.NotNil(cfsm)
 	require.Equal(sPrepare, cfsm.CurrentState())
+	var (
+		mu  sync.Mutex
+		dst []fsm.State
+	)
+	cfsm.SetTransitionObserver(func(_ fsm.State, s fsm.State, et fsm.EventType) {
+		require.Equal(BackdoorEvent, et)
+		mu.Lock()
+		dst = append(dst, s)
+		mu.Unlock()
+	})
 
 	require.NoError(cfsm.Start(context.Background()))
 	defer func() {
 		require.NoError(cfsm.Stop(context.Background()))
+		mu.Lock()
+		require.Equal(consensusStates, dst)
+		mu.Unlock()
 	}()
 
 	for _, state := range consensusStates {
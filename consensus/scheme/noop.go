@@ -54,6 +54,19 @@ func (n *Noop) Metrics() (ConsensusMetrics, error) {
 	)
 }
 
+// RoundLogs is not implemented for noop scheme
+func (n *Noop) RoundLogs() ([]RoundLog, error) {
+	return nil, errors.Wrapf(
+		ErrNotImplemented,
+		"noop scheme does not supported round logs",
+	)
+}
+
+// AddRoundLogSubscriber does nothing for noop scheme, which has no consensus round to log
+func (n *Noop) AddRoundLogSubscriber(_ RoundLogSubscriber) error {
+	return nil
+}
+
 // Activate is not implemented for noop scheme
 func (n *Noop) Activate(_ bool) {
 	log.S().Warn("Noop scheme could not support activate")
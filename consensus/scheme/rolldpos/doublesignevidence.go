@@ -0,0 +1,122 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/poll"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/endorsement"
+)
+
+// ErrInvalidDoubleSignEvidence indicates that the endorsements do not prove a double sign
+var ErrInvalidDoubleSignEvidence = poll.ErrInvalidDoubleSignEvidence
+
+// DoubleSignEvidence is the proof that a delegate endorsed two different blocks at the same height on the same topic,
+// i.e., two COMMIT endorsements at the same height, or two PROPOSAL or LOCK endorsements in the same round.
+// It is put on chain by the block producers as a PutDoubleSignEvidence action, and the delegate is put on probation.
+type DoubleSignEvidence struct {
+	topic        ConsensusVoteTopic
+	headers      [2]*block.Header
+	endorsements [2]*endorsement.Endorsement
+}
+
+// NewDoubleSignEvidence creates a double sign evidence from two endorsements on the topic for the blocks
+func NewDoubleSignEvidence(
+	topic ConsensusVoteTopic,
+	header1 *block.Header,
+	en1 *endorsement.Endorsement,
+	header2 *block.Header,
+	en2 *endorsement.Endorsement,
+) *DoubleSignEvidence {
+	return &DoubleSignEvidence{
+		topic:        topic,
+		headers:      [2]*block.Header{header1, header2},
+		endorsements: [2]*endorsement.Endorsement{en1, en2},
+	}
+}
+
+// Topic returns the topic of the conflicting endorsements
+func (e *DoubleSignEvidence) Topic() ConsensusVoteTopic {
+	return e.topic
+}
+
+// Height returns the height of the endorsed blocks
+func (e *DoubleSignEvidence) Height() uint64 {
+	return e.headers[0].Height()
+}
+
+// Endorser returns the public key of the delegate who double signed
+func (e *DoubleSignEvidence) Endorser() crypto.PublicKey {
+	return e.endorsements[0].Endorser()
+}
+
+// Headers returns the headers of the endorsed blocks
+func (e *DoubleSignEvidence) Headers() []*block.Header {
+	return []*block.Header{e.headers[0], e.headers[1]}
+}
+
+// Endorsements returns the conflicting endorsements
+func (e *DoubleSignEvidence) Endorsements() []*endorsement.Endorsement {
+	return []*endorsement.Endorsement{e.endorsements[0], e.endorsements[1]}
+}
+
+// Verify checks that both endorsements are signed by the same endorser for two different valid blocks
+func (e *DoubleSignEvidence) Verify() error {
+	act, err := e.Action()
+	if err != nil {
+		return err
+	}
+	_, _, err = poll.VerifyDoubleSignEvidence(act)
+	return err
+}
+
+// Action returns the action putting the evidence on chain
+func (e *DoubleSignEvidence) Action() (*action.PutDoubleSignEvidence, error) {
+	for i := range e.headers {
+		if e.headers[i] == nil || e.endorsements[i] == nil {
+			return nil, errors.Wrap(ErrInvalidDoubleSignEvidence, "missing block header or endorsement")
+		}
+	}
+	vote, err := NewConsensusVote(nil, e.topic).Proto()
+	if err != nil {
+		return nil, err
+	}
+	headers := make([][]byte, 0, len(e.headers))
+	for _, h := range e.headers {
+		ser, err := h.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		headers = append(headers, ser)
+	}
+	return action.NewPutDoubleSignEvidence(0, vote.Topic, headers, e.Endorsements()), nil
+}
+
+// LoadAction loads the evidence from the action putting it on chain
+func (e *DoubleSignEvidence) LoadAction(act *action.PutDoubleSignEvidence) error {
+	if len(act.Headers()) != 2 || len(act.Endorsements()) != 2 {
+		return errors.Wrap(ErrInvalidDoubleSignEvidence, "missing block header or endorsement")
+	}
+	vote := &ConsensusVote{}
+	if err := vote.LoadProto(&iotextypes.ConsensusVote{Topic: act.Topic()}); err != nil {
+		return err
+	}
+	e.topic = vote.Topic()
+	for i, ser := range act.Headers() {
+		e.headers[i] = &block.Header{}
+		if err := e.headers[i].Deserialize(ser); err != nil {
+			return err
+		}
+		e.endorsements[i] = act.Endorsements()[i]
+	}
+	return nil
+}
@@ -8,12 +8,14 @@ package rolldpos
 
 import (
 	"encoding/hex"
+	"sync"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/consensus/scheme/rolldpos/endorsementpb"
 	"github.com/iotexproject/iotex-core/db"
@@ -222,6 +224,9 @@ type endorsementManager struct {
 	eManagerDB      db.KVStore
 	collections     map[string]*blockEndorsementCollection
 	cachedMintedBlk *block.Block
+	// doubleSigns is read by the block producer while minting a block, so it is guarded by its own lock
+	doubleSignsMu sync.RWMutex
+	doubleSigns   []*DoubleSignEvidence
 }
 
 func newEndorsementManager(eManagerDB db.KVStore) (*endorsementManager, error) {
@@ -296,6 +301,18 @@ func (m *endorsementManager) fromProto(managerPro *endorsementpb.EndorsementMana
 		}
 		m.cachedMintedBlk = blk
 	}
+	m.doubleSigns = nil
+	for _, evidencePb := range managerPro.DoubleSignEvidences {
+		act := &action.PutDoubleSignEvidence{}
+		if err := act.LoadProto(evidencePb); err != nil {
+			return err
+		}
+		evidence := &DoubleSignEvidence{}
+		if err := evidence.LoadAction(act); err != nil {
+			return err
+		}
+		m.doubleSigns = append(m.doubleSigns, evidence)
+	}
 	return nil
 }
 
@@ -312,6 +329,13 @@ func (m *endorsementManager) toProto() (*endorsementpb.EndorsementManager, error
 	if m.cachedMintedBlk != nil {
 		mc.CachedMintedBlk = m.cachedMintedBlk.ConvertToBlockPb()
 	}
+	for _, evidence := range m.DoubleSignEvidences() {
+		act, err := evidence.Action()
+		if err != nil {
+			return nil, err
+		}
+		mc.DoubleSignEvidences = append(mc.DoubleSignEvidences, act.Proto())
+	}
 	return mc, nil
 }
 
@@ -369,6 +393,19 @@ func (m *endorsementManager) AddVoteEndorsement(
 		return err
 	}
 	m.collections[encoded] = c
+	if evidence := m.doubleSign(vote, en); evidence != nil {
+		log.L().Warn("Delegate endorsed two different blocks.",
+			zap.String("endorser", evidence.Endorser().HexString()),
+			zap.Uint64("height", evidence.Height()),
+			zap.Uint8("topic", uint8(evidence.Topic())),
+		)
+		m.doubleSignsMu.Lock()
+		m.doubleSigns = append(m.doubleSigns, evidence)
+		m.doubleSignsMu.Unlock()
+		if m.eManagerDB != nil {
+			return m.PutEndorsementManagerToDB()
+		}
+	}
 
 	if m.eManagerDB != nil && m.isMajorityFunc != nil {
 		afterVote = m.isMajorityFunc(vote.BlockHash(), []ConsensusVoteTopic{vote.Topic()})
@@ -380,6 +417,61 @@ func (m *endorsementManager) AddVoteEndorsement(
 	return nil
 }
 
+// doubleSign returns the evidence if the endorser has endorsed another block on the same topic at the same height,
+// PROPOSAL and LOCK endorsements are only compared to the endorsements for the blocks proposed in the same round
+func (m *endorsementManager) doubleSign(
+	vote *ConsensusVote,
+	en *endorsement.Endorsement,
+) *DoubleSignEvidence {
+	c := m.CollectionByBlockHash(vote.BlockHash())
+	if c == nil || c.Block() == nil {
+		return nil
+	}
+	blk := c.Block()
+	endorser := en.Endorser().HexString()
+	for _, e := range m.DoubleSignEvidences() {
+		if e.Topic() == vote.Topic() && e.Height() == blk.Height() && e.Endorser().HexString() == endorser {
+			// already detected
+			return nil
+		}
+	}
+	for _, other := range m.collections {
+		if other == c || other.Block() == nil || other.Block().Height() != blk.Height() {
+			continue
+		}
+		if vote.Topic() != COMMIT && !other.Block().Timestamp().Equal(blk.Timestamp()) {
+			continue
+		}
+		if prev := other.Endorsement(endorser, vote.Topic()); prev != nil {
+			return NewDoubleSignEvidence(vote.Topic(), &other.Block().Header, prev, &blk.Header, en)
+		}
+	}
+	return nil
+}
+
+// DoubleSignEvidences returns the evidences of double signs detected and not pruned yet
+func (m *endorsementManager) DoubleSignEvidences() []*DoubleSignEvidence {
+	m.doubleSignsMu.RLock()
+	defer m.doubleSignsMu.RUnlock()
+	evidences := make([]*DoubleSignEvidence, len(m.doubleSigns))
+	copy(evidences, m.doubleSigns)
+	return evidences
+}
+
+// PruneDoubleSignEvidences drops the evidences of the blocks lower than the height, which cannot be put on chain
+// anymore
+func (m *endorsementManager) PruneDoubleSignEvidences(height uint64) {
+	m.doubleSignsMu.Lock()
+	defer m.doubleSignsMu.Unlock()
+	evidences := m.doubleSigns[:0]
+	for _, e := range m.doubleSigns {
+		if e.Height() >= height {
+			evidences = append(evidences, e)
+		}
+	}
+	m.doubleSigns = evidences
+}
+
 func (m *endorsementManager) SetMintedBlock(blk *block.Block) error {
 	m.cachedMintedBlk = blk
 	if m.eManagerDB != nil {
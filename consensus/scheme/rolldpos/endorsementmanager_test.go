@@ -11,8 +11,11 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/endorsement"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/test/identityset"
@@ -212,3 +215,118 @@ func TestEndorsementManagerProto(t *testing.T) {
 	require.Equal(em.collections[encoded].endorsers, em2.collections[encoded].endorsers)
 	require.Equal(em.cachedMintedBlk.HashBlock(), em2.cachedMintedBlk.HashBlock())
 }
+
+func TestEndorsementManagerDoubleSign(t *testing.T) {
+	require := require.New(t)
+	em, err := newEndorsementManager(nil)
+	require.NoError(err)
+
+	newBlock := func(height uint64, ts time.Time, prevHash string) *block.Block {
+		blk, err := block.NewBuilder(block.NewRunnableActionsBuilder().Build()).
+			SetHeight(height).
+			SetTimestamp(ts).
+			SetPrevBlockHash(hash.Hash256b([]byte(prevHash))).
+			SignAndBuild(identityset.PrivateKey(1))
+		require.NoError(err)
+		return &blk
+	}
+	endorse := func(blk *block.Block, topic ConsensusVoteTopic, ts time.Time) (*ConsensusVote, *endorsement.Endorsement) {
+		blkHash := blk.HashBlock()
+		vote := NewConsensusVote(blkHash[:], topic)
+		en, err := endorsement.Endorse(identityset.PrivateKey(2), vote, ts)
+		require.NoError(err)
+		return vote, en
+	}
+	now := time.Now()
+	roundStart := now.Add(-10 * time.Second)
+	blk1 := newBlock(10, roundStart, "a")
+	blk2 := newBlock(10, roundStart, "b")
+	blk3 := newBlock(10, now, "c")
+	for _, blk := range []*block.Block{blk1, blk2, blk3} {
+		require.NoError(em.RegisterBlock(blk))
+	}
+
+	// endorsing blocks of different rounds is not a double sign
+	vote, en := endorse(blk1, PROPOSAL, roundStart)
+	require.NoError(em.AddVoteEndorsement(vote, en))
+	vote, en = endorse(blk3, PROPOSAL, now)
+	require.NoError(em.AddVoteEndorsement(vote, en))
+	require.Empty(em.DoubleSignEvidences())
+
+	// endorsing two blocks of the same round
+	vote, en = endorse(blk2, PROPOSAL, roundStart.Add(time.Second))
+	require.NoError(em.AddVoteEndorsement(vote, en))
+	require.Equal(1, len(em.DoubleSignEvidences()))
+	evidence := em.DoubleSignEvidences()[0]
+	require.Equal(PROPOSAL, evidence.Topic())
+	require.Equal(uint64(10), evidence.Height())
+	require.Equal(identityset.PrivateKey(2).PublicKey().HexString(), evidence.Endorser().HexString())
+	require.NoError(evidence.Verify())
+
+	// the same double sign is only reported once
+	vote, en = endorse(blk2, PROPOSAL, roundStart.Add(2*time.Second))
+	require.NoError(em.AddVoteEndorsement(vote, en))
+	require.Equal(1, len(em.DoubleSignEvidences()))
+
+	// committing two blocks at the same height in different rounds
+	vote, en = endorse(blk1, COMMIT, roundStart)
+	require.NoError(em.AddVoteEndorsement(vote, en))
+	vote, en = endorse(blk3, COMMIT, now)
+	require.NoError(em.AddVoteEndorsement(vote, en))
+	require.Equal(2, len(em.DoubleSignEvidences()))
+	evidence = em.DoubleSignEvidences()[1]
+	require.Equal(COMMIT, evidence.Topic())
+	require.NoError(evidence.Verify())
+
+	// invalid evidences
+	headers, ens := evidence.Headers(), evidence.Endorsements()
+	require.Equal(ErrInvalidDoubleSignEvidence, errors.Cause(
+		NewDoubleSignEvidence(PROPOSAL, headers[0], ens[0], headers[1], ens[1]).Verify(),
+	))
+	require.Equal(ErrInvalidDoubleSignEvidence, errors.Cause(
+		NewDoubleSignEvidence(COMMIT, headers[0], ens[0], headers[0], ens[0]).Verify(),
+	))
+	_, en = endorse(blk2, COMMIT, now)
+	require.Equal(ErrInvalidDoubleSignEvidence, errors.Cause(
+		NewDoubleSignEvidence(COMMIT, headers[0], ens[0], headers[1], en).Verify(),
+	))
+	blkHash := headers[1].HashBlock()
+	other, err := endorsement.Endorse(identityset.PrivateKey(3), NewConsensusVote(blkHash[:], COMMIT), now)
+	require.NoError(err)
+	require.Equal(ErrInvalidDoubleSignEvidence, errors.Cause(
+		NewDoubleSignEvidence(COMMIT, headers[0], ens[0], headers[1], other).Verify(),
+	))
+
+	// the evidence converts to the action putting it on chain
+	act, err := evidence.Action()
+	require.NoError(err)
+	loaded := &DoubleSignEvidence{}
+	require.NoError(loaded.LoadAction(act))
+	require.Equal(COMMIT, loaded.Topic())
+	require.Equal(evidence.Headers()[0].HashBlock(), loaded.Headers()[0].HashBlock())
+	require.Equal(evidence.Headers()[1].HashBlock(), loaded.Headers()[1].HashBlock())
+	require.NoError(loaded.Verify())
+
+	// evidences are persisted along with the endorsements
+	emProto, err := em.toProto()
+	require.NoError(err)
+	em2, err := newEndorsementManager(nil)
+	require.NoError(err)
+	require.NoError(em2.fromProto(emProto))
+	require.Equal(2, len(em2.DoubleSignEvidences()))
+	for i, e := range em2.DoubleSignEvidences() {
+		require.Equal(em.DoubleSignEvidences()[i].Topic(), e.Topic())
+		require.Equal(em.DoubleSignEvidences()[i].Height(), e.Height())
+		require.NoError(e.Verify())
+	}
+
+	// evidences are kept at a new height until they are pruned
+	require.NoError(em.Cleanup(now))
+	require.Equal(2, len(em.DoubleSignEvidences()))
+	require.NoError(em.Cleanup(time.Time{}))
+	require.Equal(2, len(em.DoubleSignEvidences()))
+	em.PruneDoubleSignEvidences(10)
+	require.Equal(2, len(em.DoubleSignEvidences()))
+	em.PruneDoubleSignEvidences(11)
+	require.Empty(em.DoubleSignEvidences())
+}
@@ -9,8 +9,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.23.0
-// 	protoc        v3.12.3
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.4
 // source: consensus/scheme/rolldpos/endorsementpb/endorsementmanager.proto
 
 package endorsementpb
@@ -158,9 +158,10 @@ type EndorsementManager struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlkHash           []string                      `protobuf:"bytes,1,rep,name=blkHash,proto3" json:"blkHash,omitempty"`
-	BlockEndorsements []*BlockEndorsementCollection `protobuf:"bytes,2,rep,name=blockEndorsements,proto3" json:"blockEndorsements,omitempty"`
-	CachedMintedBlk   *iotextypes.Block             `protobuf:"bytes,3,opt,name=cachedMintedBlk,proto3" json:"cachedMintedBlk,omitempty"`
+	BlkHash             []string                            `protobuf:"bytes,1,rep,name=blkHash,proto3" json:"blkHash,omitempty"`
+	BlockEndorsements   []*BlockEndorsementCollection       `protobuf:"bytes,2,rep,name=blockEndorsements,proto3" json:"blockEndorsements,omitempty"`
+	CachedMintedBlk     *iotextypes.Block                   `protobuf:"bytes,3,opt,name=cachedMintedBlk,proto3" json:"cachedMintedBlk,omitempty"`
+	DoubleSignEvidences []*iotextypes.PutDoubleSignEvidence `protobuf:"bytes,4,rep,name=doubleSignEvidences,proto3" json:"doubleSignEvidences,omitempty"`
 }
 
 func (x *EndorsementManager) Reset() {
@@ -216,6 +217,13 @@ func (x *EndorsementManager) GetCachedMintedBlk() *iotextypes.Block {
 	return nil
 }
 
+func (x *EndorsementManager) GetDoubleSignEvidences() []*iotextypes.PutDoubleSignEvidence {
+	if x != nil {
+		return x.DoubleSignEvidences
+	}
+	return nil
+}
+
 var File_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto protoreflect.FileDescriptor
 
 var file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_rawDesc = []byte{
@@ -224,46 +232,53 @@ var file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_rawDes
 	0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73,
 	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x0d, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x1a, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x65, 0x6e, 0x64,
-	0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90,
-	0x01, 0x0a, 0x1d, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x64, 0x6f, 0x72,
-	0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6f, 0x74,
-	0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x8b, 0x01, 0x0a, 0x1a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x6f, 0x72,
+	0x62, 0x1a, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x01, 0x0a, 0x1d, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x3b,
+	0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x65,
+	0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x1a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x03, 0x62, 0x6c,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x03, 0x62, 0x6c, 0x6b, 0x12,
+	0x48, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x22, 0x99, 0x02, 0x0a, 0x12, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x57, 0x0a, 0x11, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x6f, 0x72,
 	0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x23, 0x0a, 0x03, 0x62, 0x6c, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x03, 0x62, 0x6c, 0x6b, 0x12, 0x48, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61,
-	0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x72,
-	0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x22,
-	0xc4, 0x01, 0x0a, 0x12, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61, 0x73,
-	0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x57, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x6e,
-	0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64,
-	0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0f, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x4d, 0x69, 0x6e,
-	0x74, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6e,
-	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x2f, 0x72, 0x6f,
-	0x6c, 0x6c, 0x64, 0x70, 0x6f, 0x73, 0x2f, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x4d, 0x69, 0x6e,
+	0x74, 0x65, 0x64, 0x42, 0x6c, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x0f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6b,
+	0x12, 0x53, 0x0a, 0x13, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x45, 0x76,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x74, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x13, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x45, 0x76, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x2f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x64, 0x70, 0x6f, 0x73, 0x2f, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -280,11 +295,12 @@ func file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_rawDe
 
 var file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_goTypes = []interface{}{
-	(*EndorserEndorsementCollection)(nil), // 0: endorsementpb.endorserEndorsementCollection
-	(*BlockEndorsementCollection)(nil),    // 1: endorsementpb.blockEndorsementCollection
-	(*EndorsementManager)(nil),            // 2: endorsementpb.endorsementManager
-	(*iotextypes.Endorsement)(nil),        // 3: iotextypes.Endorsement
-	(*iotextypes.Block)(nil),              // 4: iotextypes.Block
+	(*EndorserEndorsementCollection)(nil),    // 0: endorsementpb.endorserEndorsementCollection
+	(*BlockEndorsementCollection)(nil),       // 1: endorsementpb.blockEndorsementCollection
+	(*EndorsementManager)(nil),               // 2: endorsementpb.endorsementManager
+	(*iotextypes.Endorsement)(nil),           // 3: iotextypes.Endorsement
+	(*iotextypes.Block)(nil),                 // 4: iotextypes.Block
+	(*iotextypes.PutDoubleSignEvidence)(nil), // 5: iotextypes.PutDoubleSignEvidence
 }
 var file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_depIdxs = []int32{
 	3, // 0: endorsementpb.endorserEndorsementCollection.endorsements:type_name -> iotextypes.Endorsement
@@ -292,11 +308,12 @@ var file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_depIdx
 	0, // 2: endorsementpb.blockEndorsementCollection.blockMap:type_name -> endorsementpb.endorserEndorsementCollection
 	1, // 3: endorsementpb.endorsementManager.blockEndorsements:type_name -> endorsementpb.blockEndorsementCollection
 	4, // 4: endorsementpb.endorsementManager.cachedMintedBlk:type_name -> iotextypes.Block
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	5, // 5: endorsementpb.endorsementManager.doubleSignEvidences:type_name -> iotextypes.PutDoubleSignEvidence
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_consensus_scheme_rolldpos_endorsementpb_endorsementmanager_proto_init() }
@@ -17,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
@@ -203,6 +204,21 @@ func (r *RollDPoS) Metrics() (scheme.ConsensusMetrics, error) {
 	}, nil
 }
 
+// RoundLogs returns the logs of the recent consensus rounds
+func (r *RollDPoS) RoundLogs() ([]scheme.RoundLog, error) {
+	return r.ctx.roundLogs.Logs(), nil
+}
+
+// AddRoundLogSubscriber adds a subscriber to the logs of the consensus rounds
+func (r *RollDPoS) AddRoundLogSubscriber(s scheme.RoundLogSubscriber) error {
+	return r.ctx.roundLogs.AddSubscriber(s)
+}
+
+// DoubleSignEvidences returns the actions putting the detected double sign evidences on chain
+func (r *RollDPoS) DoubleSignEvidences() []*action.PutDoubleSignEvidence {
+	return r.ctx.DoubleSignEvidences()
+}
+
 // NumPendingEvts returns the number of pending events
 func (r *RollDPoS) NumPendingEvts() int {
 	return r.cfsm.NumPendingEvents()
@@ -331,6 +347,7 @@ func (b *Builder) Build() (*RollDPoS, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "error when constructing the consensus FSM")
 	}
+	cfsm.SetTransitionObserver(ctx.roundLogs.Transition)
 	return &RollDPoS{
 		cfsm:       cfsm,
 		ctx:        ctx,
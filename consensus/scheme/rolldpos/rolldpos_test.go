@@ -272,7 +272,10 @@ func TestRollDPoS_Metrics(t *testing.T) {
 
 	sk1 := identityset.PrivateKey(1)
 	cfg := config.Default
-	cfg.Consensus.RollDPoS.ConsensusDBPath = "consensus.db"
+	testDBPath, err := testutil.PathOfTempFile("consensus.db")
+	require.NoError(t, err)
+	defer testutil.CleanupPath(t, testDBPath)
+	cfg.Consensus.RollDPoS.ConsensusDBPath = testDBPath
 	cfg.Genesis.NumDelegates = 4
 	cfg.Genesis.NumSubEpochs = 1
 	cfg.Genesis.BlockInterval = 10 * time.Second
@@ -19,6 +19,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/config"
@@ -85,9 +86,12 @@ type rollDPoSCtx struct {
 	encodedAddr string
 	priKey      crypto.PrivateKey
 	round       *roundCtx
-	clock       clock.Clock
-	active      bool
-	mutex       sync.RWMutex
+	// eManager is carried over from round to round, so the double sign evidences can be read without the mutex
+	eManager  *endorsementManager
+	roundLogs *roundLogger
+	clock     clock.Clock
+	active    bool
+	mutex     sync.RWMutex
 }
 
 func newRollDPoSCtx(
@@ -146,6 +150,7 @@ func newRollDPoSCtx(
 		chain:             chain,
 		broadcastHandler:  broadcastHandler,
 		clock:             clock,
+		roundLogs:         newRoundLogger(clock, roundLogSize),
 		roundCalc:         roundCalc,
 		eManagerDB:        eManagerDB,
 		toleratedOvertime: toleratedOvertime,
@@ -161,11 +166,33 @@ func (ctx *rollDPoSCtx) Start(c context.Context) (err error) {
 		eManager, err = newEndorsementManager(ctx.eManagerDB)
 	}
 	ctx.round, err = ctx.roundCalc.NewRoundWithToleration(0, ctx.BlockInterval(0), ctx.clock.Now(), eManager, ctx.toleratedOvertime)
+	if err != nil {
+		return err
+	}
+	ctx.eManager = ctx.round.eManager
+	return nil
+}
 
-	return err
+// DoubleSignEvidences returns the actions putting the detected double sign evidences on chain. It does not hold the
+// mutex, since it is called back while minting a block in the proposal.
+func (ctx *rollDPoSCtx) DoubleSignEvidences() []*action.PutDoubleSignEvidence {
+	if ctx.eManager == nil {
+		return nil
+	}
+	var acts []*action.PutDoubleSignEvidence
+	for _, evidence := range ctx.eManager.DoubleSignEvidences() {
+		act, err := evidence.Action()
+		if err != nil {
+			log.L().Error("Failed to convert double sign evidence.", zap.Error(err))
+			continue
+		}
+		acts = append(acts, act)
+	}
+	return acts
 }
 
 func (ctx *rollDPoSCtx) Stop(c context.Context) error {
+	ctx.roundLogs.Stop()
 	if ctx.eManagerDB != nil {
 		return ctx.eManagerDB.Stop(c)
 	}
@@ -306,6 +333,7 @@ func (ctx *rollDPoSCtx) Prepare() error {
 		zap.String("roundStartTime", newRound.roundStartTime.String()),
 	)
 	ctx.round = newRound
+	ctx.roundLogs.NewRound(newRound, ctx.endorsementDeadlines())
 	consensusHeightMtc.WithLabelValues().Set(float64(ctx.round.height))
 	timeSlotMtc.WithLabelValues().Set(float64(ctx.round.roundNum))
 	return nil
@@ -339,12 +367,12 @@ func (ctx *rollDPoSCtx) WaitUntilRoundStart() time.Duration {
 	now := ctx.clock.Now()
 	startTime := ctx.round.StartTime()
 	if now.Before(startTime) {
-		time.Sleep(startTime.Sub(now))
+		ctx.clock.Sleep(startTime.Sub(now))
 		return 0
 	}
 	overTime := now.Sub(startTime)
 	if !ctx.isDelegate() && ctx.toleratedOvertime > overTime {
-		time.Sleep(ctx.toleratedOvertime - overTime)
+		ctx.clock.Sleep(ctx.toleratedOvertime - overTime)
 		return 0
 	}
 	return overTime
@@ -382,6 +410,7 @@ func (ctx *rollDPoSCtx) NewProposalEndorsement(msg interface{}) (interface{}, er
 		if err := ctx.round.AddBlock(proposal.block); err != nil {
 			return nil, err
 		}
+		ctx.roundLogs.BlockReceived()
 		ctx.loggerWithStats().Debug("accept block proposal", log.Hex("block", blockHash))
 	} else if ctx.round.IsLocked() {
 		blockHash = ctx.round.HashOfBlockInLock()
@@ -480,7 +509,7 @@ func (ctx *rollDPoSCtx) Commit(msg interface{}) (bool, error) {
 	case blockchain.ErrInvalidTipHeight:
 		return true, nil
 	case nil:
-		break
+		ctx.roundLogs.Committed()
 	default:
 		return false, errors.Wrap(err, "error when committing a block")
 	}
@@ -681,6 +710,7 @@ func (ctx *rollDPoSCtx) verifyVote(
 	if err := ctx.round.AddVoteEndorsement(vote, endorsement); err != nil {
 		return blkHash, err
 	}
+	ctx.roundLogs.Endorsed(vote, endorsement)
 	ctx.loggerWithStats().Debug(
 		"verified consensus vote",
 		log.Hex("block", blkHash),
@@ -693,6 +723,18 @@ func (ctx *rollDPoSCtx) verifyVote(
 	return blkHash, nil
 }
 
+// endorsementDeadlines returns the times to stop accepting endorsements by topic in the current round
+func (ctx *rollDPoSCtx) endorsementDeadlines() map[ConsensusVoteTopic]time.Time {
+	height := ctx.round.height
+	proposal := ctx.round.StartTime().Add(ctx.AcceptBlockTTL(height) + ctx.AcceptProposalEndorsementTTL(height))
+	lock := proposal.Add(ctx.AcceptLockEndorsementTTL(height))
+	return map[ConsensusVoteTopic]time.Time{
+		PROPOSAL: proposal,
+		LOCK:     lock,
+		COMMIT:   lock.Add(ctx.CommitTTL(height)),
+	}
+}
+
 func (ctx *rollDPoSCtx) newEndorsement(
 	blkHash []byte,
 	topic ConsensusVoteTopic,
@@ -67,6 +67,8 @@ func (c *roundCalculator) UpdateRound(round *roundCtx, height uint64, blockInter
 		blockInLock = round.blockInLock
 		proofOfLock = round.proofOfLock
 	} else {
+		// the evidence of the last block of the previous epoch is kept, so that it can be reported in this epoch
+		round.eManager.PruneDoubleSignEvidences(epochStartHeight - 1)
 		err = round.eManager.Cleanup(time.Time{})
 		if err != nil {
 			return nil, err
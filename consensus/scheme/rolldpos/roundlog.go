@@ -0,0 +1,278 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/facebookgo/clock"
+	fsm "github.com/iotexproject/go-fsm"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/consensus/scheme"
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// roundLogSize is the number of recent rounds of which the logs are kept
+const roundLogSize = 100
+
+// roundLogSubscriberBufferSize is the number of round logs buffered for a subscriber, beyond which the logs are
+// dropped for the subscriber instead of blocking the consensus
+const roundLogSubscriberBufferSize = 100
+
+var topicNames = map[ConsensusVoteTopic]string{
+	PROPOSAL: "PROPOSAL",
+	LOCK:     "LOCK",
+	COMMIT:   "COMMIT",
+}
+
+// roundLogger keeps the logs of the recent consensus rounds in a ring buffer
+type roundLogger struct {
+	mu    sync.RWMutex
+	clock clock.Clock
+	logs  []*scheme.RoundLog
+	// next is the index of the log of the next round
+	next int
+	// deadlines are the times to stop accepting endorsements by topic in the current round
+	deadlines   map[ConsensusVoteTopic]time.Time
+	subscribers []*roundLogSubscription
+}
+
+// roundLogSubscription includes the subscriber, the buffered channel of the round logs to notify, and the cancel
+// channel to end the handler thread
+type roundLogSubscription struct {
+	subscriber scheme.RoundLogSubscriber
+	pending    chan scheme.RoundLog
+	cancel     chan struct{}
+}
+
+func (s *roundLogSubscription) handler() {
+	for {
+		select {
+		case <-s.cancel:
+			return
+		case rl := <-s.pending:
+			if err := s.subscriber.ReceiveRoundLog(rl); err != nil {
+				log.L().Error("Failed to handle round log.", zap.Error(err))
+			}
+		}
+	}
+}
+
+func newRoundLogger(clock clock.Clock, size int) *roundLogger {
+	return &roundLogger{
+		clock: clock,
+		logs:  make([]*scheme.RoundLog, size),
+	}
+}
+
+func (l *roundLogger) current() *scheme.RoundLog {
+	return l.logs[(l.next+len(l.logs)-1)%len(l.logs)]
+}
+
+// NewRound starts the log of the round, the log of the previous round is closed as failed if it was not committed
+func (l *roundLogger) NewRound(round *roundCtx, deadlines map[ConsensusVoteTopic]time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if cur := l.current(); cur != nil {
+		if cur.Height == round.Height() && cur.Round == round.Number() {
+			return
+		}
+		if cur.Outcome == scheme.RoundInProgress {
+			cur.Outcome = scheme.RoundFailed
+			cur.Reason = failureReason(cur)
+			cur.Missing = missingEndorsers(cur)
+			l.notify(cur)
+		}
+	}
+	l.logs[l.next] = &scheme.RoundLog{
+		Height:    round.Height(),
+		Round:     round.Number(),
+		StartTime: round.StartTime(),
+		Proposer:  round.Proposer(),
+		Delegates: round.Delegates(),
+		Outcome:   scheme.RoundInProgress,
+	}
+	l.next = (l.next + 1) % len(l.logs)
+	l.deadlines = deadlines
+}
+
+// BlockReceived records the time the proposed block is received
+func (l *roundLogger) BlockReceived() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if cur := l.current(); cur != nil && cur.BlockReceived.IsZero() {
+		cur.BlockReceived = l.clock.Now()
+	}
+}
+
+// Endorsed records an endorsement received
+func (l *roundLogger) Endorsed(vote *ConsensusVote, en *endorsement.Endorsement) {
+	endorser, err := address.FromBytes(en.Endorser().Hash())
+	if err != nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	cur := l.current()
+	if cur == nil {
+		return
+	}
+	now := l.clock.Now()
+	cur.Endorsements = append(cur.Endorsements, scheme.EndorsementLog{
+		Endorser:  endorser.String(),
+		Topic:     topicNames[vote.Topic()],
+		BlockHash: encodeToString(vote.BlockHash()),
+		Received:  now,
+		Late:      now.After(l.deadlines[vote.Topic()]),
+	})
+}
+
+// Transition records a state transition of the consensus fsm
+func (l *roundLogger) Transition(src fsm.State, dst fsm.State, et fsm.EventType) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if cur := l.current(); cur != nil {
+		cur.Transitions = append(cur.Transitions, scheme.TransitionLog{
+			Src:   string(src),
+			Dst:   string(dst),
+			Event: string(et),
+			Time:  l.clock.Now(),
+		})
+	}
+}
+
+// Committed closes the log of the current round as committed
+func (l *roundLogger) Committed() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if cur := l.current(); cur != nil && cur.Outcome != scheme.RoundCommitted {
+		cur.Outcome = scheme.RoundCommitted
+		cur.Missing = missingEndorsers(cur)
+		l.notify(cur)
+	}
+}
+
+// AddSubscriber adds a subscriber to the logs of the closed rounds
+func (l *roundLogger) AddSubscriber(s scheme.RoundLogSubscriber) error {
+	if s == nil {
+		return errors.New("subscriber could not be nil")
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	subscription := &roundLogSubscription{
+		subscriber: s,
+		pending:    make(chan scheme.RoundLog, roundLogSubscriberBufferSize),
+		cancel:     make(chan struct{}),
+	}
+	// create subscriber handler thread to handle the round logs
+	go subscription.handler()
+	l.subscribers = append(l.subscribers, subscription)
+	return nil
+}
+
+// Stop ends the handler threads of the subscribers
+func (l *roundLogger) Stop() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, s := range l.subscribers {
+		close(s.cancel)
+	}
+	l.subscribers = nil
+}
+
+// notify passes a copy of the closed round log to the subscribers. The log is dropped for a subscriber whose buffer
+// is full, such that a slow subscriber doesn't block the consensus
+func (l *roundLogger) notify(rl *scheme.RoundLog) {
+	for _, s := range l.subscribers {
+		select {
+		case s.pending <- copyRoundLog(rl):
+		default:
+			log.L().Warn("Round log subscriber is full, the round log is dropped.", zap.Uint64("height", rl.Height))
+		}
+	}
+}
+
+// Logs returns the logs of the recent rounds, from the oldest to the latest
+func (l *roundLogger) Logs() []scheme.RoundLog {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	logs := make([]scheme.RoundLog, 0, len(l.logs))
+	for i := range l.logs {
+		rl := l.logs[(l.next+i)%len(l.logs)]
+		if rl == nil {
+			continue
+		}
+		cp := copyRoundLog(rl)
+		if cp.Outcome == scheme.RoundInProgress {
+			cp.Missing = missingEndorsers(rl)
+		}
+		logs = append(logs, cp)
+	}
+	return logs
+}
+
+// copyRoundLog returns a copy of the round log, which is not changed by the following events of the round
+func copyRoundLog(rl *scheme.RoundLog) scheme.RoundLog {
+	cp := *rl
+	cp.Delegates = append([]string{}, rl.Delegates...)
+	cp.Endorsements = append([]scheme.EndorsementLog{}, rl.Endorsements...)
+	cp.Transitions = append([]scheme.TransitionLog{}, rl.Transitions...)
+	return cp
+}
+
+// missingEndorsers returns the delegates who have not endorsed on each topic
+func missingEndorsers(rl *scheme.RoundLog) map[string][]string {
+	endorsed := make(map[string]map[string]bool, len(topicNames))
+	for _, e := range rl.Endorsements {
+		if endorsed[e.Topic] == nil {
+			endorsed[e.Topic] = make(map[string]bool)
+		}
+		endorsed[e.Topic][e.Endorser] = true
+	}
+	missing := make(map[string][]string, len(topicNames))
+	for _, topic := range topicNames {
+		for _, d := range rl.Delegates {
+			if !endorsed[topic][d] {
+				missing[topic] = append(missing[topic], d)
+			}
+		}
+	}
+	return missing
+}
+
+// failureReason returns the first step of the round which did not complete
+func failureReason(rl *scheme.RoundLog) string {
+	if rl.BlockReceived.IsZero() {
+		return "no block proposal received"
+	}
+	for _, topic := range []ConsensusVoteTopic{PROPOSAL, LOCK, COMMIT} {
+		endorsers := make(map[string]bool)
+		for _, e := range rl.Endorsements {
+			if e.Topic == topicNames[topic] && len(e.BlockHash) != 0 {
+				endorsers[e.Endorser] = true
+			}
+		}
+		if 3*len(endorsers) <= 2*len(rl.Delegates) {
+			return fmt.Sprintf("insufficient %s endorsements", topicNames[topic])
+		}
+	}
+	return "block not committed"
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/consensus/scheme"
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestRoundLogger(t *testing.T) {
+	require := require.New(t)
+	mockClock := clock.NewMock()
+	l := newRoundLogger(mockClock, 2)
+	require.Empty(l.Logs())
+
+	delegates := []string{identityset.Address(0).String(), identityset.Address(1).String(), identityset.Address(2).String()}
+	newRound := func(height uint64, num uint32) {
+		start := mockClock.Now()
+		l.NewRound(&roundCtx{
+			height:         height,
+			roundNum:       num,
+			proposer:       delegates[0],
+			roundStartTime: start,
+			delegates:      delegates,
+		}, map[ConsensusVoteTopic]time.Time{
+			PROPOSAL: start.Add(2 * time.Second),
+			LOCK:     start.Add(3 * time.Second),
+			COMMIT:   start.Add(4 * time.Second),
+		})
+	}
+	endorse := func(i int, topic ConsensusVoteTopic) {
+		vote := NewConsensusVote([]byte{1, 2, 3}, topic)
+		en, err := endorsement.Endorse(identityset.PrivateKey(i), vote, mockClock.Now())
+		require.NoError(err)
+		l.Endorsed(vote, en)
+	}
+
+	// a round without block proposal
+	newRound(1, 0)
+	newRound(1, 0)
+	l.Transition("S_PREPARE", "S_ACCEPT_BLOCK_PROPOSAL", "E_PREPARE")
+	logs := l.Logs()
+	require.Equal(1, len(logs))
+	require.Equal(uint64(1), logs[0].Height)
+	require.Equal(delegates[0], logs[0].Proposer)
+	require.Equal(scheme.RoundInProgress, logs[0].Outcome)
+	require.Equal(1, len(logs[0].Transitions))
+	require.Equal("E_PREPARE", logs[0].Transitions[0].Event)
+	require.Equal(delegates, logs[0].Missing["PROPOSAL"])
+
+	// a round failing for lack of lock endorsements
+	mockClock.Add(10 * time.Second)
+	newRound(1, 1)
+	l.BlockReceived()
+	for i := range delegates {
+		endorse(i, PROPOSAL)
+	}
+	mockClock.Add(5 * time.Second)
+	endorse(1, LOCK)
+	logs = l.Logs()
+	require.Equal(2, len(logs))
+	require.Equal(scheme.RoundFailed, logs[0].Outcome)
+	require.Equal("no block proposal received", logs[0].Reason)
+	require.Equal(uint32(1), logs[1].Round)
+	require.False(logs[1].BlockReceived.IsZero())
+	require.Equal(4, len(logs[1].Endorsements))
+	require.False(logs[1].Endorsements[0].Late)
+	require.True(logs[1].Endorsements[3].Late)
+	require.Equal(delegates[1], logs[1].Endorsements[3].Endorser)
+	require.Empty(logs[1].Missing["PROPOSAL"])
+	require.Equal([]string{delegates[0], delegates[2]}, logs[1].Missing["LOCK"])
+
+	// the oldest round is dropped
+	mockClock.Add(10 * time.Second)
+	newRound(2, 0)
+	for i := range delegates {
+		endorse(i, COMMIT)
+	}
+	l.Committed()
+	logs = l.Logs()
+	require.Equal(2, len(logs))
+	require.Equal(uint64(1), logs[0].Height)
+	require.Equal(scheme.RoundFailed, logs[0].Outcome)
+	require.Equal("insufficient LOCK endorsements", logs[0].Reason)
+	require.Equal(uint64(2), logs[1].Height)
+	require.Equal(scheme.RoundCommitted, logs[1].Outcome)
+	require.Empty(logs[1].Missing["COMMIT"])
+}
+
+type testRoundLogSubscriber chan scheme.RoundLog
+
+func (s testRoundLogSubscriber) ReceiveRoundLog(rl scheme.RoundLog) error {
+	s <- rl
+	return nil
+}
+
+func TestRoundLoggerSubscriber(t *testing.T) {
+	require := require.New(t)
+	l := newRoundLogger(clock.NewMock(), 2)
+	require.Error(l.AddSubscriber(nil))
+	s := make(testRoundLogSubscriber, 2)
+	require.NoError(l.AddSubscriber(s))
+	defer l.Stop()
+
+	newRound := func(height uint64) {
+		l.NewRound(&roundCtx{height: height}, nil)
+	}
+	// the logs are received once the rounds are closed
+	newRound(1)
+	newRound(2)
+	rl := <-s
+	require.Equal(uint64(1), rl.Height)
+	require.Equal(scheme.RoundFailed, rl.Outcome)
+	l.Transition("S_PREPARE", "S_ACCEPT_BLOCK_PROPOSAL", "E_PREPARE")
+	l.Committed()
+	l.Committed()
+	rl = <-s
+	require.Equal(uint64(2), rl.Height)
+	require.Equal(scheme.RoundCommitted, rl.Outcome)
+	require.Len(rl.Transitions, 1)
+	// the received log is not changed by the following events
+	l.Transition("S_COMMIT", "S_PREPARE", "E_PREPARE")
+	require.Len(rl.Transitions, 1)
+	newRound(3)
+	select {
+	case rl = <-s:
+		require.FailNow("unexpected round log", "height %d", rl.Height)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
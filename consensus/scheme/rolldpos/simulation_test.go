@@ -0,0 +1,428 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"context"
+	"encoding/hex"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/config"
+	cp "github.com/iotexproject/iotex-core/crypto"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+// The simulation runs a number of delegates in process. The nodes share a mock clock, which is only moved forward by
+// the simulation, and talk over a simulated network, which delays, drops, reorders and partitions messages with a
+// seeded random source. Each node still handles its events in its own goroutine, so the simulation lets the nodes
+// settle before moving the clock forward.
+
+// simStep is the simulated time the clock moves forward at a time
+const simStep = 50 * time.Millisecond
+
+// simClock is the mock clock of which the sleeps are interrupted once the simulation stops, so that the nodes waiting
+// for a round to start could stop
+type simClock struct {
+	*clock.Mock
+	stop chan struct{}
+}
+
+func (c *simClock) Sleep(d time.Duration) {
+	select {
+	case <-c.After(d):
+	case <-c.stop:
+	}
+}
+
+// simNetwork delivers the messages broadcast by the nodes on the mock clock
+type simNetwork struct {
+	mu       sync.Mutex
+	clock    *clock.Mock
+	rand     *rand.Rand
+	handlers []func(proto.Message)
+	minDelay time.Duration
+	maxDelay time.Duration
+	dropRate float64
+	// groups are the partitions of the nodes, nodes can only talk to the nodes in the same group
+	groups []int
+}
+
+func newSimNetwork(clock *clock.Mock, seed int64, numNodes int) *simNetwork {
+	return &simNetwork{
+		clock:    clock,
+		rand:     rand.New(rand.NewSource(seed)),
+		handlers: make([]func(proto.Message), numNodes),
+		groups:   make([]int, numNodes),
+	}
+}
+
+// SetDelay sets the range of the message delay, messages are reordered if the range is not empty
+func (n *simNetwork) SetDelay(min, max time.Duration) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.minDelay, n.maxDelay = min, max
+}
+
+// SetDropRate sets the ratio of the messages to drop
+func (n *simNetwork) SetDropRate(rate float64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.dropRate = rate
+}
+
+// Partition splits the network into the groups of nodes
+func (n *simNetwork) Partition(groups ...[]int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for g, nodes := range groups {
+		for _, i := range nodes {
+			n.groups[i] = g
+		}
+	}
+}
+
+// Heal removes the partitions
+func (n *simNetwork) Heal() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.groups = make([]int, len(n.groups))
+}
+
+// Connected returns whether the nodes are in the same partition
+func (n *simNetwork) Connected(i, j int) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.groups[i] == n.groups[j]
+}
+
+// Broadcast returns the broadcast handler of the node
+func (n *simNetwork) Broadcast(from int) func(proto.Message) error {
+	return func(msg proto.Message) error {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		for to, handle := range n.handlers {
+			if to == from || n.groups[to] != n.groups[from] || n.rand.Float64() < n.dropRate {
+				continue
+			}
+			delay := n.minDelay
+			if n.maxDelay > n.minDelay {
+				delay += time.Duration(n.rand.Int63n(int64(n.maxDelay - n.minDelay)))
+			}
+			handle := handle
+			n.clock.AfterFunc(delay, func() { handle(msg) })
+		}
+		return nil
+	}
+}
+
+type simulation struct {
+	t      *testing.T
+	clock  *simClock
+	net    *simNetwork
+	nodes  []*RollDPoS
+	chains []blockchain.Blockchain
+	daos   []blockdao.BlockDAO
+}
+
+// newSimulation creates a simulation of the delegates, the config is shared by all the nodes
+func newSimulation(t *testing.T, cfg config.Config, numNodes int, seed int64) *simulation {
+	require := require.New(t)
+	cfg.Consensus.RollDPoS.ConsensusDBPath = ""
+	cfg.Genesis.Blockchain.NumDelegates = uint64(numNodes)
+	cfg.Genesis.Blockchain.NumSubEpochs = 1
+	cfg.Genesis.EnableGravityChainVoting = false
+
+	chainAddrs := make([]*addrKeyPair, numNodes)
+	addressMap := make(map[string]*addrKeyPair)
+	delegates := make([]string, 0, numNodes)
+	for i := 0; i < numNodes; i++ {
+		addr := identityset.Address(i).String()
+		addressMap[addr] = &addrKeyPair{encodedAddr: addr, priKey: identityset.PrivateKey(i)}
+		delegates = append(delegates, addr)
+	}
+	cp.SortCandidates(delegates, 1, cp.CryptoSeed)
+	for i, addr := range delegates {
+		chainAddrs[i] = addressMap[addr]
+	}
+	delegatesByEpochFunc := func(_ uint64) ([]string, error) {
+		return delegates, nil
+	}
+
+	mockClock := clock.NewMock()
+	mockClock.Add(time.Unix(cfg.Genesis.Timestamp, 0).Sub(mockClock.Now()))
+	s := &simulation{
+		t:     t,
+		clock: &simClock{Mock: mockClock, stop: make(chan struct{})},
+		net:   newSimNetwork(mockClock, seed, numNodes),
+	}
+	for i := 0; i < numNodes; i++ {
+		ctx := context.Background()
+		cfg.Chain.ProducerPrivKey = hex.EncodeToString(chainAddrs[i].priKey.Bytes())
+		registry := protocol.NewRegistry()
+		sf, err := factory.NewFactory(cfg, factory.InMemTrieOption(), factory.RegistryOption(registry))
+		require.NoError(err)
+		require.NoError(sf.Start(protocol.WithBlockchainCtx(
+			protocol.WithRegistry(ctx, registry),
+			protocol.BlockchainCtx{
+				Genesis: cfg.Genesis,
+			},
+		)))
+		actPool, err := actpool.NewActPool(sf, cfg.ActPool, actpool.EnableExperimentalActions())
+		require.NoError(err)
+		require.NoError(account.NewProtocol(rewarding.DepositGas).Register(registry))
+		rp := rolldpos.NewProtocol(cfg.Genesis.NumCandidateDelegates, cfg.Genesis.NumDelegates, cfg.Genesis.NumSubEpochs)
+		require.NoError(rp.Register(registry))
+		dao := blockdao.NewBlockDAOInMemForTest([]blockdao.BlockIndexer{sf}, cfg.DB)
+		chain := blockchain.NewBlockchain(
+			cfg,
+			dao,
+			factory.NewMinter(sf, actPool),
+			blockchain.BlockValidatorOption(block.NewValidator(
+				sf,
+				protocol.NewGenericValidator(sf, accountutil.AccountState),
+			)),
+		)
+		consensus, err := NewRollDPoSBuilder().
+			SetAddr(chainAddrs[i].encodedAddr).
+			SetPriKey(chainAddrs[i].priKey).
+			SetConfig(cfg).
+			SetChainManager(chain).
+			SetBroadcast(s.net.Broadcast(i)).
+			SetDelegatesByEpochFunc(delegatesByEpochFunc).
+			SetClock(s.clock).
+			RegisterProtocol(rp).
+			Build()
+		require.NoError(err)
+		s.chains = append(s.chains, chain)
+		s.daos = append(s.daos, dao)
+		s.nodes = append(s.nodes, consensus)
+		s.net.handlers[i] = s.handler(i)
+	}
+	return s
+}
+
+// handler returns the handler of the messages received by the node
+func (s *simulation) handler(i int) func(proto.Message) {
+	return func(msg proto.Message) {
+		switch msg := msg.(type) {
+		case *iotextypes.ConsensusMessage:
+			s.nodes[i].HandleConsensusMsg(msg)
+		case *iotextypes.Block:
+			blk := &block.Block{}
+			if err := blk.ConvertFromBlockPb(msg); err != nil {
+				return
+			}
+			s.commit(i, blk)
+		}
+	}
+}
+
+// commit commits the block to the chain of the node if it is next to the tip
+func (s *simulation) commit(i int, blk *block.Block) bool {
+	if blk.Height() != s.chains[i].TipHeight()+1 {
+		return false
+	}
+	if err := s.nodes[i].ValidateBlockFooter(blk); err != nil {
+		return false
+	}
+	if err := s.chains[i].ValidateBlock(blk); err != nil {
+		return false
+	}
+	if err := s.chains[i].CommitBlock(blk); err != nil {
+		return false
+	}
+	s.nodes[i].Calibrate(blk.Height())
+	return true
+}
+
+// sync plays the role of block sync, the nodes fetch the blocks they missed from the reachable nodes
+func (s *simulation) sync() {
+	for i := range s.nodes {
+		for j := range s.nodes {
+			if i == j || !s.net.Connected(i, j) {
+				continue
+			}
+			for h := s.chains[i].TipHeight() + 1; h <= s.chains[j].TipHeight(); h++ {
+				blk, err := s.daos[j].GetBlockByHeight(h)
+				if err != nil || !s.commit(i, blk) {
+					break
+				}
+			}
+		}
+	}
+}
+
+func (s *simulation) Start() {
+	ctx := context.Background()
+	for i := range s.nodes {
+		require.NoError(s.t, s.chains[i].Start(ctx))
+		require.NoError(s.t, s.nodes[i].Start(ctx))
+	}
+}
+
+func (s *simulation) Stop() {
+	ctx := context.Background()
+	close(s.clock.stop)
+	for i := range s.nodes {
+		require.NoError(s.t, s.nodes[i].Stop(ctx))
+		require.NoError(s.t, s.chains[i].Stop(ctx))
+	}
+}
+
+// settle waits for the nodes to handle the pending events
+func (s *simulation) settle() {
+	for retry := 0; retry < 20; retry++ {
+		time.Sleep(time.Millisecond)
+		pending := 0
+		for _, node := range s.nodes {
+			pending += node.NumPendingEvts()
+		}
+		if pending == 0 {
+			return
+		}
+	}
+}
+
+// Run moves the clock forward by the duration
+func (s *simulation) Run(d time.Duration) {
+	for end := s.clock.Now().Add(d); s.clock.Now().Before(end); {
+		s.clock.Add(simStep)
+		s.settle()
+		s.sync()
+	}
+}
+
+// RunUntil moves the clock forward until the condition is met, it returns false if the condition is not met in time
+func (s *simulation) RunUntil(cond func() bool, timeout time.Duration) bool {
+	for end := s.clock.Now().Add(timeout); s.clock.Now().Before(end); {
+		if cond() {
+			return true
+		}
+		s.clock.Add(simStep)
+		s.settle()
+		s.sync()
+	}
+	return cond()
+}
+
+// ReachHeight returns a condition that the nodes all reach the height
+func (s *simulation) ReachHeight(height uint64, nodes ...int) func() bool {
+	return func() bool {
+		for _, i := range nodes {
+			if s.chains[i].TipHeight() < height {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// AllNodes returns the indexes of all nodes
+func (s *simulation) AllNodes() []int {
+	nodes := make([]int, len(s.nodes))
+	for i := range nodes {
+		nodes[i] = i
+	}
+	return nodes
+}
+
+// AssertSafety checks that no two nodes have committed different blocks at the same height
+func (s *simulation) AssertSafety() {
+	committed := make(map[uint64]string)
+	for i, chain := range s.chains {
+		for h := uint64(1); h <= chain.TipHeight(); h++ {
+			header, err := chain.BlockHeaderByHeight(h)
+			require.NoError(s.t, err)
+			blkHash := header.HashBlock()
+			encoded := hex.EncodeToString(blkHash[:])
+			if prev, ok := committed[h]; ok {
+				require.Equal(s.t, prev, encoded, "node %d committed a conflicting block at height %d", i, h)
+				continue
+			}
+			committed[h] = encoded
+		}
+	}
+}
+
+func simulationConfig() config.Config {
+	cfg := config.Default
+	cfg.Consensus.RollDPoS.Delay = 300 * time.Millisecond
+	cfg.Consensus.RollDPoS.FSM.AcceptBlockTTL = 800 * time.Millisecond
+	cfg.Consensus.RollDPoS.FSM.AcceptProposalEndorsementTTL = 400 * time.Millisecond
+	cfg.Consensus.RollDPoS.FSM.AcceptLockEndorsementTTL = 400 * time.Millisecond
+	cfg.Consensus.RollDPoS.FSM.CommitTTL = 400 * time.Millisecond
+	cfg.Consensus.RollDPoS.FSM.UnmatchedEventTTL = time.Second
+	cfg.Consensus.RollDPoS.FSM.UnmatchedEventInterval = 10 * time.Millisecond
+	cfg.Consensus.RollDPoS.ToleratedOvertime = 200 * time.Millisecond
+	cfg.Genesis.BlockInterval = 2 * time.Second
+	return cfg
+}
+
+func TestSimulation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skip the consensus simulation in short mode.")
+	}
+
+	t.Run("delay-and-reorder", func(t *testing.T) {
+		s := newSimulation(t, simulationConfig(), 4, 1)
+		s.net.SetDelay(10*time.Millisecond, 200*time.Millisecond)
+		s.Start()
+		defer s.Stop()
+
+		require.True(t, s.RunUntil(s.ReachHeight(5, s.AllNodes()...), time.Minute))
+		s.AssertSafety()
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		s := newSimulation(t, simulationConfig(), 4, 2)
+		s.net.SetDelay(10*time.Millisecond, 50*time.Millisecond)
+		s.net.SetDropRate(0.05)
+		s.Start()
+		defer s.Stop()
+
+		require.True(t, s.RunUntil(s.ReachHeight(3, s.AllNodes()...), 2*time.Minute))
+		s.AssertSafety()
+	})
+
+	t.Run("partition", func(t *testing.T) {
+		s := newSimulation(t, simulationConfig(), 4, 3)
+		s.net.SetDelay(10*time.Millisecond, 50*time.Millisecond)
+		// without a majority on either side, no block is committed
+		s.net.Partition([]int{0, 1}, []int{2, 3})
+		s.Start()
+		defer s.Stop()
+
+		s.Run(10 * time.Second)
+		for _, chain := range s.chains {
+			require.Equal(t, uint64(0), chain.TipHeight())
+		}
+		// the majority commits blocks while a node is cut off, which catches up once the partition heals
+		s.net.Partition([]int{0}, []int{1, 2, 3})
+		require.True(t, s.RunUntil(s.ReachHeight(3, 1, 2, 3), time.Minute))
+		s.net.Heal()
+		require.True(t, s.RunUntil(s.ReachHeight(s.chains[1].TipHeight()+1, s.AllNodes()...), time.Minute))
+		s.AssertSafety()
+	})
+}
@@ -7,6 +7,8 @@
 package scheme
 
 import (
+	"time"
+
 	"github.com/golang/protobuf/proto"
 
 	"github.com/iotexproject/iotex-core/blockchain/block"
@@ -37,10 +39,18 @@ type Scheme interface {
 	Calibrate(uint64)
 	ValidateBlockFooter(*block.Block) error
 	Metrics() (ConsensusMetrics, error)
+	RoundLogs() ([]RoundLog, error)
+	AddRoundLogSubscriber(RoundLogSubscriber) error
 	Activate(bool)
 	Active() bool
 }
 
+// RoundLogSubscriber is the interface of a subscriber to the logs of the consensus rounds, a log is received once
+// its round is closed
+type RoundLogSubscriber interface {
+	ReceiveRoundLog(RoundLog) error
+}
+
 // ConsensusMetrics contains consensus metrics to expose
 type ConsensusMetrics struct {
 	LatestEpoch         uint64
@@ -48,3 +58,45 @@ type ConsensusMetrics struct {
 	LatestDelegates     []string
 	LatestBlockProducer string
 }
+
+// Consensus round outcomes
+const (
+	RoundInProgress = "in progress"
+	RoundCommitted  = "committed"
+	RoundFailed     = "failed"
+)
+
+// RoundLog contains the events of a consensus round
+type RoundLog struct {
+	Height    uint64    `json:"height"`
+	Round     uint32    `json:"round"`
+	StartTime time.Time `json:"startTime"`
+	Proposer  string    `json:"proposer"`
+	Delegates []string  `json:"delegates"`
+	// BlockReceived is the time the proposed block is received, it is zero if no block is received
+	BlockReceived time.Time        `json:"blockReceived"`
+	Endorsements  []EndorsementLog `json:"endorsements"`
+	Transitions   []TransitionLog  `json:"transitions"`
+	Outcome       string           `json:"outcome"`
+	Reason        string           `json:"reason,omitempty"`
+	// Missing are the delegates whose endorsements on a topic are not received, by topic
+	Missing map[string][]string `json:"missing,omitempty"`
+}
+
+// EndorsementLog is an endorsement received in a consensus round
+type EndorsementLog struct {
+	Endorser  string    `json:"endorser"`
+	Topic     string    `json:"topic"`
+	BlockHash string    `json:"blockHash"`
+	Received  time.Time `json:"received"`
+	// Late is true if the endorsement is received after the time to accept endorsements on the topic
+	Late bool `json:"late"`
+}
+
+// TransitionLog is a state transition of the consensus fsm
+type TransitionLog struct {
+	Src   string    `json:"src"`
+	Dst   string    `json:"dst"`
+	Event string    `json:"event"`
+	Time  time.Time `json:"time"`
+}
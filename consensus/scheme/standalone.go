@@ -95,6 +95,19 @@ func (s *Standalone) Metrics() (ConsensusMetrics, error) {
 	)
 }
 
+// RoundLogs is not implemented for standalone scheme
+func (s *Standalone) RoundLogs() ([]RoundLog, error) {
+	return nil, errors.Wrapf(
+		ErrNotImplemented,
+		"standalone scheme does not supported round logs",
+	)
+}
+
+// AddRoundLogSubscriber does nothing for standalone scheme, which has no consensus round to log
+func (s *Standalone) AddRoundLogSubscriber(_ RoundLogSubscriber) error {
+	return nil
+}
+
 // Activate is not implemented for standalone scheme
 func (s *Standalone) Activate(_ bool) {
 	log.S().Warn("Standalone scheme could not support activate")
@@ -7,6 +7,8 @@
 package crypto
 
 import (
+	"github.com/pkg/errors"
+
 	"github.com/iotexproject/go-pkgs/hash"
 )
 
@@ -79,3 +81,50 @@ func (mk *Merkle) HashTree() hash.Hash256 {
 	mk.root = merkle[0]
 	return mk.root
 }
+
+// MerkleProof returns the audit path of the leaf at index, which are the sibling hashes from the leaf up to the root
+func (mk *Merkle) MerkleProof(index int) ([]hash.Hash256, error) {
+	if index < 0 || index >= mk.size {
+		return nil, errors.Errorf("invalid leaf index %d", index)
+	}
+
+	var proof []hash.Hash256
+	level := make([]hash.Hash256, mk.size)
+	copy(level, mk.leaf)
+	for len(level) > 1 {
+		if len(level)&1 != 0 {
+			level = append(level, level[len(level)-1])
+		}
+		proof = append(proof, level[index^1])
+
+		length := len(level) >> 1
+		for i := 0; i < length; i++ {
+			h := level[i<<1][:]
+			h = append(h, level[i<<1+1][:]...)
+			level[i] = hash.Hash256b(h)
+		}
+		level = level[0:length]
+		index >>= 1
+	}
+
+	return proof, nil
+}
+
+// VerifyMerkleProof verifies the leaf at index against the root hash with the audit path
+func VerifyMerkleProof(root hash.Hash256, leaf hash.Hash256, index int, proof []hash.Hash256) bool {
+	if index < 0 {
+		return false
+	}
+
+	h := leaf
+	for _, sibling := range proof {
+		if index&1 == 0 {
+			h = hash.Hash256b(append(h[:], sibling[:]...))
+		} else {
+			h = hash.Hash256b(append(sibling[:], h[:]...))
+		}
+		index >>= 1
+	}
+
+	return index == 0 && h == root
+}
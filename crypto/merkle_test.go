@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/go-pkgs/hash"
 )
@@ -41,3 +42,29 @@ func TestMerkleTree(t *testing.T) {
 	rootHashHex := hex.EncodeToString(rootHash[:])
 	assert.Equal(t, "4de26a6d1d6618f7bfeb3d168e37ef645db94c2d558bf8c3546d1311877ddffa", rootHashHex)
 }
+
+func TestMerkleProof(t *testing.T) {
+	require := require.New(t)
+
+	var inputs []hash.Hash256
+	for i := 0; i < 9; i++ {
+		inputs = append(inputs, hash.Hash256b([]byte{byte(i)}))
+		m := NewMerkleTree(inputs)
+		root := m.HashTree()
+		for j := range inputs {
+			proof, err := m.MerkleProof(j)
+			require.NoError(err)
+			require.True(VerifyMerkleProof(root, inputs[j], j, proof))
+			// wrong index or leaf fails the verification
+			require.False(VerifyMerkleProof(root, inputs[j], j+len(inputs)+1, proof))
+			require.False(VerifyMerkleProof(root, hash.ZeroHash256, j, proof))
+			if len(proof) > 0 {
+				require.False(VerifyMerkleProof(root, inputs[j], j, proof[1:]))
+			}
+		}
+		_, err := m.MerkleProof(-1)
+		require.Error(err)
+		_, err = m.MerkleProof(len(inputs) + 1)
+		require.Error(err)
+	}
+}
@@ -157,9 +157,13 @@ func TestCountingIndex(t *testing.T) {
 	cfg := config.Default.DB
 	cfg.DbPath = testPath
 
+	levelDB, cleanup := newTestLevelDB(t, "test-counting.level")
+	defer cleanup()
+
 	for _, v := range []KVStore{
 		NewMemKVStore(),
 		NewBoltDB(cfg),
+		levelDB,
 	} {
 		t.Run("test counting index", func(t *testing.T) {
 			testFunc(v, t)
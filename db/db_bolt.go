@@ -319,6 +319,41 @@ func (b *BoltDB) BucketExists(namespace string) bool {
 	return exist
 }
 
+// CopyTo copies all buckets into another KVStore, the writes are committed every batchSize records
+func (b *BoltDB) CopyTo(kv KVStore, batchSize int) error {
+	if batchSize <= 0 {
+		return errors.Wrapf(ErrInvalid, "invalid batch size %d", batchSize)
+	}
+	return b.db.View(func(tx *bolt.Tx) error {
+		kvb := batch.NewBatch()
+		if err := tx.ForEach(func(name []byte, bucket *bolt.Bucket) error {
+			ns := string(name)
+			return bucket.ForEach(func(k, v []byte) error {
+				kvb.Put(ns, k, v, "failed to copy key %x in bucket %x", k, name)
+				if kvb.Size() < batchSize {
+					return nil
+				}
+				return kv.WriteBatch(kvb)
+			})
+		}); err != nil {
+			return err
+		}
+		return kv.WriteBatch(kvb)
+	})
+}
+
+// ForEach calls the function on each record in the order of bucket and key
+func (b *BoltDB) ForEach(fn func(string, []byte, []byte) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, bucket *bolt.Bucket) error {
+			ns := string(name)
+			return bucket.ForEach(func(k, v []byte) error {
+				return fn(ns, k, v)
+			})
+		})
+	})
+}
+
 // ======================================
 // below functions used by RangeIndex
 // ======================================
@@ -0,0 +1,438 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// level DB has a single flat key space, the namespace (bucket in bolt DB) is emulated by key prefix:
+//
+// bucketPrefix + namespace ==> empty value, marks the existence of the namespace
+// dataPrefix + 2-byte length of namespace + namespace + key ==> value
+//
+// so the keys in a namespace are stored together and sorted in the same order as in a bolt DB bucket
+const (
+	bucketPrefix byte = 0
+	dataPrefix   byte = 1
+)
+
+// LevelDB is KVStore implementation based on LSM-tree level DB
+type LevelDB struct {
+	// mutex serializes the read-modify-write operations used by RangeIndex
+	mutex  sync.Mutex
+	db     *leveldb.DB
+	path   string
+	config config.DB
+}
+
+// NewLevelDB instantiates a LevelDB which implements KVStore
+func NewLevelDB(cfg config.DB) *LevelDB {
+	return &LevelDB{
+		db:     nil,
+		path:   cfg.DbPath,
+		config: cfg,
+	}
+}
+
+// Start opens the LevelDB (creates new directory if not existing yet)
+func (l *LevelDB) Start(_ context.Context) error {
+	db, err := leveldb.OpenFile(l.path, nil)
+	if err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	l.db = db
+	return nil
+}
+
+// Stop closes the LevelDB
+func (l *LevelDB) Stop(_ context.Context) error {
+	if l.db != nil {
+		if err := l.db.Close(); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+	}
+	return nil
+}
+
+// Put inserts a <key, value> record
+func (l *LevelDB) Put(namespace string, key, value []byte) error {
+	b := new(leveldb.Batch)
+	b.Put(bucketKey([]byte(namespace)), nil)
+	b.Put(dataKey([]byte(namespace), key), value)
+	if err := l.db.Write(b, nil); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
+// Get retrieves a record
+func (l *LevelDB) Get(namespace string, key []byte) ([]byte, error) {
+	value, err := l.db.Get(dataKey([]byte(namespace), key), nil)
+	switch err {
+	case nil:
+		return value, nil
+	case leveldb.ErrNotFound:
+		return nil, errors.Wrapf(ErrNotExist, "key = %x doesn't exist", key)
+	default:
+		return nil, errors.Wrap(ErrIO, err.Error())
+	}
+}
+
+// Filter returns <k, v> pair in a bucket that meet the condition
+func (l *LevelDB) Filter(namespace string, cond Condition, minKey, maxKey []byte) ([][]byte, [][]byte, error) {
+	if !l.BucketExists(namespace) {
+		return nil, nil, errors.Wrapf(ErrBucketNotExist, "bucket = %x doesn't exist", []byte(namespace))
+	}
+	prefix := dataKey([]byte(namespace), nil)
+	iter := l.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+
+	var (
+		fk, fv [][]byte
+		ok     bool
+	)
+	if len(minKey) > 0 {
+		ok = iter.Seek(dataKey([]byte(namespace), minKey))
+	} else {
+		ok = iter.First()
+	}
+	checkMax := len(maxKey) > 0
+	for ; ok; ok = iter.Next() {
+		k, v := iter.Key()[len(prefix):], iter.Value()
+		if checkMax && bytes.Compare(k, maxKey) == 1 {
+			break
+		}
+		if cond(k, v) {
+			fk = append(fk, copyBytes(k))
+			fv = append(fv, copyBytes(v))
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, nil, errors.Wrap(ErrIO, err.Error())
+	}
+
+	if len(fk) == 0 {
+		return nil, nil, errors.Wrap(ErrNotExist, "filter returns no match")
+	}
+	return fk, fv, nil
+}
+
+// Range retrieves values for a range of keys
+func (l *LevelDB) Range(namespace string, key []byte, count uint64) ([][]byte, error) {
+	if !l.BucketExists(namespace) {
+		return nil, errors.Wrapf(ErrNotExist, "bucket = %s doesn't exist", namespace)
+	}
+	iter := l.db.NewIterator(util.BytesPrefix(dataKey([]byte(namespace), nil)), nil)
+	defer iter.Release()
+
+	// seek to start
+	if !iter.Seek(dataKey([]byte(namespace), key)) {
+		return nil, errors.Wrapf(ErrNotExist, "entry for key 0x%x doesn't exist", key)
+	}
+	// retrieve 'count' items
+	value := make([][]byte, count)
+	for i := uint64(0); i < count; i++ {
+		if i > 0 && !iter.Next() {
+			return nil, errors.Wrapf(ErrNotExist, "entry for key 0x%x doesn't exist", key)
+		}
+		value[i] = copyBytes(iter.Value())
+	}
+	if err := iter.Error(); err != nil {
+		return nil, errors.Wrap(ErrIO, err.Error())
+	}
+	return value, nil
+}
+
+// GetBucketByPrefix retrieves all bucket those with const namespace prefix
+func (l *LevelDB) GetBucketByPrefix(namespace []byte) ([][]byte, error) {
+	allKey := make([][]byte, 0)
+	iter := l.db.NewIterator(util.BytesPrefix(bucketKey(namespace)), nil)
+	defer iter.Release()
+	for iter.Next() {
+		name := iter.Key()[1:]
+		if !bytes.Equal(name, namespace) {
+			allKey = append(allKey, copyBytes(name))
+		}
+	}
+	return allKey, iter.Error()
+}
+
+// GetKeyByPrefix retrieves all keys those with const prefix
+func (l *LevelDB) GetKeyByPrefix(namespace, prefix []byte) ([][]byte, error) {
+	if !l.BucketExists(string(namespace)) {
+		return nil, ErrNotExist
+	}
+	allKey := make([][]byte, 0)
+	nsPrefix := dataKey(namespace, nil)
+	iter := l.db.NewIterator(util.BytesPrefix(dataKey(namespace, prefix)), nil)
+	defer iter.Release()
+	for iter.Next() {
+		allKey = append(allKey, copyBytes(iter.Key()[len(nsPrefix):]))
+	}
+	return allKey, iter.Error()
+}
+
+// Delete deletes a record,if key is nil,this will delete the whole bucket
+func (l *LevelDB) Delete(namespace string, key []byte) error {
+	if !l.BucketExists(namespace) {
+		return nil
+	}
+	b := new(leveldb.Batch)
+	if key == nil {
+		b.Delete(bucketKey([]byte(namespace)))
+		iter := l.db.NewIterator(util.BytesPrefix(dataKey([]byte(namespace), nil)), nil)
+		for iter.Next() {
+			b.Delete(copyBytes(iter.Key()))
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+	} else {
+		b.Delete(dataKey([]byte(namespace), key))
+	}
+	if err := l.db.Write(b, nil); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
+// WriteBatch commits a batch
+func (l *LevelDB) WriteBatch(kvsb batch.KVStoreBatch) (err error) {
+	succeed := true
+	kvsb.Lock()
+	defer func() {
+		if succeed {
+			// clear the batch if commit succeeds
+			kvsb.ClearAndUnlock()
+		} else {
+			kvsb.Unlock()
+		}
+	}()
+
+	b := new(leveldb.Batch)
+	buckets := make(map[string]bool)
+	for i := 0; i < kvsb.Size(); i++ {
+		write, e := kvsb.Entry(i)
+		if e != nil {
+			succeed = false
+			return errors.Wrap(ErrIO, e.Error())
+		}
+		ns := write.Namespace()
+		switch write.WriteType() {
+		case batch.Put:
+			if !buckets[ns] {
+				b.Put(bucketKey([]byte(ns)), nil)
+				buckets[ns] = true
+			}
+			b.Put(dataKey([]byte(ns), write.Key()), write.Value())
+		case batch.Delete:
+			b.Delete(dataKey([]byte(ns), write.Key()))
+		}
+	}
+	if err = l.db.Write(b, nil); err != nil {
+		succeed = false
+		err = errors.Wrap(ErrIO, err.Error())
+	}
+	return err
+}
+
+// BucketExists returns true if bucket exists
+func (l *LevelDB) BucketExists(namespace string) bool {
+	exist, err := l.db.Has(bucketKey([]byte(namespace)), nil)
+	return err == nil && exist
+}
+
+// ForEach calls the function on each record in the order of namespace and key, the same order as in a bolt DB
+func (l *LevelDB) ForEach(fn func(string, []byte, []byte) error) error {
+	// the data keys are sorted by the length of namespace first, so iterate the namespaces in order of the bucket keys
+	var namespaces [][]byte
+	iter := l.db.NewIterator(util.BytesPrefix([]byte{bucketPrefix}), nil)
+	for iter.Next() {
+		namespaces = append(namespaces, copyBytes(iter.Key()[1:]))
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	for _, ns := range namespaces {
+		prefix := dataKey(ns, nil)
+		iter := l.db.NewIterator(util.BytesPrefix(prefix), nil)
+		for iter.Next() {
+			if err := fn(string(ns), iter.Key()[len(prefix):], iter.Value()); err != nil {
+				iter.Release()
+				return err
+			}
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+	}
+	return nil
+}
+
+// ======================================
+// below functions used by RangeIndex
+// ======================================
+
+// Insert inserts a value into the index
+func (l *LevelDB) Insert(name []byte, key uint64, value []byte) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.BucketExists(string(name)) {
+		return errors.Wrapf(ErrBucketNotExist, "bucket = %x doesn't exist", name)
+	}
+	iter := l.db.NewIterator(util.BytesPrefix(dataKey(name, nil)), nil)
+	defer iter.Release()
+
+	b := new(leveldb.Batch)
+	ak := byteutil.Uint64ToBytesBigEndian(key - 1)
+	k, v := seek(iter, name, ak)
+	if !bytes.Equal(k, ak) {
+		// insert new key
+		b.Put(dataKey(name, ak), v)
+	} else {
+		// update an existing key
+		k, _ = next(iter, name)
+	}
+	if k != nil {
+		b.Put(dataKey(name, k), value)
+	}
+	if err := l.db.Write(b, nil); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
+// Seek returns value by the key
+func (l *LevelDB) Seek(name []byte, key uint64) ([]byte, error) {
+	if !l.BucketExists(string(name)) {
+		return nil, errors.Wrapf(ErrBucketNotExist, "bucket = %x doesn't exist", name)
+	}
+	iter := l.db.NewIterator(util.BytesPrefix(dataKey(name, nil)), nil)
+	defer iter.Release()
+
+	// seek to start
+	_, v := seek(iter, name, byteutil.Uint64ToBytesBigEndian(key))
+	if v == nil {
+		v = []byte{}
+	}
+	return v, iter.Error()
+}
+
+// Remove removes an existing key
+func (l *LevelDB) Remove(name []byte, key uint64) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.BucketExists(string(name)) {
+		return errors.Wrapf(ErrBucketNotExist, "bucket = %x doesn't exist", name)
+	}
+	iter := l.db.NewIterator(util.BytesPrefix(dataKey(name, nil)), nil)
+	defer iter.Release()
+
+	ak := byteutil.Uint64ToBytesBigEndian(key - 1)
+	k, v := seek(iter, name, ak)
+	if !bytes.Equal(k, ak) {
+		// return nil if the key does not exist
+		return nil
+	}
+	b := new(leveldb.Batch)
+	b.Delete(dataKey(name, ak))
+	// write the corresponding value to next key
+	if k, _ = next(iter, name); k != nil {
+		b.Put(dataKey(name, k), v)
+	}
+	if err := l.db.Write(b, nil); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
+// Purge deletes an existing key and all keys before it
+func (l *LevelDB) Purge(name []byte, key uint64) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.BucketExists(string(name)) {
+		return errors.Wrapf(ErrBucketNotExist, "bucket = %x doesn't exist", name)
+	}
+	iter := l.db.NewIterator(util.BytesPrefix(dataKey(name, nil)), nil)
+	defer iter.Release()
+
+	nk, _ := seek(iter, name, byteutil.Uint64ToBytesBigEndian(key))
+	b := new(leveldb.Batch)
+	// delete all keys before this key
+	for k, _ := first(iter, name); k != nil && (nk == nil || bytes.Compare(k, nk) < 0); k, _ = next(iter, name) {
+		b.Delete(dataKey(name, k))
+	}
+	// write not exist value to next key
+	if nk != nil {
+		b.Put(dataKey(name, nk), NotExist)
+	}
+	if err := l.db.Write(b, nil); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
+// ======================================
+// private functions
+// ======================================
+
+func bucketKey(namespace []byte) []byte {
+	return append([]byte{bucketPrefix}, namespace...)
+}
+
+func dataKey(namespace, key []byte) []byte {
+	k := make([]byte, 3, 3+len(namespace)+len(key))
+	k[0] = dataPrefix
+	binary.BigEndian.PutUint16(k[1:], uint16(len(namespace)))
+	k = append(k, namespace...)
+	return append(k, key...)
+}
+
+func copyBytes(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
+
+// seek, first and next move the iterator of a namespace, and return the copied key (without prefix) and value
+func seek(iter iterator.Iterator, namespace, key []byte) ([]byte, []byte) {
+	return current(iter.Seek(dataKey(namespace, key)), iter, namespace)
+}
+
+func first(iter iterator.Iterator, namespace []byte) ([]byte, []byte) {
+	return current(iter.First(), iter, namespace)
+}
+
+func next(iter iterator.Iterator, namespace []byte) ([]byte, []byte) {
+	return current(iter.Next(), iter, namespace)
+}
+
+func current(ok bool, iter iterator.Iterator, namespace []byte) ([]byte, []byte) {
+	if !ok {
+		return nil, nil
+	}
+	return copyBytes(iter.Key()[3+len(namespace):]), copyBytes(iter.Value())
+}
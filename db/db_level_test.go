@@ -0,0 +1,159 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+// newTestLevelDB returns a LevelDB in a temp directory, and the function to remove the directory
+func newTestLevelDB(t *testing.T, name string) (*LevelDB, func()) {
+	testPath, err := testutil.PathOfTempFile(name)
+	require.NoError(t, err)
+	testutil.CleanupPath(t, testPath)
+	cfg := config.Default.DB
+	cfg.DbPath = testPath
+	return NewLevelDB(cfg), func() { testutil.CleanupPath(t, testPath) }
+}
+
+func TestNewKVStore(t *testing.T) {
+	require := require.New(t)
+
+	cfg := config.Default.DB
+	_, ok := NewKVStore(cfg).(*BoltDB)
+	require.True(ok)
+	cfg.DBType = config.LevelDBType
+	_, ok = NewKVStore(cfg).(*LevelDB)
+	require.True(ok)
+}
+
+func TestLevelDBNamespace(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv, cleanup := newTestLevelDB(t, "test-namespace.level")
+	defer cleanup()
+	require.NoError(kv.Start(ctx))
+
+	// namespace "ns" and "ns1" do not overlap, even though one is the prefix of the other
+	require.NoError(kv.Put("ns", []byte("1key"), []byte("value")))
+	require.NoError(kv.Put("ns1", []byte("key"), []byte("value1")))
+	v, err := kv.Get("ns", []byte("1key"))
+	require.NoError(err)
+	require.Equal([]byte("value"), v)
+	_, err = kv.Get("ns1", []byte("1key"))
+	require.Equal(ErrNotExist, errors.Cause(err))
+	keys, err := kv.GetKeyByPrefix([]byte("ns"), []byte("1"))
+	require.NoError(err)
+	require.Equal([][]byte{[]byte("1key")}, keys)
+	_, err = kv.GetKeyByPrefix([]byte("ns2"), nil)
+	require.Equal(ErrNotExist, errors.Cause(err))
+
+	buckets, err := kv.GetBucketByPrefix([]byte("ns"))
+	require.NoError(err)
+	require.Equal([][]byte{[]byte("ns1")}, buckets)
+	require.True(kv.BucketExists("ns"))
+	require.False(kv.BucketExists("ns2"))
+
+	// range
+	require.NoError(kv.Put("ns", []byte("2key"), []byte("value2")))
+	values, err := kv.Range("ns", []byte("1key"), 2)
+	require.NoError(err)
+	require.Equal([][]byte{[]byte("value"), []byte("value2")}, values)
+	_, err = kv.Range("ns", []byte("1key"), 3)
+	require.Equal(ErrNotExist, errors.Cause(err))
+	_, err = kv.Range("ns2", []byte("1key"), 1)
+	require.Equal(ErrNotExist, errors.Cause(err))
+
+	// data persists after restart
+	require.NoError(kv.Stop(ctx))
+	require.NoError(kv.Start(ctx))
+	defer func() {
+		require.NoError(kv.Stop(ctx))
+	}()
+	v, err = kv.Get("ns1", []byte("key"))
+	require.NoError(err)
+	require.Equal([]byte("value1"), v)
+	require.NoError(kv.Delete("ns", nil))
+	require.False(kv.BucketExists("ns"))
+	_, err = kv.Get("ns", []byte("2key"))
+	require.Equal(ErrNotExist, errors.Cause(err))
+	v, err = kv.Get("ns1", []byte("key"))
+	require.NoError(err)
+	require.Equal([]byte("value1"), v)
+}
+
+func TestBoltDBCopyTo(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	testPath, err := testutil.PathOfTempFile("test-copy.bolt")
+	require.NoError(err)
+	defer testutil.CleanupPath(t, testPath)
+	cfg := config.Default.DB
+	cfg.DbPath = testPath
+	bolt := NewBoltDB(cfg)
+	require.NoError(bolt.Start(ctx))
+	defer func() {
+		require.NoError(bolt.Stop(ctx))
+	}()
+	for i := range testK1 {
+		require.NoError(bolt.Put(bucket1, testK1[i], testV1[i]))
+		require.NoError(bolt.Put(bucket2, testK2[i], testV2[i]))
+	}
+	index, err := NewRangeIndex(bolt, []byte("range"), NotExist)
+	require.NoError(err)
+	require.NoError(index.Insert(7, []byte("7")))
+	// a longer namespace sorts before the shorter ones
+	require.NoError(bolt.Put("a-long-namespace", []byte("key"), []byte("value")))
+
+	level, cleanup := newTestLevelDB(t, "test-copy.level")
+	defer cleanup()
+	require.NoError(level.Start(ctx))
+	defer func() {
+		require.NoError(level.Stop(ctx))
+	}()
+	require.Equal(ErrInvalid, errors.Cause(bolt.CopyTo(level, 0)))
+	require.NoError(bolt.CopyTo(level, 2))
+
+	// both engines iterate the records in the same order
+	records := func(kv KVStoreWithForEach) []string {
+		var recs []string
+		require.NoError(kv.ForEach(func(ns string, k, v []byte) error {
+			recs = append(recs, fmt.Sprintf("%s/%x/%x", ns, k, v))
+			return nil
+		}))
+		return recs
+	}
+	require.Equal(records(bolt), records(level))
+	require.Len(records(level), 2*len(testK1)+3)
+
+	for i := range testK1 {
+		v, err := level.Get(bucket1, testK1[i])
+		require.NoError(err)
+		require.Equal(testV1[i], v)
+		v, err = level.Get(bucket2, testK2[i])
+		require.NoError(err)
+		require.Equal(testV2[i], v)
+	}
+	index, err = NewRangeIndex(level, []byte("range"), NotExist)
+	require.NoError(err)
+	v, err := index.Get(6)
+	require.NoError(err)
+	require.Equal(NotExist, v)
+	v, err = index.Get(7)
+	require.NoError(err)
+	require.Equal([]byte("7"), v)
+}
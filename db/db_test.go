@@ -63,9 +63,13 @@ func TestKVStorePutGet(t *testing.T) {
 	cfg := config.Default.DB
 	cfg.DbPath = testPath
 
+	levelDB, cleanup := newTestLevelDB(t, "test-kv-store.level")
+	defer cleanup()
+
 	for _, v := range []KVStore{
 		NewMemKVStore(),
 		NewBoltDB(cfg),
+		levelDB,
 	} {
 		t.Run("test put get", func(t *testing.T) {
 			testKVStorePutGet(v, t)
@@ -240,9 +244,13 @@ func TestDBBatch(t *testing.T) {
 	cfg := config.Default.DB
 	cfg.DbPath = testPath
 
+	levelDB, cleanup := newTestLevelDB(t, "test-batch-commit.level")
+	defer cleanup()
+
 	for _, v := range []KVStore{
 		NewMemKVStore(),
 		NewBoltDB(cfg),
+		levelDB,
 	} {
 		t.Run("test batch", func(t *testing.T) {
 			testBatchRollback(v, t)
@@ -293,9 +301,13 @@ func TestCacheKV(t *testing.T) {
 	cfg := config.Default.DB
 	cfg.DbPath = testPath
 
+	levelDB, cleanup := newTestLevelDB(t, "test-cache-kv.level")
+	defer cleanup()
+
 	for _, v := range []KVStore{
 		NewMemKVStore(),
 		NewBoltDB(cfg),
+		levelDB,
 	} {
 		t.Run("test cache kv", func(t *testing.T) {
 			testFunc(v, t)
@@ -342,6 +354,12 @@ func TestDeleteBucket(t *testing.T) {
 	t.Run("test delete bucket", func(t *testing.T) {
 		testFunc(NewBoltDB(cfg), t)
 	})
+
+	levelDB, cleanup := newTestLevelDB(t, "test-delete.level")
+	defer cleanup()
+	t.Run("test delete bucket on level DB", func(t *testing.T) {
+		testFunc(levelDB, t)
+	})
 }
 
 func TestFilter(t *testing.T) {
@@ -444,4 +462,10 @@ func TestFilter(t *testing.T) {
 	t.Run("test filter", func(t *testing.T) {
 		testFunc(NewBoltDB(cfg), t)
 	})
+
+	levelDB, cleanup := newTestLevelDB(t, "test-filter.level")
+	defer cleanup()
+	t.Run("test filter on level DB", func(t *testing.T) {
+		testFunc(levelDB, t)
+	})
 }
@@ -7,9 +7,9 @@
 package db
 
 import (
-	"github.com/iotexproject/iotex-core/pkg/lifecycle"
-
+	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 )
 
 type (
@@ -44,6 +44,14 @@ type (
 		Range(string, []byte, uint64) ([][]byte, error)
 	}
 
+	// KVStoreWithForEach is KVStore with ForEach() API
+	KVStoreWithForEach interface {
+		KVStore
+		// ForEach calls the function on each record in the order of namespace and key, until the function returns
+		// an error. The key and value are only valid during the call
+		ForEach(func(string, []byte, []byte) error) error
+	}
+
 	// KVStoreForRangeIndex is KVStore for range index
 	KVStoreForRangeIndex interface {
 		KVStore
@@ -61,3 +69,11 @@ type (
 		GetKeyByPrefix(namespace, prefix []byte) ([][]byte, error)
 	}
 )
+
+// NewKVStore creates a KVStore on the engine set in config, bolt DB by default
+func NewKVStore(cfg config.DB) KVStore {
+	if cfg.DBType == config.LevelDBType {
+		return NewLevelDB(cfg)
+	}
+	return NewBoltDB(cfg)
+}
@@ -21,6 +21,8 @@ var (
 	ErrBucketNotExist = errors.New("bucket not exist in DB")
 	// ErrNotExist indicates certain item does not exist in Blockchain database
 	ErrNotExist = errors.New("not exist in DB")
+	// ErrPruned indicates certain item has been pruned from Blockchain database
+	ErrPruned = errors.New("pruned from DB")
 	// ErrIO indicates the generic error of DB I/O operation
 	ErrIO = errors.New("DB I/O operation error")
 )
@@ -0,0 +1,160 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package mptrie
+
+import (
+	"bytes"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/db/trie/triepb"
+)
+
+// GetProof returns the serialized nodes on the path from root to the key. If the key doesn't exist,
+// the returned nodes prove its absence
+func (mpt *merklePatriciaTrie) GetProof(key []byte) ([][]byte, error) {
+	mpt.mutex.RLock()
+	defer mpt.mutex.RUnlock()
+
+	trieMtc.WithLabelValues("root", "GetProof").Inc()
+	kt, err := mpt.checkKeyType(key)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		proof  [][]byte
+		n      node = mpt.root
+		offset uint8
+	)
+	for n != nil {
+		if hn, ok := n.(*hashNode); ok {
+			if n, err = hn.LoadNode(); err != nil {
+				return nil, err
+			}
+		}
+		sn, ok := n.(serializable)
+		if !ok {
+			return nil, errors.Wrapf(trie.ErrInvalidTrie, "unexpected node type %T", n)
+		}
+		pb, err := sn.proto(false)
+		if err != nil {
+			return nil, err
+		}
+		ser, err := proto.Marshal(pb)
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, ser)
+		switch node := n.(type) {
+		case *branchNode:
+			n = node.children[kt[offset]]
+			offset++
+		case *extensionNode:
+			matched := node.commonPrefixLength(kt[offset:])
+			if matched != uint8(len(node.path)) {
+				return proof, nil
+			}
+			n = node.child
+			offset += matched
+		default:
+			return proof, nil
+		}
+	}
+
+	return proof, nil
+}
+
+// VerifyProof verifies the proof of key against the root hash. It returns the value of the key if the
+// proof shows it exists, or trie.ErrNotExist if the proof shows it is absent
+func VerifyProof(rootHash []byte, key []byte, proof [][]byte, options ...Option) ([]byte, error) {
+	mpt := &merklePatriciaTrie{
+		keyLength: len(key),
+		hashFunc:  DefaultHashFunc,
+	}
+	for _, opt := range options {
+		if err := opt(mpt); err != nil {
+			return nil, err
+		}
+	}
+	kt, err := mpt.checkKeyType(key)
+	if err != nil {
+		return nil, err
+	}
+	expected := rootHash
+	offset := 0
+	for i, ser := range proof {
+		if !bytes.Equal(mpt.hashFunc(ser), expected) {
+			return nil, errors.Wrapf(trie.ErrInvalidProof, "hash of node %d doesn't match", i)
+		}
+		last := i == len(proof)-1
+		pb := triepb.NodePb{}
+		if err := proto.Unmarshal(ser, &pb); err != nil {
+			return nil, errors.Wrapf(trie.ErrInvalidProof, "failed to unmarshal node %d", i)
+		}
+		if pbBranch := pb.GetBranch(); pbBranch != nil {
+			if offset >= len(kt) {
+				return nil, errors.Wrapf(trie.ErrInvalidProof, "unexpected branch node %d", i)
+			}
+			expected = nil
+			for _, b := range pbBranch.Branches {
+				if b.Index == uint32(kt[offset]) {
+					expected = b.Path
+					break
+				}
+			}
+			if expected == nil {
+				if last {
+					return nil, errors.Wrapf(trie.ErrNotExist, "key %x does not exist", kt)
+				}
+				return nil, errors.Wrapf(trie.ErrInvalidProof, "branch node %d has no child %x", i, kt[offset])
+			}
+			offset++
+			continue
+		}
+		if pbExtend := pb.GetExtend(); pbExtend != nil {
+			if offset+len(pbExtend.Path) > len(kt) || !bytes.Equal(pbExtend.Path, kt[offset:offset+len(pbExtend.Path)]) {
+				if last {
+					return nil, errors.Wrapf(trie.ErrNotExist, "key %x does not exist", kt)
+				}
+				return nil, errors.Wrapf(trie.ErrInvalidProof, "extension node %d doesn't match the key", i)
+			}
+			expected = pbExtend.Value
+			offset += len(pbExtend.Path)
+			continue
+		}
+		if pbLeaf := pb.GetLeaf(); pbLeaf != nil {
+			if !last {
+				return nil, errors.Wrapf(trie.ErrInvalidProof, "unexpected leaf node %d", i)
+			}
+			if !bytes.Equal(pbLeaf.Path, kt) {
+				return nil, errors.Wrapf(trie.ErrNotExist, "key %x does not exist", kt)
+			}
+			return pbLeaf.Value, nil
+		}
+		return nil, errors.Wrapf(trie.ErrInvalidProof, "invalid node type of node %d", i)
+	}
+
+	return nil, errors.Wrap(trie.ErrInvalidProof, "incomplete proof")
+}
+
+// VerifyTwoLayerProof verifies the proofs of a two layer trie against the root hash of layer one
+func VerifyTwoLayerProof(
+	rootHash []byte,
+	layerOneKey []byte,
+	layerTwoKey []byte,
+	layerOneProof [][]byte,
+	layerTwoProof [][]byte,
+) ([]byte, error) {
+	layerTwoRootHash, err := VerifyProof(rootHash, layerOneKey, layerOneProof)
+	if err != nil {
+		return nil, err
+	}
+
+	return VerifyProof(layerTwoRootHash, layerTwoKey, layerTwoProof)
+}
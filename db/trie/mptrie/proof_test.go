@@ -0,0 +1,152 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package mptrie
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+
+	"github.com/iotexproject/iotex-core/db/trie"
+)
+
+func TestProof(t *testing.T) {
+	for _, async := range []bool{false, true} {
+		require := require.New(t)
+		opts := []Option{KVStoreOption(trie.NewMemKVStore()), KeyLengthOption(8)}
+		if async {
+			opts = append(opts, AsyncOption())
+		}
+		tr, err := New(opts...)
+		require.NoError(err)
+		require.NoError(tr.Start(context.Background()))
+
+		// proof of absence in an empty trie
+		root, err := tr.RootHash()
+		require.NoError(err)
+		proof, err := tr.GetProof(cat)
+		require.NoError(err)
+		require.Equal(1, len(proof))
+		_, err = VerifyProof(root, cat, proof)
+		require.Equal(trie.ErrNotExist, errors.Cause(err))
+
+		keys := [][]byte{ham, car, cat, dog, egg, fox, cow, ant}
+		for i, k := range keys {
+			require.NoError(tr.Upsert(k, testV[i]))
+		}
+		root, err = tr.RootHash()
+		require.NoError(err)
+		for i, k := range keys {
+			proof, err := tr.GetProof(k)
+			require.NoError(err)
+			v, err := VerifyProof(root, k, proof)
+			require.NoError(err)
+			require.Equal(testV[i], v)
+			// tampered value fails the verification
+			tampered := make([][]byte, len(proof))
+			copy(tampered, proof)
+			leaf := append([]byte{}, proof[len(proof)-1]...)
+			leaf[len(leaf)-1]++
+			tampered[len(tampered)-1] = leaf
+			_, err = VerifyProof(root, k, tampered)
+			require.Equal(trie.ErrInvalidProof, errors.Cause(err))
+			// incomplete proof fails the verification
+			_, err = VerifyProof(root, k, proof[:len(proof)-1])
+			require.Equal(trie.ErrInvalidProof, errors.Cause(err))
+		}
+
+		// proof of absence
+		for _, k := range [][]byte{rat, br1, br2, cl1, cl2} {
+			proof, err := tr.GetProof(k)
+			require.NoError(err)
+			_, err = VerifyProof(root, k, proof)
+			require.Equal(trie.ErrNotExist, errors.Cause(err))
+		}
+		// proof against a wrong root
+		proof, err = tr.GetProof(cat)
+		require.NoError(err)
+		_, err = VerifyProof(emptyTrieRootHash, cat, proof)
+		require.Equal(trie.ErrInvalidProof, errors.Cause(err))
+		// wrong key length
+		_, err = VerifyProof(root, cat, proof, KeyLengthOption(20))
+		require.Error(err)
+		require.NoError(tr.Stop(context.Background()))
+	}
+}
+
+func TestProofWithHashFunc(t *testing.T) {
+	require := require.New(t)
+
+	hashFunc := func(data []byte) []byte {
+		h := hash.Hash256b(data)
+		return h[:]
+	}
+	kvStore := trie.NewMemKVStore()
+	tr, err := New(KVStoreOption(kvStore), KeyLengthOption(8), HashFuncOption(hashFunc))
+	require.NoError(err)
+	require.NoError(tr.Start(context.Background()))
+	require.NoError(tr.Upsert(cat, testV[2]))
+	require.NoError(tr.Upsert(rat, []byte("rat")))
+	root, err := tr.RootHash()
+	require.NoError(err)
+	require.NoError(tr.Stop(context.Background()))
+
+	tr, err = New(KVStoreOption(kvStore), KeyLengthOption(8), HashFuncOption(hashFunc), RootHashOption(root))
+	require.NoError(err)
+	require.NoError(tr.Start(context.Background()))
+	proof, err := tr.GetProof(rat)
+	require.NoError(err)
+	v, err := VerifyProof(root, rat, proof, HashFuncOption(hashFunc))
+	require.NoError(err)
+	require.Equal([]byte("rat"), v)
+	_, err = VerifyProof(root, rat, proof)
+	require.Equal(trie.ErrInvalidProof, errors.Cause(err))
+	require.NoError(tr.Stop(context.Background()))
+}
+
+func TestTwoLayerTrieProof(t *testing.T) {
+	require := require.New(t)
+
+	tlt := NewTwoLayerTrie(trie.NewMemKVStore(), "rootKey")
+	require.NoError(tlt.Start(context.Background()))
+	defer require.NoError(tlt.Stop(context.Background()))
+	layerOneKey := []byte("layerOneKey111111111")
+	require.NoError(tlt.Upsert(layerOneKey, []byte("layerTwoKey1"), []byte("value1")))
+	require.NoError(tlt.Upsert(layerOneKey, []byte("layerTwoKey2"), []byte("value2")))
+	require.NoError(tlt.Upsert([]byte("layerOneKey222222222"), []byte("layerTwoKey1"), []byte("value3")))
+
+	// the root hash has to be computed before getting the proof
+	_, _, err := tlt.GetProof(layerOneKey, []byte("layerTwoKey2"))
+	require.Error(err)
+	root, err := tlt.RootHash()
+	require.NoError(err)
+	p1, p2, err := tlt.GetProof(layerOneKey, []byte("layerTwoKey2"))
+	require.NoError(err)
+	v, err := VerifyTwoLayerProof(root, layerOneKey, []byte("layerTwoKey2"), p1, p2)
+	require.NoError(err)
+	require.Equal([]byte("value2"), v)
+	// getting the proof doesn't change the trie
+	newRoot, err := tlt.RootHash()
+	require.NoError(err)
+	require.Equal(root, newRoot)
+
+	// absent in layer two
+	p1, p2, err = tlt.GetProof(layerOneKey, []byte("layerTwoKey3"))
+	require.NoError(err)
+	_, err = VerifyTwoLayerProof(root, layerOneKey, []byte("layerTwoKey3"), p1, p2)
+	require.Equal(trie.ErrNotExist, errors.Cause(err))
+
+	// absent in layer one
+	p1, p2, err = tlt.GetProof([]byte("layerOneKey333333333"), []byte("layerTwoKey1"))
+	require.NoError(err)
+	_, err = VerifyTwoLayerProof(root, []byte("layerOneKey333333333"), []byte("layerTwoKey1"), p1, p2)
+	require.Equal(trie.ErrNotExist, errors.Cause(err))
+}
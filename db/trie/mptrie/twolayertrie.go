@@ -177,6 +177,30 @@ func (tlt *twoLayerTrie) Upsert(layerOneKey []byte, layerTwoKey []byte, value []
 	return nil
 }
 
+// GetProof returns the proofs against the root hash, which has to be computed after the last change
+func (tlt *twoLayerTrie) GetProof(layerOneKey []byte, layerTwoKey []byte) ([][]byte, [][]byte, error) {
+	// layer one doesn't reflect the pending changes of layer two until they are flushed
+	for _, lt := range tlt.layerTwoMap {
+		if lt.dirty {
+			return nil, nil, errors.New("failed to get proof of a trie with pending changes")
+		}
+	}
+	layerOneProof, err := tlt.layerOne.GetProof(layerOneKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	lt, err := tlt.layerTwoTrie(layerOneKey, len(layerTwoKey))
+	if err != nil {
+		return nil, nil, err
+	}
+	layerTwoProof, err := lt.tr.GetProof(layerTwoKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return layerOneProof, layerTwoProof, nil
+}
+
 func (tlt *twoLayerTrie) Delete(layerOneKey []byte, layerTwoKey []byte) error {
 	lt, err := tlt.layerTwoTrie(layerOneKey, len(layerTwoKey))
 	if err != nil {
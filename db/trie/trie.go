@@ -21,6 +21,9 @@ var (
 
 	// ErrEndOfIterator defines an error which will be returned
 	ErrEndOfIterator = errors.New("hit the end of the iterator, no more item")
+
+	// ErrInvalidProof indicates the proof doesn't match the root hash or the key
+	ErrInvalidProof = errors.New("invalid trie proof")
 )
 
 type (
@@ -41,6 +44,8 @@ type (
 		Get([]byte) ([]byte, error)
 		// Delete deletes an entry
 		Delete([]byte) error
+		// GetProof returns the serialized nodes on the path from root to the key
+		GetProof([]byte) ([][]byte, error)
 		// RootHash returns trie's root hash
 		RootHash() ([]byte, error)
 		// SetRootHash sets a new root to trie
@@ -64,5 +69,7 @@ type (
 		Upsert([]byte, []byte, []byte) error
 		// Delete deletes an item in layer two
 		Delete([]byte, []byte) error
+		// GetProof returns the proofs of an item in layer one and layer two
+		GetProof([]byte, []byte) ([][]byte, [][]byte, error)
 	}
 )
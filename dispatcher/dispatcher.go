@@ -19,6 +19,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/p2p"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	goproto "github.com/iotexproject/iotex-proto/golang"
@@ -31,7 +32,9 @@ type Subscriber interface {
 	HandleAction(context.Context, *iotextypes.Action) error
 	HandleBlock(context.Context, *iotextypes.Block) error
 	HandleBlockSync(context.Context, *iotextypes.Block) error
-	HandleSyncRequest(context.Context, peerstore.PeerInfo, *iotexrpc.BlockSync) error
+	HandleSyncRequest(context.Context, peerstore.PeerInfo, proto.Message) error
+	HandleBlockHeaders(context.Context, *iotexrpc.BlockHeaders) error
+	HandleBlockBodies(context.Context, *iotexrpc.BlockBodies) error
 	HandleConsensusMsg(*iotextypes.ConsensusMessage) error
 }
 
@@ -76,7 +79,8 @@ func (m blockMsg) ChainID() uint32 {
 type blockSyncMsg struct {
 	ctx     context.Context
 	chainID uint32
-	sync    *iotexrpc.BlockSync
+	msgType iotexrpc.MessageType
+	sync    proto.Message
 	peer    peerstore.PeerInfo
 }
 
@@ -84,6 +88,18 @@ func (m blockSyncMsg) ChainID() uint32 {
 	return m.chainID
 }
 
+// blockPartsMsg packages a proto message of the block headers or bodies received in header-first sync.
+type blockPartsMsg struct {
+	ctx     context.Context
+	chainID uint32
+	msgType iotexrpc.MessageType
+	parts   proto.Message
+}
+
+func (m blockPartsMsg) ChainID() uint32 {
+	return m.chainID
+}
+
 // actionMsg packages a proto action message.
 type actionMsg struct {
 	ctx     context.Context
@@ -185,6 +201,8 @@ loop:
 				d.handleActionMsg(msg)
 			case *blockMsg:
 				d.handleBlockMsg(msg)
+			case *blockPartsMsg:
+				d.handleBlockPartsMsg(msg)
 			default:
 				log.L().Warn("Invalid message type in block handler.", zap.Any("msg", msg))
 			}
@@ -248,18 +266,48 @@ func (d *IotxDispatcher) handleBlockMsg(m *blockMsg) {
 	}
 }
 
+// handleBlockPartsMsg handles block headers and bodies messages from peers.
+func (d *IotxDispatcher) handleBlockPartsMsg(m *blockPartsMsg) {
+	log.L().Debug("receive blockPartsMsg.", zap.Stringer("msgType", m.msgType))
+
+	d.subscribersMU.RLock()
+	subscriber, ok := d.subscribers[m.ChainID()]
+	d.subscribersMU.RUnlock()
+	if !ok {
+		log.L().Info("No subscriber specified in the dispatcher.", zap.Uint32("chainID", m.ChainID()))
+		return
+	}
+	d.updateEventAudit(m.msgType)
+	var err error
+	switch parts := m.parts.(type) {
+	case *iotexrpc.BlockHeaders:
+		err = subscriber.HandleBlockHeaders(m.ctx, parts)
+	case *iotexrpc.BlockBodies:
+		err = subscriber.HandleBlockBodies(m.ctx, parts)
+	}
+	if err != nil {
+		log.L().Error("Fail to handle the block parts.", zap.Error(err))
+	}
+}
+
 // handleBlockSyncMsg handles block messages from peers.
 func (d *IotxDispatcher) handleBlockSyncMsg(m *blockSyncMsg) {
+	// the block, header and body sync requests all request a height range
+	req := m.sync.(interface {
+		GetStart() uint64
+		GetEnd() uint64
+	})
 	log.L().Debug("Receive blockSyncMsg.",
 		zap.String("src", fmt.Sprintf("%v", m.peer)),
-		zap.Uint64("start", m.sync.Start),
-		zap.Uint64("end", m.sync.End))
+		zap.Stringer("msgType", m.msgType),
+		zap.Uint64("start", req.GetStart()),
+		zap.Uint64("end", req.GetEnd()))
 
 	d.subscribersMU.RLock()
 	subscriber, ok := d.subscribers[m.ChainID()]
 	d.subscribersMU.RUnlock()
 	if ok {
-		d.updateEventAudit(iotexrpc.MessageType_BLOCK_REQUEST)
+		d.updateEventAudit(m.msgType)
 		// dispatch to block sync
 		if err := subscriber.HandleSyncRequest(m.ctx, m.peer, m.sync); err != nil {
 			log.L().Error("Failed to handle sync request.", zap.Error(err))
@@ -293,8 +341,32 @@ func (d *IotxDispatcher) dispatchBlockCommit(ctx context.Context, chainID uint32
 	})
 }
 
+// dispatchBlockParts adds the passed block headers or bodies message to the news handling queue.
+func (d *IotxDispatcher) dispatchBlockParts(
+	ctx context.Context,
+	chainID uint32,
+	msgType iotexrpc.MessageType,
+	msg proto.Message,
+) {
+	if atomic.LoadInt32(&d.shutdown) != 0 {
+		return
+	}
+	d.enqueueEvent(&blockPartsMsg{
+		ctx:     ctx,
+		chainID: chainID,
+		msgType: msgType,
+		parts:   msg,
+	})
+}
+
 // dispatchBlockSyncReq adds the passed block sync request to the news handling queue.
-func (d *IotxDispatcher) dispatchBlockSyncReq(ctx context.Context, chainID uint32, peer peerstore.PeerInfo, msg proto.Message) {
+func (d *IotxDispatcher) dispatchBlockSyncReq(
+	ctx context.Context,
+	chainID uint32,
+	peer peerstore.PeerInfo,
+	msgType iotexrpc.MessageType,
+	msg proto.Message,
+) {
 	if atomic.LoadInt32(&d.shutdown) != 0 {
 		return
 	}
@@ -306,8 +378,9 @@ func (d *IotxDispatcher) dispatchBlockSyncReq(ctx context.Context, chainID uint3
 	d.syncChan <- &blockSyncMsg{
 		ctx:     ctx,
 		chainID: chainID,
+		msgType: msgType,
 		peer:    peer,
-		sync:    (msg).(*iotexrpc.BlockSync),
+		sync:    msg,
 	}
 }
 
@@ -346,10 +419,15 @@ func (d *IotxDispatcher) HandleTell(ctx context.Context, chainID uint32, peer pe
 		log.L().Warn("Unexpected message handled by HandleTell.", zap.Error(err))
 	}
 	switch msgType {
-	case iotexrpc.MessageType_BLOCK_REQUEST:
-		d.dispatchBlockSyncReq(ctx, chainID, peer, message)
+	case iotexrpc.MessageType_BLOCK_REQUEST,
+		iotexrpc.MessageType_BLOCK_HEADER_REQUEST,
+		iotexrpc.MessageType_BLOCK_BODY_REQUEST:
+		d.dispatchBlockSyncReq(ctx, chainID, peer, msgType, message)
 	case iotexrpc.MessageType_BLOCK:
-		d.dispatchBlockCommit(ctx, chainID, message)
+		// the peer which the block is received from is tracked by block sync
+		d.dispatchBlockCommit(p2p.WithPeer(ctx, peer), chainID, message)
+	case iotexrpc.MessageType_BLOCK_HEADERS, iotexrpc.MessageType_BLOCK_BODIES:
+		d.dispatchBlockParts(p2p.WithPeer(ctx, peer), chainID, msgType, message)
 	default:
 		log.L().Warn("Unexpected msgType handled by HandleTell.", zap.Any("msgType", msgType))
 	}
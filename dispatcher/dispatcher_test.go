@@ -52,6 +52,10 @@ func setTestCase() []proto.Message {
 		&iotextypes.ConsensusMessage{},
 		&iotextypes.Block{},
 		&iotexrpc.BlockSync{},
+		&iotexrpc.BlockHeaderSync{},
+		&iotexrpc.BlockHeaders{},
+		&iotexrpc.BlockBodySync{},
+		&iotexrpc.BlockBodies{},
 		&testingpb.TestPayload{},
 	}
 }
@@ -92,10 +96,16 @@ func (s *DummySubscriber) HandleBlock(context.Context, *iotextypes.Block) error
 
 func (s *DummySubscriber) HandleBlockSync(context.Context, *iotextypes.Block) error { return nil }
 
-func (s *DummySubscriber) HandleSyncRequest(context.Context, peerstore.PeerInfo, *iotexrpc.BlockSync) error {
+func (s *DummySubscriber) HandleSyncRequest(context.Context, peerstore.PeerInfo, proto.Message) error {
 	return nil
 }
 
+func (s *DummySubscriber) HandleBlockHeaders(context.Context, *iotexrpc.BlockHeaders) error {
+	return nil
+}
+
+func (s *DummySubscriber) HandleBlockBodies(context.Context, *iotexrpc.BlockBodies) error { return nil }
+
 func (s *DummySubscriber) HandleAction(context.Context, *iotextypes.Action) error { return nil }
 
 func (s *DummySubscriber) HandleConsensusMsg(*iotextypes.ConsensusMessage) error { return nil }
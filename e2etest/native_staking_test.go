@@ -62,6 +62,7 @@ func TestNativeStaking(t *testing.T) {
 			identityset.Address(23).String(),
 			"test1",
 			selfStake.String(),
+			0,
 		},
 		{
 			identityset.Address(24).String(),
@@ -69,6 +70,7 @@ func TestNativeStaking(t *testing.T) {
 			identityset.Address(25).String(),
 			"test2",
 			selfStake.String(),
+			0,
 		},
 	}
 
@@ -34,6 +34,7 @@ require (
 	github.com/schollz/progressbar/v2 v2.15.0
 	github.com/spf13/cobra v0.0.4
 	github.com/stretchr/testify v1.4.0
+	github.com/syndtr/goleveldb v1.0.0
 	go.etcd.io/bbolt v1.3.5
 	go.uber.org/automaxprocs v1.2.0
 	go.uber.org/config v1.3.1
@@ -50,3 +51,7 @@ require (
 replace github.com/ethereum/go-ethereum => github.com/iotexproject/go-ethereum v0.3.1
 
 replace golang.org/x/xerrors => golang.org/x/xerrors v0.0.0-20190212162355-a5947ffaace3
+
+// the api and type definitions not released in iotex-proto yet are carried in third_party/iotex-proto, see
+// third_party/iotex-proto/UPSTREAM.md for the list and the steps to drop this replace
+replace github.com/iotexproject/iotex-proto => ./third_party/iotex-proto
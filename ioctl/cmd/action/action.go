@@ -26,6 +26,7 @@ import (
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/ioctl/cmd/account"
+	"github.com/iotexproject/iotex-core/ioctl/cmd/bc"
 	"github.com/iotexproject/iotex-core/ioctl/config"
 	"github.com/iotexproject/iotex-core/ioctl/flag"
 	"github.com/iotexproject/iotex-core/ioctl/output"
@@ -282,6 +283,14 @@ func SendAction(elp action.Envelope, signer string) error {
 
 	defer prvKey.Zero()
 
+	// sign with the chain ID once the chain accepts it, so the action cannot be replayed on another chain
+	chainID, err := getChainID()
+	if err != nil {
+		return err
+	}
+	if chainID != 0 {
+		elp.SetChainID(chainID)
+	}
 	sealed, err := action.Sign(elp, prvKey)
 	prvKey.Zero()
 	if err != nil {
@@ -387,6 +396,23 @@ func Read(contract address.Address, amount *big.Int, bytecode []byte) (string, e
 	return "", output.NewError(output.NetworkError, "failed to invoke ReadContract api", err)
 }
 
+// getChainID returns the chain ID set in config, or the chain ID of the endpoint if it is not set. It returns 0 if
+// the next block of the endpoint is before Hawaii, which rejects the actions signed with chain ID, or if the endpoint
+// does not report the chain ID
+func getChainID() (uint32, error) {
+	chainMeta, err := bc.GetChainMeta()
+	if err != nil {
+		return 0, output.NewError(0, "failed to get chain meta", err)
+	}
+	if chainMeta.Height+1 < config.ReadConfig.Hawaiiheight {
+		return 0, nil
+	}
+	if config.ReadConfig.ChainID != 0 {
+		return config.ReadConfig.ChainID, nil
+	}
+	return chainMeta.ChainID, nil
+}
+
 func isBalanceEnough(address string, act action.SealedEnvelope) error {
 	accountMeta, err := account.GetAccountMeta(address)
 	if err != nil {
@@ -225,6 +225,14 @@ func printActionProto(action *iotextypes.Action) (string, error) {
 		}
 		result += "  >\n" +
 			">\n"
+	case action.Core.GetPutDoubleSignEvidence() != nil:
+		evidence := action.Core.GetPutDoubleSignEvidence()
+		result += "putDoubleSignEvidence: <\n" +
+			fmt.Sprintf("  topic: %s\n", iotextypes.ConsensusVote_Topic(evidence.Topic))
+		for _, en := range evidence.Endorsements {
+			result += fmt.Sprintf("  endorser: %x\n", en.Endorser)
+		}
+		result += ">\n"
 	}
 	result += fmt.Sprintf("senderPubKey: %x\n", action.SenderPubKey) +
 		fmt.Sprintf("signature: %x\n", action.Signature)
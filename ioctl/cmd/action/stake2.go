@@ -59,6 +59,9 @@ func init() {
 	Stake2Cmd.AddCommand(stake2ReleaseCmd)
 	Stake2Cmd.AddCommand(stake2RegisterCmd)
 	Stake2Cmd.AddCommand(stake2ChangeCmd)
+	Stake2Cmd.AddCommand(stake2AuthorizeCmd)
+	Stake2Cmd.AddCommand(stake2SplitCmd)
+	Stake2Cmd.AddCommand(stake2MergeCmd)
 	Stake2Cmd.PersistentFlags().StringVar(&config.ReadConfig.Endpoint, "endpoint", config.ReadConfig.Endpoint, config.TranslateInLang(stake2FlagEndpointUsages, config.UILanguage))
 	Stake2Cmd.PersistentFlags().BoolVar(&config.Insecure, "insecure", config.Insecure, config.TranslateInLang(stake2FlagInsecureUsages, config.UILanguage))
 }
@@ -0,0 +1,152 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+	"github.com/iotexproject/iotex-core/ioctl/util"
+)
+
+// Multi-language support
+var (
+	stake2AuthorizeCmdUses = map[config.Language]string{
+		config.English: "authorize (restake|deposit|changeCandidate) BUCKET_INDEX (STAKE_DURATION|AMOUNT_IOTX|CANDIDATE_NAME) EXPIRY_HEIGHT TYPE [--auto-stake]" +
+			" [-s SIGNER] [-n NONCE] [-l GAS_LIMIT] [-p GAS_PRICE] [-P PASSWORD] [-y]",
+		config.Chinese: "authorize (restake|deposit|changeCandidate) 票索引 (投票持续时间|IOTX数量|候选人名字) 过期高度 类型 [--auto-stake]" +
+			" [-s 签署人] [-n NONCE] [-l GAS限制] [-p GAS价格] [-P 密码] [-y]",
+	}
+
+	stake2AuthorizeCmdShorts = map[config.Language]string{
+		config.English: "Operate on bucket on IoTeX blockchain with authorization of bucket owner",
+		config.Chinese: "经投票所有者授权操作IoTeX区块链上的投票",
+	}
+)
+
+// stake2AuthorizeCmd represents the stake2 authorize command
+var stake2AuthorizeCmd = &cobra.Command{
+	Use:   config.TranslateInLang(stake2AuthorizeCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(stake2AuthorizeCmdShorts, config.UILanguage),
+	Args:  cobra.ExactArgs(5),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := stake2Authorize(args)
+		return output.PrintError(err)
+	},
+}
+
+func init() {
+	RegisterWriteCommand(stake2AuthorizeCmd)
+	stake2AuthorizeCmd.Flags().BoolVar(&stake2AutoStake, "auto-stake", false,
+		config.TranslateInLang(stake2FlagAutoStakeUsages, config.UILanguage))
+}
+
+func stake2Authorize(args []string) error {
+	bucketIndex, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return output.NewError(output.ConvertError, "failed to convert bucket index", nil)
+	}
+	expiry, err := strconv.ParseUint(args[3], 10, 64)
+	if err != nil {
+		return output.NewError(output.ConvertError, "failed to convert expiry height", nil)
+	}
+
+	sender, err := Signer()
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get signed address", err)
+	}
+	nonce, err := nonce(sender)
+	if err != nil {
+		return output.NewError(0, "failed to get nonce ", err)
+	}
+	gasPriceRau, err := gasPriceInRau()
+	if err != nil {
+		return output.NewError(0, "failed to get gas price", err)
+	}
+
+	// the envelope of the action to be authorized, the authorization is put into the payload
+	newEnvelope := func(payload []byte) (action.Envelope, error) {
+		eb := (&action.EnvelopeBuilder{}).SetNonce(nonce).SetGasPrice(gasPriceRau)
+		gasLimit := gasLimitFlag.Value().(uint64)
+		switch args[0] {
+		case action.AuthorizeRestake:
+			stakeDuration, err := parseStakeDuration(args[2])
+			if err != nil {
+				return action.Envelope{}, err
+			}
+			if gasLimit == 0 {
+				gasLimit = action.RestakeBaseIntrinsicGas + action.RestakePayloadGas*uint64(len(payload))
+			}
+			act, err := action.NewRestake(nonce, bucketIndex, uint32(stakeDuration.Uint64()), stake2AutoStake, payload, gasLimit, gasPriceRau)
+			if err != nil {
+				return action.Envelope{}, err
+			}
+			eb.SetAction(act)
+		case action.AuthorizeDepositToStake:
+			amountInRau, err := util.StringToRau(args[2], util.IotxDecimalNum)
+			if err != nil {
+				return action.Envelope{}, output.NewError(output.ConvertError, "invalid amount", err)
+			}
+			if gasLimit == 0 {
+				gasLimit = action.DepositToStakeBaseIntrinsicGas + action.DepositToStakePayloadGas*uint64(len(payload))
+			}
+			act, err := action.NewDepositToStake(nonce, bucketIndex, amountInRau.String(), payload, gasLimit, gasPriceRau)
+			if err != nil {
+				return action.Envelope{}, err
+			}
+			eb.SetAction(act)
+		case action.AuthorizeChangeCandidate:
+			if gasLimit == 0 {
+				gasLimit = action.MoveStakeBaseIntrinsicGas + action.MoveStakePayloadGas*uint64(len(payload))
+			}
+			act, err := action.NewChangeCandidate(nonce, args[2], bucketIndex, payload, gasLimit, gasPriceRau)
+			if err != nil {
+				return action.Envelope{}, err
+			}
+			eb.SetAction(act)
+		default:
+			return action.Envelope{}, output.NewError(output.InputError, "invalid operation "+args[0], nil)
+		}
+		return eb.SetGasLimit(gasLimit).Build(), nil
+	}
+	elp, err := newEnvelope(nil)
+	if err != nil {
+		return output.NewError(output.InstantiationError, "failed to make an action instance", err)
+	}
+
+	// construct authorization message
+	msg, err := action.NewAuthorizeMsg(args[4], sender, elp.Action(), nonce, expiry)
+	if err != nil {
+		return output.NewError(output.InputError, "failed to create authorization message", err)
+	}
+	fmt.Printf("Here's the authorization message:\n\n")
+	fmt.Println(string(msg))
+
+	// ask user to input signature
+	sig, err := readSigFromStdin([]string{args[1], args[4]})
+	if err != nil {
+		return output.NewError(output.InputError, "failed to generate signature", err)
+	}
+	fmt.Println()
+
+	// construct authorization JSON
+	payload, err := action.NewAuthorizeJSON(args[4], sender, sig, elp.Action(), nonce, expiry)
+	if err != nil {
+		return output.NewError(output.InputError, "failed to create authorization JSON", err)
+	}
+	elp, err = newEnvelope(payload)
+	if err != nil {
+		return output.NewError(output.InstantiationError, "failed to make an action instance", err)
+	}
+	return SendAction(elp, sender)
+}
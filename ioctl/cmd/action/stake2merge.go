@@ -0,0 +1,90 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+)
+
+// Multi-language support
+var (
+	stake2MergeCmdUses = map[config.Language]string{
+		config.English: "merge BUCKET_INDEX MERGED_BUCKET_INDEX..." +
+			" [-s SIGNER] [-n NONCE] [-l GAS_LIMIT] [-p GAS_PRICE] [-P PASSWORD] [-y]",
+		config.Chinese: "merge 票索引 被合并票索引..." +
+			" [-s 签署人] [-n NONCE] [-l GAS限制] [-p GAS价格] [-P 密码] [-y]",
+	}
+	stake2MergeCmdShorts = map[config.Language]string{
+		config.English: "Merge buckets into bucket on IoTeX blockchain",
+		config.Chinese: "在IoTeX区块链上合并投票",
+	}
+)
+
+// stake2MergeCmd represents the stake2 merge command
+var stake2MergeCmd = &cobra.Command{
+	Use:   config.TranslateInLang(stake2MergeCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(stake2MergeCmdShorts, config.UILanguage),
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := stake2Merge(args)
+		return output.PrintError(err)
+	},
+}
+
+func init() {
+	RegisterWriteCommand(stake2MergeCmd)
+}
+
+func stake2Merge(args []string) error {
+	indexes := make([]uint64, 0, len(args))
+	for _, arg := range args {
+		index, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return output.NewError(output.ConvertError, "failed to convert bucket index", nil)
+		}
+		indexes = append(indexes, index)
+	}
+
+	sender, err := Signer()
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get signed address", err)
+	}
+
+	gasPriceRau, err := gasPriceInRau()
+	if err != nil {
+		return output.NewError(0, "failed to get gas price", err)
+	}
+	nonce, err := nonce(sender)
+	if err != nil {
+		return output.NewError(0, "failed to get nonce ", err)
+	}
+
+	gasLimit := gasLimitFlag.Value().(uint64)
+	s2m, err := action.NewMergeStake(nonce, indexes[0], indexes[1:], nil, gasLimit, gasPriceRau)
+	if err != nil {
+		return output.NewError(output.InstantiationError, "failed to make a mergeStake instance", err)
+	}
+	if gasLimit == 0 {
+		if gasLimit, err = s2m.IntrinsicGas(); err != nil {
+			return output.NewError(0, "failed to get intrinsic gas", err)
+		}
+	}
+	return SendAction(
+		(&action.EnvelopeBuilder{}).
+			SetNonce(nonce).
+			SetGasPrice(gasPriceRau).
+			SetGasLimit(gasLimit).
+			SetAction(s2m).Build(),
+		sender)
+}
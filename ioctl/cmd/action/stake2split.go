@@ -0,0 +1,98 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/ioctl/config"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+	"github.com/iotexproject/iotex-core/ioctl/util"
+	"github.com/iotexproject/iotex-core/ioctl/validator"
+)
+
+// Multi-language support
+var (
+	stake2SplitCmdUses = map[config.Language]string{
+		config.English: "split BUCKET_INDEX AMOUNT_IOTX CANDIDATE_NAME" +
+			" [-s SIGNER] [-n NONCE] [-l GAS_LIMIT] [-p GAS_PRICE] [-P PASSWORD] [-y]",
+		config.Chinese: "split 票索引 IOTX数量 候选人名字" +
+			" [-s 签署人] [-n NONCE] [-l GAS限制] [-p GAS价格] [-P 密码] [-y]",
+	}
+	stake2SplitCmdShorts = map[config.Language]string{
+		config.English: "Split amount out of bucket into a new bucket voting for candidate on IoTeX blockchain",
+		config.Chinese: "在IoTeX区块链上从投票中拆分出投给候选人的新投票",
+	}
+)
+
+// stake2SplitCmd represents the stake2 split command
+var stake2SplitCmd = &cobra.Command{
+	Use:   config.TranslateInLang(stake2SplitCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(stake2SplitCmdShorts, config.UILanguage),
+	Args:  cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := stake2Split(args)
+		return output.PrintError(err)
+	},
+}
+
+func init() {
+	RegisterWriteCommand(stake2SplitCmd)
+}
+
+func stake2Split(args []string) error {
+	bucketIndex, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return output.NewError(output.ConvertError, "failed to convert bucket index", nil)
+	}
+
+	amountInRau, err := util.StringToRau(args[1], util.IotxDecimalNum)
+	if err != nil {
+		return output.NewError(output.ConvertError, "invalid amount", err)
+	}
+
+	var candidateName = args[2]
+	if err := validator.ValidateCandidateNameForStake2(candidateName); err != nil {
+		return output.NewError(output.ValidationError, "invalid candidate name", err)
+	}
+
+	sender, err := Signer()
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get signed address", err)
+	}
+
+	gasPriceRau, err := gasPriceInRau()
+	if err != nil {
+		return output.NewError(0, "failed to get gas price", err)
+	}
+	nonce, err := nonce(sender)
+	if err != nil {
+		return output.NewError(0, "failed to get nonce ", err)
+	}
+
+	gasLimit := gasLimitFlag.Value().(uint64)
+	s2s, err := action.NewSplitStake(nonce, candidateName, bucketIndex, amountInRau.String(), nil, gasLimit, gasPriceRau)
+	if err != nil {
+		return output.NewError(output.InstantiationError, "failed to make a splitStake instance", err)
+	}
+	if gasLimit == 0 {
+		if gasLimit, err = s2s.IntrinsicGas(); err != nil {
+			return output.NewError(0, "failed to get intrinsic gas", err)
+		}
+	}
+	return SendAction(
+		(&action.EnvelopeBuilder{}).
+			SetNonce(nonce).
+			SetGasPrice(gasPriceRau).
+			SetGasLimit(gasLimit).
+			SetAction(s2s).Build(),
+		sender)
+}
@@ -65,6 +65,8 @@ type Config struct {
 	Explorer       string            `json:"explorer" yaml:"explorer"`
 	Language       string            `json:"language" yaml:"language"`
 	Nsv2height     uint64            `json:"nsv2height" yaml:"nsv2height"`
+	ChainID        uint32            `json:"chainID" yaml:"chainID"`
+	Hawaiiheight   uint64            `json:"hawaiiheight" yaml:"hawaiiheight"`
 }
 
 var (
@@ -108,6 +110,9 @@ func init() {
 	if ReadConfig.Nsv2height == 0 {
 		ReadConfig.Nsv2height = config.Default.Genesis.FairbankBlockHeight
 	}
+	if ReadConfig.Hawaiiheight == 0 {
+		ReadConfig.Hawaiiheight = config.Default.Genesis.HawaiiBlockHeight
+	}
 	if !completeness {
 		err := writeConfig()
 		if err != nil {
@@ -31,8 +31,8 @@ const (
 
 var (
 	supportedLanguage = []string{"English", "中文"}
-	validArgs         = []string{"endpoint", "wallet", "explorer", "defaultacc", "language", "nsv2height"}
-	validGetArgs      = []string{"endpoint", "wallet", "explorer", "defaultacc", "language", "nsv2height", "all"}
+	validArgs         = []string{"endpoint", "wallet", "explorer", "defaultacc", "language", "nsv2height", "chainid", "hawaiiheight"}
+	validGetArgs      = []string{"endpoint", "wallet", "explorer", "defaultacc", "language", "nsv2height", "chainid", "hawaiiheight", "all"}
 	validExpl         = []string{"iotexscan", "iotxplorer"}
 	endpointCompile   = regexp.MustCompile("^" + endpointPattern + "$")
 )
@@ -152,6 +152,12 @@ func Get(arg string) error {
 	case "nsv2height":
 		fmt.Println(ReadConfig.Nsv2height)
 		return nil
+	case "chainid":
+		fmt.Println(ReadConfig.ChainID)
+		return nil
+	case "hawaiiheight":
+		fmt.Println(ReadConfig.Hawaiiheight)
+		return nil
 	case "all":
 		fmt.Println(ReadConfig.String())
 		return nil
@@ -276,6 +282,18 @@ func set(args []string) error {
 			return output.NewError(output.ValidationError, "invalid height", nil)
 		}
 		ReadConfig.Nsv2height = height
+	case "chainid":
+		chainID, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil || chainID == 0 {
+			return output.NewError(output.ValidationError, "invalid chain ID", nil)
+		}
+		ReadConfig.ChainID = uint32(chainID)
+	case "hawaiiheight":
+		height, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return output.NewError(output.ValidationError, "invalid height", nil)
+		}
+		ReadConfig.Hawaiiheight = height
 	}
 	err := writeConfig()
 	if err != nil {
@@ -289,6 +307,7 @@ func set(args []string) error {
 func reset() error {
 	ReadConfig.Wallet = ConfigDir
 	ReadConfig.Endpoint = ""
+	ReadConfig.ChainID = 0
 	ReadConfig.SecureConnect = true
 	ReadConfig.DefaultAccount = *new(Context)
 	ReadConfig.Explorer = "iotexscan"
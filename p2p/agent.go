@@ -386,6 +386,11 @@ func (p *Agent) UnicastOutbound(ctx context.Context, peer peerstore.PeerInfo, ms
 	return
 }
 
+// BlockPeer puts a misbehaving peer into the blocklist, so no more message is sent to it until the blocklist expires
+func (p *Agent) BlockPeer(peer peerstore.PeerInfo) {
+	p.unicastBlocklist.Block(peer.ID.Pretty(), time.Now())
+}
+
 // Info returns agents' peer info.
 func (p *Agent) Info() peerstore.PeerInfo { return p.host.Info() }
 
@@ -58,6 +58,12 @@ func (bl *BlockList) Add(name string, t time.Time) {
 	}
 }
 
+// Block adds the name to blocklist immediately
+func (bl *BlockList) Block(name string, t time.Time) {
+	bl.counter.Add(name, blockThreshold)
+	bl.timeout.Add(name, t.Add(blockListTTL))
+}
+
 // Remove takes name off the blocklist
 func (bl *BlockList) Remove(name string) {
 	bl.counter.Remove(name)
@@ -35,3 +35,17 @@ func TestBlockList(t *testing.T) {
 		r.Equal(v.blocked, list.Blocked(name, v.curTime))
 	}
 }
+
+func TestBlockListBlock(t *testing.T) {
+	r := require.New(t)
+
+	now := time.Now()
+	name := "alfa"
+	list := NewBlockList(10)
+	list.Block(name, now)
+	r.True(list.Blocked(name, now.Add(blockListTTL/2)))
+	r.False(list.Blocked(name, now.Add(blockListTTL*2)))
+	list.Block(name, now)
+	list.Remove(name)
+	r.False(list.Blocked(name, now))
+}
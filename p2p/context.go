@@ -6,9 +6,16 @@
 
 package p2p
 
-import "context"
+import (
+	"context"
 
-type p2pCtxKey struct{}
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+)
+
+type (
+	p2pCtxKey  struct{}
+	peerCtxKey struct{}
+)
 
 // Context provides the auxiliary information Agent network operations
 type Context struct {
@@ -25,3 +32,14 @@ func GetContext(ctx context.Context) (Context, bool) {
 	p2pCtx, ok := ctx.Value(p2pCtxKey{}).(Context)
 	return p2pCtx, ok
 }
+
+// WithPeer adds the peer which a message is received from into context.
+func WithPeer(ctx context.Context, peer peerstore.PeerInfo) context.Context {
+	return context.WithValue(ctx, peerCtxKey{}, peer)
+}
+
+// GetPeer gets the peer which a message is received from
+func GetPeer(ctx context.Context) (peerstore.PeerInfo, bool) {
+	peer, ok := ctx.Value(peerCtxKey{}).(peerstore.PeerInfo)
+	return peer, ok
+}
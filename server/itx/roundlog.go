@@ -0,0 +1,57 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package itx
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/iotexproject/iotex-core/consensus"
+	"github.com/iotexproject/iotex-core/consensus/scheme"
+)
+
+// RoundLogHandler is the admin handler to read the logs of the recent consensus rounds, the logs of the following
+// rounds are streamed by the StreamRoundLogs API
+type RoundLogHandler struct {
+	c consensus.Consensus
+}
+
+// NewRoundLogHandler instantiates a RoundLogHandler instance
+func NewRoundLogHandler(c consensus.Consensus) *RoundLogHandler {
+	return &RoundLogHandler{c: c}
+}
+
+// Handle returns the round logs in json, the logs are filtered by the height in the query if it is given
+func (h *RoundLogHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var height uint64
+	if val := r.URL.Query().Get("height"); val != "" {
+		var err error
+		if height, err = strconv.ParseUint(val, 10, 64); err != nil {
+			http.Error(w, "invalid height", http.StatusBadRequest)
+			return
+		}
+	}
+	logs, err := h.c.RoundLogs()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotImplemented)
+		return
+	}
+	if height != 0 {
+		filtered := []scheme.RoundLog{}
+		for _, l := range logs {
+			if l.Height == height {
+				filtered = append(filtered, l)
+			}
+		}
+		logs = filtered
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(logs); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
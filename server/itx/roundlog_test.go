@@ -0,0 +1,54 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package itx
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/consensus/scheme"
+	"github.com/iotexproject/iotex-core/test/mock/mock_consensus"
+)
+
+func TestRoundLogHandler(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := mock_consensus.NewMockConsensus(ctrl)
+	c.EXPECT().RoundLogs().Return([]scheme.RoundLog{
+		{Height: 1, Round: 0, Outcome: scheme.RoundFailed},
+		{Height: 1, Round: 1, Outcome: scheme.RoundCommitted},
+		{Height: 2, Round: 0, Outcome: scheme.RoundInProgress},
+	}, nil).Times(2)
+	h := NewRoundLogHandler(c)
+
+	for _, test := range []struct {
+		query  string
+		status int
+		rounds int
+	}{
+		{"", http.StatusOK, 3},
+		{"?height=1", http.StatusOK, 2},
+		{"?height=abc", http.StatusBadRequest, 0},
+	} {
+		w := httptest.NewRecorder()
+		h.Handle(w, httptest.NewRequest(http.MethodGet, "/consensus/rounds"+test.query, nil))
+		require.Equal(test.status, w.Code)
+		if test.status != http.StatusOK {
+			continue
+		}
+		var logs []scheme.RoundLog
+		require.NoError(json.NewDecoder(w.Body).Decode(&logs))
+		require.Equal(test.rounds, len(logs))
+	}
+}
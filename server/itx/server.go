@@ -199,6 +199,7 @@ func StartServer(ctx context.Context, svr *Server, probeSvr *probe.Server, cfg c
 		log.RegisterLevelConfigMux(mux)
 		haCtl := ha.New(svr.rootChainService.Consensus())
 		mux.Handle("/ha", http.HandlerFunc(haCtl.Handle))
+		mux.Handle("/consensus/rounds", http.HandlerFunc(NewRoundLogHandler(svr.rootChainService.Consensus()).Handle))
 		mux.Handle("/debug/pprof/", http.HandlerFunc(pprof.Index))
 		mux.Handle("/debug/pprof/cmdline", http.HandlerFunc(pprof.Cmdline))
 		mux.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
@@ -0,0 +1,753 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package snapshot
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/blockchain/filedao"
+	"github.com/iotexproject/iotex-core/blockindex"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/util/fileutil"
+	"github.com/iotexproject/iotex-core/state/factory"
+)
+
+// Version is the version of the snapshot archive
+const Version = 2
+
+// lockTimeout is the timeout to open a db file which is locked by a running node
+const lockTimeout = 3 * time.Second
+
+// batchSize is the number of records committed at a time when a db file is extracted
+const batchSize = 10000
+
+// names of the files in the snapshot archive
+const (
+	manifestFile       = "manifest.json"
+	headersFile        = "headers"
+	stateDBFile        = "state.db"
+	indexDBFile        = "index.db"
+	candidateIndexFile = "candidate.index.db"
+	stakingIndexFile   = "staking.index.db"
+	stakingHistoryFile = "staking.history.index.db"
+	rewardHistoryFile  = "reward.history.index.db"
+)
+
+var (
+	// ErrInvalidSnapshot indicates the snapshot archive is invalid
+	ErrInvalidSnapshot = errors.New("invalid snapshot")
+)
+
+type (
+	// Manifest describes the content of a snapshot archive, which is the last entry of the archive
+	Manifest struct {
+		Version uint32 `json:"version"`
+		// Height is the height of the states in the snapshot
+		Height uint64 `json:"height"`
+		// TipHash is the hash of the block at the height
+		TipHash string `json:"tipHash"`
+		// DeltaStateDigest is the delta state digest in the header of the block at the height
+		DeltaStateDigest string `json:"deltaStateDigest"`
+		// StateDigest is the digest of the states at the height, see StateDigest
+		StateDigest string `json:"stateDigest"`
+		// TrielessStateDB is true if the state db is the trieless state db
+		TrielessStateDB bool   `json:"trielessStateDB"`
+		Files           []File `json:"files"`
+	}
+
+	// File is a file in the snapshot archive
+	File struct {
+		Name string `json:"name"`
+		// Height is the tip of the db file if it is an indexer of the blocks
+		Height uint64 `json:"height,omitempty"`
+		// Digest is the sha256 digest of the file, which is the records of a db file in the order of namespace and
+		// key, see writeRecord
+		Digest string `json:"digest"`
+	}
+
+	// dbFile is a db file of the node which is included in the snapshot
+	dbFile struct {
+		name string
+		path string
+		// height reads the tip of the db file, nil if the db file is not an indexer of the blocks
+		height func(config.DB) (uint64, error)
+		// required is true if the db file must be in the snapshot
+		required bool
+	}
+)
+
+// dbFiles returns the db files included in the snapshot
+func dbFiles(cfg config.Config) []dbFile {
+	files := []dbFile{
+		{name: stateDBFile, path: cfg.Chain.TrieDBPath, height: stateHeight, required: true},
+	}
+	if _, gateway := cfg.Plugins[config.GatewayPlugin]; gateway {
+		files = append(files,
+			dbFile{
+				name: indexDBFile,
+				path: cfg.Chain.IndexDBPath,
+				height: func(dbCfg config.DB) (uint64, error) {
+					return indexHeight(dbCfg, cfg.Genesis.Hash())
+				},
+				required: true,
+			},
+			dbFile{name: candidateIndexFile, path: cfg.Chain.CandidateIndexDBPath},
+		)
+		if cfg.Chain.EnableStakingIndexer {
+			files = append(files, dbFile{name: stakingIndexFile, path: cfg.Chain.StakingIndexDBPath})
+		}
+		if cfg.Chain.EnableStakingHistoryIndexer {
+			files = append(files, dbFile{name: stakingHistoryFile, path: cfg.Chain.StakingHistoryIndexDBPath, height: stakingHistoryHeight})
+		}
+		if cfg.Chain.EnableRewardHistoryIndexer && cfg.Consensus.Scheme == config.RollDPoSScheme {
+			files = append(files, dbFile{
+				name: rewardHistoryFile,
+				path: cfg.Chain.RewardHistoryIndexDBPath,
+				height: func(dbCfg config.DB) (uint64, error) {
+					return rewardHistoryHeight(dbCfg, rolldpos.NewProtocol(
+						cfg.Genesis.NumCandidateDelegates,
+						cfg.Genesis.NumDelegates,
+						cfg.Genesis.NumSubEpochs,
+						rolldpos.EnableDardanellesSubEpoch(cfg.Genesis.DardanellesBlockHeight, cfg.Genesis.DardanellesNumSubEpochs),
+					))
+				},
+			})
+		}
+	}
+	return files
+}
+
+// Export writes a snapshot archive of the state db, the indexers and the last numHeaders block headers to the
+// writer. The node must be stopped, and the snapshot is taken at the height of the state db, which must be the
+// given height if it is not 0
+func Export(cfg config.Config, w io.Writer, height, numHeaders uint64) (*Manifest, error) {
+	// the chain db is always a bolt DB
+	file, err := openReadOnly(cfg.Chain.ChainDBPath)
+	if err != nil {
+		return nil, err
+	}
+	file.Close()
+	stateCfg := cfg.DB
+	stateCfg.DbPath = cfg.Chain.TrieDBPath
+	if err := checkStopped(stateCfg); err != nil {
+		return nil, err
+	}
+	tip, err := stateHeight(stateCfg)
+	if err != nil {
+		return nil, err
+	}
+	if tip == 0 {
+		return nil, errors.New("state db is empty")
+	}
+	if height != 0 && height != tip {
+		return nil, errors.Errorf("state db is at height %d, cannot export at height %d", tip, height)
+	}
+	headers, err := readHeaders(cfg, tip, numHeaders)
+	if err != nil {
+		return nil, err
+	}
+	stateDigest, err := StateDigest(stateCfg)
+	if err != nil {
+		return nil, err
+	}
+	tipHeader := headers[len(headers)-1]
+	tipHash, digest := tipHeader.HashBlock(), tipHeader.DeltaStateDigest()
+	manifest := &Manifest{
+		Version:          Version,
+		Height:           tip,
+		TipHash:          hex.EncodeToString(tipHash[:]),
+		DeltaStateDigest: hex.EncodeToString(digest[:]),
+		StateDigest:      hex.EncodeToString(stateDigest[:]),
+		TrielessStateDB:  cfg.Chain.EnableTrielessStateDB,
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	for _, file := range dbFiles(cfg) {
+		if !fileutil.FileExists(file.path) {
+			if file.required {
+				return nil, errors.Errorf("db file %s does not exist", file.path)
+			}
+			continue
+		}
+		entry := File{Name: file.name}
+		dbCfg := cfg.DB
+		dbCfg.DbPath = file.path
+		if err := checkStopped(dbCfg); err != nil {
+			return nil, err
+		}
+		if file.height != nil {
+			if entry.Height, err = file.height(dbCfg); err != nil {
+				return nil, err
+			}
+			if entry.Height != tip {
+				return nil, errors.Errorf("db file %s is at height %d, not at height %d of state db", file.path, entry.Height, tip)
+			}
+		}
+		if entry.Digest, err = writeFile(tw, file.name, dbCfg); err != nil {
+			return nil, err
+		}
+		manifest.Files = append(manifest.Files, entry)
+	}
+	data, err := serializeHeaders(headers)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.Sum256(data)
+	if err := writeEntry(tw, headersFile, data); err != nil {
+		return nil, err
+	}
+	manifest.Files = append(manifest.Files, File{Name: headersFile, Digest: hex.EncodeToString(h[:])})
+	if data, err = json.MarshalIndent(manifest, "", "  "); err != nil {
+		return nil, errors.Wrap(err, "failed to encode manifest")
+	}
+	if err := writeEntry(tw, manifestFile, data); err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to write snapshot")
+	}
+	if err := gw.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to write snapshot")
+	}
+	return manifest, nil
+}
+
+// Import verifies a snapshot archive read from the reader, and installs it as the db files of a new node, which then
+// starts from the height of the snapshot. The tip block header must be signed by its producer and be the trusted
+// hash, the other headers must be chained to it, and the manifest must match the hash and the delta state digest of
+// the tip block. The digest of the states in the snapshot must be the trusted state digest, which is obtained with the
+// trusted hash from a trusted node. The db files of the node must not exist
+func Import(cfg config.Config, r io.Reader, trustedHash, trustedStateDigest hash.Hash256) (*Manifest, error) {
+	if trustedHash == hash.ZeroHash256 || trustedStateDigest == hash.ZeroHash256 {
+		return nil, errors.New("trusted hash and trusted state digest are required to import snapshot")
+	}
+	files := dbFiles(cfg)
+	for _, file := range append(files, dbFile{path: cfg.Chain.ChainDBPath}) {
+		if fileutil.FileExists(file.path) {
+			return nil, errors.Errorf("db file %s already exists", file.path)
+		}
+	}
+
+	// extract the db files next to where they are installed
+	var (
+		extracted = map[string]string{}
+		digests   = map[string]string{}
+		headers   []byte
+		data      []byte
+	)
+	defer func() {
+		for _, tmp := range extracted {
+			os.RemoveAll(tmp)
+		}
+	}()
+	paths := map[string]string{}
+	for _, file := range files {
+		paths[file.name] = file.path
+	}
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidSnapshot, err.Error())
+	}
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(ErrInvalidSnapshot, err.Error())
+		}
+		switch hdr.Name {
+		case manifestFile:
+			if data, err = ioutil.ReadAll(tr); err != nil {
+				return nil, errors.Wrap(ErrInvalidSnapshot, err.Error())
+			}
+		case headersFile:
+			if headers, err = ioutil.ReadAll(tr); err != nil {
+				return nil, errors.Wrap(ErrInvalidSnapshot, err.Error())
+			}
+			h := sha256.Sum256(headers)
+			digests[hdr.Name] = hex.EncodeToString(h[:])
+		default:
+			path, ok := paths[hdr.Name]
+			if !ok {
+				log.L().Warn("Unknown file in snapshot.", zap.String("file", hdr.Name))
+				continue
+			}
+			dbCfg := cfg.DB
+			dbCfg.DbPath = path + ".snapshot"
+			extracted[hdr.Name] = dbCfg.DbPath
+			if digests[hdr.Name], err = extractFile(tr, dbCfg); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, errors.Wrapf(ErrInvalidSnapshot, "failed to decode manifest: %v", err)
+	}
+	if manifest.Version != Version {
+		return nil, errors.Wrapf(ErrInvalidSnapshot, "unsupported version %d", manifest.Version)
+	}
+	if manifest.TrielessStateDB != cfg.Chain.EnableTrielessStateDB {
+		return nil, errors.Wrapf(ErrInvalidSnapshot, "trieless state db is %t, but %t in config", manifest.TrielessStateDB, cfg.Chain.EnableTrielessStateDB)
+	}
+	for _, file := range manifest.Files {
+		if digests[file.Name] != file.Digest {
+			return nil, errors.Wrapf(ErrInvalidSnapshot, "digest of file %s does not match", file.Name)
+		}
+		delete(digests, file.Name)
+	}
+	for name := range digests {
+		return nil, errors.Wrapf(ErrInvalidSnapshot, "file %s is not in manifest", name)
+	}
+	blkHeaders, err := deserializeHeaders(headers)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyHeaders(manifest, blkHeaders, trustedHash); err != nil {
+		return nil, err
+	}
+
+	// verify the tips of the db files, and install them
+	heights := map[string]uint64{}
+	for _, file := range manifest.Files {
+		heights[file.Name] = file.Height
+	}
+	for _, file := range files {
+		tmp, ok := extracted[file.name]
+		if !ok {
+			if file.required {
+				return nil, errors.Wrapf(ErrInvalidSnapshot, "file %s is not in snapshot", file.name)
+			}
+			continue
+		}
+		dbCfg := cfg.DB
+		dbCfg.DbPath = tmp
+		if file.height != nil {
+			height, err := file.height(dbCfg)
+			if err != nil {
+				return nil, err
+			}
+			if height != manifest.Height || heights[file.name] != manifest.Height {
+				return nil, errors.Wrapf(ErrInvalidSnapshot, "file %s is at height %d, not at height %d", file.name, height, manifest.Height)
+			}
+		}
+		if file.name == stateDBFile {
+			if err := verifyStateDigest(manifest, dbCfg, trustedStateDigest); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, file := range files {
+		if tmp, ok := extracted[file.name]; ok {
+			if err := os.Rename(tmp, file.path); err != nil {
+				return nil, errors.Wrapf(err, "failed to install db file %s", file.path)
+			}
+			delete(extracted, file.name)
+		}
+	}
+	chainCfg := cfg.DB
+	chainCfg.DbPath = cfg.Chain.ChainDBPath
+	if err := filedao.CreateFileDAOFromHeaders(chainCfg, blkHeaders); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// verifyStateDigest verifies the digest of the states in the extracted state db against the trusted state digest
+func verifyStateDigest(manifest *Manifest, cfg config.DB, trustedStateDigest hash.Hash256) error {
+	digest, err := StateDigest(cfg)
+	if err != nil {
+		return err
+	}
+	if digest != trustedStateDigest {
+		return errors.Wrapf(ErrInvalidSnapshot, "state digest %x is not the trusted state digest %x", digest, trustedStateDigest)
+	}
+	if hex.EncodeToString(digest[:]) != manifest.StateDigest {
+		return errors.Wrapf(ErrInvalidSnapshot, "state digest %x does not match", digest)
+	}
+	return nil
+}
+
+// verifyHeaders verifies the block headers in the snapshot against the manifest
+func verifyHeaders(manifest *Manifest, headers []*block.Header, trustedHash hash.Hash256) error {
+	if len(headers) == 0 {
+		return errors.Wrap(ErrInvalidSnapshot, "no block header")
+	}
+	for i, header := range headers {
+		if !header.VerifySignature() {
+			return errors.Wrapf(ErrInvalidSnapshot, "invalid signature of block header at height %d", header.Height())
+		}
+		if i > 0 && (header.Height() != headers[i-1].Height()+1 || header.PrevHash() != headers[i-1].HashBlock()) {
+			return errors.Wrapf(ErrInvalidSnapshot, "block header at height %d is not chained", header.Height())
+		}
+	}
+	tip := headers[len(headers)-1]
+	tipHash, digest := tip.HashBlock(), tip.DeltaStateDigest()
+	switch {
+	case tip.Height() != manifest.Height:
+		return errors.Wrapf(ErrInvalidSnapshot, "tip block header is at height %d, not at height %d", tip.Height(), manifest.Height)
+	case hex.EncodeToString(tipHash[:]) != manifest.TipHash:
+		return errors.Wrapf(ErrInvalidSnapshot, "tip block hash %x does not match", tipHash)
+	case hex.EncodeToString(digest[:]) != manifest.DeltaStateDigest:
+		return errors.Wrapf(ErrInvalidSnapshot, "delta state digest %x does not match", digest)
+	case trustedHash != tipHash:
+		return errors.Wrapf(ErrInvalidSnapshot, "tip block hash %x is not the trusted hash %x", tipHash, trustedHash)
+	}
+	return nil
+}
+
+// readHeaders reads the last numHeaders block headers up to the tip from the chain db
+func readHeaders(cfg config.Config, tip, numHeaders uint64) ([]*block.Header, error) {
+	if numHeaders == 0 {
+		numHeaders = 1
+	}
+	dbCfg := cfg.DB
+	dbCfg.DbPath = cfg.Chain.ChainDBPath
+	dbCfg.CompressLegacy = cfg.Chain.CompressBlock
+	dao := blockdao.NewBlockDAO(nil, dbCfg)
+	if dao == nil {
+		return nil, errors.Errorf("failed to open chain db %s", dbCfg.DbPath)
+	}
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Genesis: cfg.Genesis})
+	if err := dao.Start(ctx); err != nil {
+		return nil, err
+	}
+	defer dao.Stop(ctx)
+	height, err := dao.Height()
+	if err != nil {
+		return nil, err
+	}
+	if height < tip {
+		return nil, errors.Errorf("chain db is at height %d, lower than height %d of state db", height, tip)
+	}
+	start := uint64(1)
+	if tip > numHeaders {
+		start = tip - numHeaders + 1
+	}
+	headers := make([]*block.Header, 0, tip-start+1)
+	for i := start; i <= tip; i++ {
+		header, err := dao.HeaderByHeight(i)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read block header at height %d", i)
+		}
+		headers = append(headers, header)
+	}
+	return headers, nil
+}
+
+// StateDigest returns the digest of the states in a state db, which is the same on all nodes at the same height, on
+// either KV store engine. The account trie and the archived history states are excluded, because they depend on how
+// the node is synced
+func StateDigest(cfg config.DB) (hash.Hash256, error) {
+	if err := checkStopped(cfg); err != nil {
+		return hash.ZeroHash256, err
+	}
+	kv, err := openDB(cfg)
+	if err != nil {
+		return hash.ZeroHash256, err
+	}
+	defer kv.Stop(context.Background())
+
+	h := sha256.New()
+	if err := kv.ForEach(func(ns string, k, v []byte) error {
+		if ns == factory.ArchiveTrieNamespace || strings.HasPrefix(ns, factory.ArchiveNamespacePrefix) {
+			return nil
+		}
+		_, err := writeRecord(h, ns, k, v)
+		return err
+	}); err != nil {
+		return hash.ZeroHash256, errors.Wrapf(err, "failed to read states of %s", cfg.DbPath)
+	}
+	return hash.BytesToHash256(h.Sum(nil)), nil
+}
+
+func stateHeight(cfg config.DB) (uint64, error) {
+	kv := db.NewKVStore(cfg)
+	ctx := context.Background()
+	if err := kv.Start(ctx); err != nil {
+		return 0, err
+	}
+	defer kv.Stop(ctx)
+	value, err := kv.Get(factory.AccountKVNamespace, []byte(factory.CurrentHeightKey))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get height of state db")
+	}
+	return byteutil.BytesToUint64(value), nil
+}
+
+func indexHeight(cfg config.DB, genesisHash hash.Hash256) (uint64, error) {
+	indexer, err := blockindex.NewIndexer(db.NewKVStore(cfg), genesisHash)
+	if err != nil {
+		return 0, err
+	}
+	ctx := context.Background()
+	if err := indexer.Start(ctx); err != nil {
+		return 0, err
+	}
+	defer indexer.Stop(ctx)
+	return indexer.Height()
+}
+
+func stakingHistoryHeight(cfg config.DB) (uint64, error) {
+	indexer, err := staking.NewBucketHistoryIndexer(db.NewKVStore(cfg))
+	if err != nil {
+		return 0, err
+	}
+	ctx := context.Background()
+	if err := indexer.Start(ctx); err != nil {
+		return 0, err
+	}
+	defer indexer.Stop(ctx)
+	return indexer.Height()
+}
+
+func rewardHistoryHeight(cfg config.DB, rp *rolldpos.Protocol) (uint64, error) {
+	indexer, err := rewarding.NewRewardHistoryIndexer(db.NewKVStore(cfg), rp)
+	if err != nil {
+		return 0, err
+	}
+	ctx := context.Background()
+	if err := indexer.Start(ctx); err != nil {
+		return 0, err
+	}
+	defer indexer.Stop(ctx)
+	return indexer.Height()
+}
+
+// writeFile writes the records of a db file into the archive, and returns its digest
+func writeFile(tw *tar.Writer, name string, cfg config.DB) (string, error) {
+	kv, err := openDB(cfg)
+	if err != nil {
+		return "", err
+	}
+	defer kv.Stop(context.Background())
+
+	// the size of the entry is written ahead of the records
+	var size int64
+	if err := kv.ForEach(func(ns string, k, v []byte) error {
+		size += int64(12 + len(ns) + len(k) + len(v))
+		return nil
+	}); err != nil {
+		return "", errors.Wrapf(err, "failed to read %s", cfg.DbPath)
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0600,
+		Size: size,
+	}); err != nil {
+		return "", errors.Wrapf(err, "failed to write %s into snapshot", name)
+	}
+	h := sha256.New()
+	w := io.MultiWriter(tw, h)
+	if err := kv.ForEach(func(ns string, k, v []byte) error {
+		_, err := writeRecord(w, ns, k, v)
+		return err
+	}); err != nil {
+		return "", errors.Wrapf(err, "failed to write %s into snapshot", name)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeRecord writes a record of a db file, each of the namespace, key and value is prefixed by its 4-byte size
+func writeRecord(w io.Writer, ns string, k, v []byte) (int, error) {
+	var n int
+	for _, data := range [][]byte{[]byte(ns), k, v} {
+		size := make([]byte, 4)
+		binary.BigEndian.PutUint32(size, uint32(len(data)))
+		for _, b := range [][]byte{size, data} {
+			written, err := w.Write(b)
+			n += written
+			if err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// readRecord reads a record written by writeRecord, io.EOF is returned if there is no more record
+func readRecord(r io.Reader) (string, []byte, []byte, error) {
+	var fields [3][]byte
+	for i := range fields {
+		size := make([]byte, 4)
+		if _, err := io.ReadFull(r, size); err != nil {
+			if i == 0 && err == io.EOF {
+				return "", nil, nil, io.EOF
+			}
+			return "", nil, nil, errors.Wrapf(ErrInvalidSnapshot, "truncated record: %v", err)
+		}
+		fields[i] = make([]byte, binary.BigEndian.Uint32(size))
+		if _, err := io.ReadFull(r, fields[i]); err != nil {
+			return "", nil, nil, errors.Wrapf(ErrInvalidSnapshot, "truncated record: %v", err)
+		}
+	}
+	return string(fields[0]), fields[1], fields[2], nil
+}
+
+// openDB opens a db file on the KV store engine in config
+func openDB(cfg config.DB) (db.KVStoreWithForEach, error) {
+	var kv db.KVStoreWithForEach
+	if cfg.DBType == config.LevelDBType {
+		kv = db.NewLevelDB(cfg)
+	} else {
+		kv = db.NewBoltDB(cfg)
+	}
+	if err := kv.Start(context.Background()); err != nil {
+		return nil, errors.Wrapf(err, "failed to open db file %s", cfg.DbPath)
+	}
+	return kv, nil
+}
+
+// checkStopped checks the db file exists and is not being used by a running node
+func checkStopped(cfg config.DB) error {
+	if !fileutil.FileExists(cfg.DbPath) {
+		return errors.Errorf("db file %s does not exist", cfg.DbPath)
+	}
+	if cfg.DBType != config.LevelDBType {
+		file, err := openReadOnly(cfg.DbPath)
+		if err != nil {
+			return err
+		}
+		return file.Close()
+	}
+	// a level DB fails to open at once if it is locked
+	kv, err := openDB(cfg)
+	if err != nil {
+		return errors.Wrap(err, "the node must be stopped")
+	}
+	return kv.Stop(context.Background())
+}
+
+// openReadOnly opens a bolt db file for read, which fails if the db file is being used by a running node
+func openReadOnly(path string) (*bolt.DB, error) {
+	file, err := bolt.Open(path, 0600, &bolt.Options{ReadOnly: true, Timeout: lockTimeout})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open db file %s, the node must be stopped", path)
+	}
+	return file, nil
+}
+
+func writeEntry(tw *tar.Writer, name string, data []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0600,
+		Size: int64(len(data)),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to write %s into snapshot", name)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return errors.Wrapf(err, "failed to write %s into snapshot", name)
+	}
+	return nil
+}
+
+// extractFile extracts the records of a db file from the archive into a new db file, and returns its digest
+func extractFile(r io.Reader, cfg config.DB) (string, error) {
+	if err := os.RemoveAll(cfg.DbPath); err != nil {
+		return "", errors.Wrapf(err, "failed to remove %s", cfg.DbPath)
+	}
+	if err := os.MkdirAll(filepath.Dir(cfg.DbPath), 0755); err != nil {
+		return "", errors.Wrapf(err, "failed to create directory of %s", cfg.DbPath)
+	}
+	kv, err := openDB(cfg)
+	if err != nil {
+		return "", err
+	}
+	defer kv.Stop(context.Background())
+
+	h := sha256.New()
+	reader := io.TeeReader(bufio.NewReader(r), h)
+	kvb := batch.NewBatch()
+	for {
+		ns, k, v, err := readRecord(reader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to extract file %s", cfg.DbPath)
+		}
+		kvb.Put(ns, k, v, "failed to extract key %x in namespace %s", k, ns)
+		if kvb.Size() < batchSize {
+			continue
+		}
+		if err := kv.WriteBatch(kvb); err != nil {
+			return "", errors.Wrapf(err, "failed to write file %s", cfg.DbPath)
+		}
+	}
+	if err := kv.WriteBatch(kvb); err != nil {
+		return "", errors.Wrapf(err, "failed to write file %s", cfg.DbPath)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// serializeHeaders encodes the block headers, each of which is prefixed by its 4-byte size
+func serializeHeaders(headers []*block.Header) ([]byte, error) {
+	var data []byte
+	for _, header := range headers {
+		ser, err := header.Serialize()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to serialize block header at height %d", header.Height())
+		}
+		size := make([]byte, 4)
+		binary.BigEndian.PutUint32(size, uint32(len(ser)))
+		data = append(append(data, size...), ser...)
+	}
+	return data, nil
+}
+
+func deserializeHeaders(data []byte) ([]*block.Header, error) {
+	var headers []*block.Header
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, errors.Wrap(ErrInvalidSnapshot, "truncated block headers")
+		}
+		size := binary.BigEndian.Uint32(data[:4])
+		data = data[4:]
+		if uint32(len(data)) < size {
+			return nil, errors.Wrap(ErrInvalidSnapshot, "truncated block headers")
+		}
+		header := &block.Header{}
+		if err := header.Deserialize(data[:size]); err != nil {
+			return nil, errors.Wrapf(ErrInvalidSnapshot, "failed to deserialize block header: %v", err)
+		}
+		headers = append(headers, header)
+		data = data[size:]
+	}
+	return headers, nil
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func testConfig(dir, dbType string) config.Config {
+	cfg := config.Default
+	cfg.DB.DBType = dbType
+	cfg.Chain.ChainDBPath = filepath.Join(dir, "chain.db")
+	cfg.Chain.TrieDBPath = filepath.Join(dir, "trie.db")
+	cfg.Chain.IndexDBPath = filepath.Join(dir, "index.db")
+	cfg.Chain.CandidateIndexDBPath = filepath.Join(dir, "candidate.index.db")
+	cfg.Chain.StakingIndexDBPath = filepath.Join(dir, "staking.index.db")
+	return cfg
+}
+
+func TestSnapshot(t *testing.T) {
+	digests := map[string]hash.Hash256{}
+	for _, dbType := range []string{config.BoltDBType, config.LevelDBType} {
+		t.Run(dbType, func(t *testing.T) {
+			digests[dbType] = testSnapshot(t, dbType)
+		})
+	}
+	// the state digest does not depend on the KV store engine
+	require.Equal(t, digests[config.BoltDBType], digests[config.LevelDBType])
+}
+
+func testSnapshot(t *testing.T, dbType string) hash.Hash256 {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	cfg := testConfig(filepath.Join(dir, "node1"), dbType)
+	require.NoError(os.MkdirAll(filepath.Join(dir, "node1"), 0755))
+
+	// prepare 5 blocks, and the state db at height 4
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Genesis: cfg.Genesis})
+	dbCfg := cfg.DB
+	dbCfg.DbPath = cfg.Chain.ChainDBPath
+	dao := blockdao.NewBlockDAO(nil, dbCfg)
+	require.NoError(dao.Start(ctx))
+	var (
+		builder = block.NewTestingBuilder()
+		prev    = hash.ZeroHash256
+		hashes  []hash.Hash256
+	)
+	for i := uint64(1); i <= 5; i++ {
+		blk, err := builder.
+			SetHeight(i).
+			SetPrevBlockHash(prev).
+			SetTimeStamp(testutil.TimestampNow().UTC()).
+			SignAndBuild(identityset.PrivateKey(27))
+		require.NoError(err)
+		require.NoError(dao.PutBlock(ctx, &blk))
+		prev = blk.HashBlock()
+		hashes = append(hashes, prev)
+	}
+	require.NoError(dao.Stop(ctx))
+	dbCfg.DbPath = cfg.Chain.TrieDBPath
+	kv := db.NewKVStore(dbCfg)
+	require.NoError(kv.Start(ctx))
+	require.NoError(kv.Put(factory.AccountKVNamespace, []byte(factory.CurrentHeightKey), byteutil.Uint64ToBytes(4)))
+	require.NoError(kv.Put(factory.AccountKVNamespace, []byte("account"), []byte("state")))
+	require.NoError(kv.Stop(ctx))
+
+	// the account trie is not in the state digest
+	digest, err := StateDigest(dbCfg)
+	require.NoError(err)
+	require.NoError(kv.Start(ctx))
+	require.NoError(kv.Put(factory.ArchiveTrieNamespace, []byte(factory.ArchiveTrieRootKey), []byte("root")))
+	require.NoError(kv.Stop(ctx))
+	stateDigest, err := StateDigest(dbCfg)
+	require.NoError(err)
+	require.Equal(digest, stateDigest)
+
+	// export
+	var buf bytes.Buffer
+	_, err = Export(cfg, &buf, 5, 3)
+	require.EqualError(err, "state db is at height 4, cannot export at height 5")
+	manifest, err := Export(cfg, &buf, 0, 3)
+	require.NoError(err)
+	require.EqualValues(Version, manifest.Version)
+	require.EqualValues(4, manifest.Height)
+	require.Equal(2, len(manifest.Files))
+	require.Equal(stateDBFile, manifest.Files[0].Name)
+	require.EqualValues(4, manifest.Files[0].Height)
+	require.Equal(headersFile, manifest.Files[1].Name)
+	require.Equal(hex.EncodeToString(stateDigest[:]), manifest.StateDigest)
+	archive := buf.Bytes()
+
+	// import
+	cfg = testConfig(filepath.Join(dir, "node2"), dbType)
+	cfg.Chain.EnableTrielessStateDB = !cfg.Chain.EnableTrielessStateDB
+	_, err = Import(cfg, bytes.NewReader(archive), hashes[3], stateDigest)
+	require.Equal(ErrInvalidSnapshot, errors.Cause(err))
+	cfg.Chain.EnableTrielessStateDB = !cfg.Chain.EnableTrielessStateDB
+	// the trusted hash and the trusted state digest are required
+	_, err = Import(cfg, bytes.NewReader(archive), hash.ZeroHash256, stateDigest)
+	require.Error(err)
+	_, err = Import(cfg, bytes.NewReader(archive), hashes[3], hash.ZeroHash256)
+	require.Error(err)
+	_, err = Import(cfg, bytes.NewReader(archive), hashes[2], stateDigest)
+	require.Equal(ErrInvalidSnapshot, errors.Cause(err))
+	_, err = Import(cfg, bytes.NewReader(archive), hashes[3], hash.Hash256b([]byte("state")))
+	require.Equal(ErrInvalidSnapshot, errors.Cause(err))
+	_, err = Import(cfg, bytes.NewReader(archive[:len(archive)/2]), hashes[3], stateDigest)
+	require.Error(err)
+	_, err = os.Stat(cfg.Chain.TrieDBPath)
+	require.True(os.IsNotExist(err))
+	manifest, err = Import(cfg, bytes.NewReader(archive), hashes[3], stateDigest)
+	require.NoError(err)
+	require.EqualValues(4, manifest.Height)
+	_, err = Import(cfg, bytes.NewReader(archive), hashes[3], stateDigest)
+	require.Error(err)
+
+	// the node starts from the snapshot
+	dbCfg = cfg.DB
+	dbCfg.DbPath = cfg.Chain.TrieDBPath
+	height, err := stateHeight(dbCfg)
+	require.NoError(err)
+	require.EqualValues(4, height)
+	digest, err = StateDigest(dbCfg)
+	require.NoError(err)
+	require.Equal(stateDigest, digest)
+	dbCfg.DbPath = cfg.Chain.ChainDBPath
+	dao = blockdao.NewBlockDAO(nil, dbCfg)
+	require.NoError(dao.Start(ctx))
+	defer dao.Stop(ctx)
+	height, err = dao.Height()
+	require.NoError(err)
+	require.EqualValues(4, height)
+	for i := uint64(2); i <= 4; i++ {
+		h, err := dao.GetBlockHash(i)
+		require.NoError(err)
+		require.Equal(hashes[i-1], h)
+		header, err := dao.HeaderByHeight(i)
+		require.NoError(err)
+		require.Equal(hashes[i-1], header.HashBlock())
+		_, err = dao.GetBlockByHeight(i)
+		require.Equal(db.ErrPruned, errors.Cause(err))
+	}
+	_, err = dao.HeaderByHeight(1)
+	require.Error(err)
+	return stateDigest
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+)
+
+// DeferredDeletesKey indicates the key of the deletes deferred by the tip block in underlying DB
+const DeferredDeletesKey = "deferredDeletes"
+
+type (
+	// deferDeleteKVStore is the KVStore of a state factory not in archive mode, which defers the deletes of a block
+	// until the next block is committed, such that the states of the parent of the tip are kept
+	deferDeleteKVStore struct {
+		db.KVStore
+	}
+
+	deferredDelete struct {
+		ns  string
+		key []byte
+	}
+)
+
+// WriteBatch writes the batch of a block, together with the deletes deferred by the last block. The deletes in the
+// batch are deferred in turn, unless the key is put again afterwards in the batch
+func (s *deferDeleteKVStore) WriteBatch(b batch.KVStoreBatch) error {
+	deferred, err := s.deferredDeletes()
+	if err != nil {
+		return err
+	}
+	kvb := batch.NewBatch()
+	// the deferred deletes go first, such that the keys put again by the block are kept
+	for _, d := range deferred {
+		kvb.Delete(d.ns, d.key, "failed to delete key %x in namespace %s", d.key, d.ns)
+	}
+	var (
+		deletes = []deferredDelete{}
+		index   = map[string]int{}
+	)
+	b.Lock()
+	for i := 0; i < b.Size(); i++ {
+		wi, err := b.Entry(i)
+		if err != nil {
+			b.Unlock()
+			return err
+		}
+		k := wi.Namespace() + string(wi.Key())
+		if wi.WriteType() == batch.Delete {
+			if _, ok := index[k]; !ok {
+				index[k] = len(deletes)
+				deletes = append(deletes, deferredDelete{ns: wi.Namespace(), key: wi.Key()})
+			}
+			continue
+		}
+		if i, ok := index[k]; ok {
+			// the key is put again, so it is not deleted
+			deletes[i].key = nil
+			delete(index, k)
+		}
+		args, _ := wi.ErrorArgs().([]interface{})
+		kvb.Put(wi.Namespace(), wi.Key(), wi.Value(), wi.ErrorFormat(), args...)
+	}
+	b.Unlock()
+
+	var data []byte
+	for _, d := range deletes {
+		if d.key != nil {
+			data = append(data, serializeDeferredDelete(d)...)
+		}
+	}
+	switch {
+	case len(data) > 0:
+		kvb.Put(ArchiveTrieNamespace, []byte(DeferredDeletesKey), data, "failed to defer deletes")
+	case len(deferred) > 0:
+		kvb.Delete(ArchiveTrieNamespace, []byte(DeferredDeletesKey), "failed to clear deferred deletes")
+	}
+	if err := s.KVStore.WriteBatch(kvb); err != nil {
+		return err
+	}
+	b.Lock()
+	b.ClearAndUnlock()
+	return nil
+}
+
+// deferredDeletes reads the deletes deferred by the last block
+func (s *deferDeleteKVStore) deferredDeletes() ([]deferredDelete, error) {
+	data, err := s.KVStore.Get(ArchiveTrieNamespace, []byte(DeferredDeletesKey))
+	switch errors.Cause(err) {
+	case nil:
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		return nil, nil
+	default:
+		return nil, errors.Wrap(err, "failed to read deferred deletes")
+	}
+	var deletes []deferredDelete
+	for len(data) > 0 {
+		var fields [2][]byte
+		for i := range fields {
+			if len(data) < 4 {
+				return nil, errors.New("invalid deferred deletes")
+			}
+			size := binary.BigEndian.Uint32(data[:4])
+			data = data[4:]
+			if uint32(len(data)) < size {
+				return nil, errors.New("invalid deferred deletes")
+			}
+			fields[i], data = data[:size], data[size:]
+		}
+		deletes = append(deletes, deferredDelete{ns: string(fields[0]), key: fields[1]})
+	}
+	return deletes, nil
+}
+
+// serializeDeferredDelete encodes a deferred delete, the namespace and the key are prefixed by their 4-byte sizes
+func serializeDeferredDelete(d deferredDelete) []byte {
+	var data []byte
+	for _, field := range [][]byte{[]byte(d.ns), d.key} {
+		size := make([]byte, 4)
+		binary.BigEndian.PutUint32(size, uint32(len(field)))
+		data = append(append(data, size...), field...)
+	}
+	return data
+}
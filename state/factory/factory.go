@@ -57,6 +57,8 @@ var (
 	ErrNotSupported = errors.New("not supported")
 	// ErrNoArchiveData is the error that the node have no archive data
 	ErrNoArchiveData = errors.New("no archive data")
+	// ErrHistoryPruned is the error that the history state is older than the retention
+	ErrHistoryPruned = errors.New("history state is pruned")
 
 	dbBatchSizelMtc = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -81,10 +83,21 @@ type (
 		// NewBlockBuilder creates block builder
 		NewBlockBuilder(context.Context, actpool.ActPool, func(action.Envelope) (action.SealedEnvelope, error)) (*block.Builder, error)
 		SimulateExecution(context.Context, address.Address, *action.Execution, evm.GetBlockHash) ([]byte, *action.Receipt, error)
+		SimulateExecutionAtHeight(context.Context, uint64, address.Address, *action.Execution, evm.GetBlockHash) ([]byte, *action.Receipt, error)
+		TraceAction(context.Context, *block.Block, hash.Hash256, *evm.Tracer) (*action.Receipt, error)
 		PutBlock(context.Context, *block.Block) error
 		DeleteTipBlock(*block.Block) error
 		StateAtHeight(uint64, interface{}, ...protocol.StateOption) error
 		StatesAtHeight(uint64, ...protocol.StateOption) (state.Iterator, error)
+		ProofAtHeight(uint64, ...protocol.StateOption) (*StateProof, error)
+	}
+
+	// StateProof is the merkle proof of a state against the root of global state trie at a height
+	StateProof struct {
+		Height         uint64
+		RootHash       []byte
+		NamespaceProof [][]byte // proof of the namespace trie root in layer one
+		KeyProof       [][]byte // proof of the state in the namespace trie
 	}
 
 	// factory implements StateFactory interface, tracks changes to account/contract and batch-commits to DB
@@ -126,7 +139,7 @@ func DefaultTrieOption() Option {
 			return errors.New("Invalid empty trie db path")
 		}
 		cfg.DB.DbPath = dbPath // TODO: remove this after moving TrieDBPath from cfg.Chain to cfg.DB
-		sf.dao = db.NewBoltDB(cfg.DB)
+		sf.dao = db.NewKVStore(cfg.DB)
 		return nil
 	}
 }
@@ -276,11 +289,20 @@ func (sf *factory) Height() (uint64, error) {
 }
 
 func (sf *factory) newWorkingSet(ctx context.Context, height uint64) (*workingSet, error) {
-	flusher, err := db.NewKVStoreFlusher(sf.dao, batch.NewCachedBatch(), sf.flusherOptions(ctx, height)...)
+	return sf.newWorkingSetWithRootKey(ctx, height, ArchiveTrieRootKey, true)
+}
+
+// newWorkingSetWithRootKey creates a working set on top of the state trie whose root hash is stored at the root key
+func (sf *factory) newWorkingSetWithRootKey(ctx context.Context, height uint64, rootKey string, create bool) (*workingSet, error) {
+	store := sf.dao
+	if !sf.saveHistory {
+		store = &deferDeleteKVStore{sf.dao}
+	}
+	flusher, err := db.NewKVStoreFlusher(store, batch.NewCachedBatch(), sf.flusherOptions(ctx, height)...)
 	if err != nil {
 		return nil, err
 	}
-	tlt, err := newTwoLayerTrie(ArchiveTrieNamespace, flusher.KVStoreWithBuffer(), ArchiveTrieRootKey, true)
+	tlt, err := newTwoLayerTrie(ArchiveTrieNamespace, flusher.KVStoreWithBuffer(), rootKey, create)
 	if err != nil {
 		return nil, err
 	}
@@ -514,6 +536,73 @@ func (sf *factory) SimulateExecution(
 	return evm.SimulateExecution(ctx, ws, caller, ex, getBlockHash)
 }
 
+// SimulateExecutionAtHeight simulates a running of smart contract operation on the state at height -- archive mode
+func (sf *factory) SimulateExecutionAtHeight(
+	ctx context.Context,
+	height uint64,
+	caller address.Address,
+	ex *action.Execution,
+	getBlockHash evm.GetBlockHash,
+) ([]byte, *action.Receipt, error) {
+	sf.mutex.Lock()
+	if height > sf.currentChainHeight {
+		sf.mutex.Unlock()
+		return nil, nil, errors.Errorf("query height %d is higher than tip height %d", height, sf.currentChainHeight)
+	}
+	if err := sf.checkArchiveHeight(height); err != nil {
+		sf.mutex.Unlock()
+		return nil, nil, err
+	}
+	ws, err := sf.newWorkingSetWithRootKey(ctx, height+1, fmt.Sprintf("%s-%d", ArchiveTrieRootKey, height), false)
+	sf.mutex.Unlock()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to obtain working set from state factory")
+	}
+
+	return evm.SimulateExecution(ctx, ws, caller, ex, getBlockHash)
+}
+
+// TraceAction re-runs an action of a committed block with the tracer, on top of the state of the parent block and
+// the actions before it in the block. The state of the parent block is kept in archive mode, or if the block is the
+// tip
+func (sf *factory) TraceAction(
+	ctx context.Context,
+	blk *block.Block,
+	h hash.Hash256,
+	tracer *evm.Tracer,
+) (*action.Receipt, error) {
+	height := blk.Height()
+	sf.mutex.Lock()
+	if height == 0 || height > sf.currentChainHeight {
+		sf.mutex.Unlock()
+		return nil, errors.Errorf("block height %d is not in range [1, %d]", height, sf.currentChainHeight)
+	}
+	if err := sf.checkArchiveHeight(height - 1); err != nil {
+		sf.mutex.Unlock()
+		return nil, err
+	}
+	ws, err := sf.newWorkingSetWithRootKey(ctx, height, fmt.Sprintf("%s-%d", ArchiveTrieRootKey, height-1), false)
+	sf.mutex.Unlock()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain working set from state factory")
+	}
+	producer, err := address.FromBytes(blk.PublicKey().Hash())
+	if err != nil {
+		return nil, err
+	}
+	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+	ctx = protocol.WithBlockCtx(
+		protocol.WithRegistry(ctx, sf.registry),
+		protocol.BlockCtx{
+			BlockHeight:    height,
+			BlockTimeStamp: blk.Timestamp(),
+			GasLimit:       bcCtx.Genesis.BlockGasLimit,
+			Producer:       producer,
+		},
+	)
+	return ws.traceAction(ctx, blk.RunnableActions().Actions(), h, tracer)
+}
+
 // PutBlock persists all changes in RunActions() into the DB
 func (sf *factory) PutBlock(ctx context.Context, blk *block.Block) error {
 	sf.mutex.Lock()
@@ -598,6 +687,68 @@ func (sf *factory) StatesAtHeight(height uint64, opts ...protocol.StateOption) (
 	return nil, errors.Wrap(ErrNotSupported, "Read historical states has not been implemented yet")
 }
 
+// ProofAtHeight returns the merkle proof of a state at height, a height lower than tip requires archive mode
+func (sf *factory) ProofAtHeight(height uint64, opts ...protocol.StateOption) (*StateProof, error) {
+	sf.mutex.RLock()
+	defer sf.mutex.RUnlock()
+	cfg, err := processOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if height > sf.currentChainHeight {
+		return nil, errors.Errorf("query height %d is higher than tip height %d", height, sf.currentChainHeight)
+	}
+	if height < sf.currentChainHeight {
+		if err := sf.checkArchiveHeight(height); err != nil {
+			return nil, err
+		}
+	}
+	tlt, err := newTwoLayerTrie(ArchiveTrieNamespace, sf.dao, fmt.Sprintf("%s-%d", ArchiveTrieRootKey, height), false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate trie for %d", height)
+	}
+	if err := tlt.Start(context.Background()); err != nil {
+		return nil, err
+	}
+	defer tlt.Stop(context.Background())
+
+	rootHash, err := tlt.RootHash()
+	if err != nil {
+		return nil, err
+	}
+	nsProof, keyProof, err := tlt.GetProof(namespaceKey(cfg.Namespace), toLegacyKey(cfg.Key))
+	if err != nil {
+		return nil, err
+	}
+
+	return &StateProof{
+		Height:         height,
+		RootHash:       rootHash,
+		NamespaceProof: nsProof,
+		KeyProof:       keyProof,
+	}, nil
+}
+
+// VerifyStateProof verifies the proof against its root hash, and returns the serialized state if it exists
+func VerifyStateProof(proof *StateProof, opts ...protocol.StateOption) ([]byte, error) {
+	cfg, err := processOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	value, err := mptrie.VerifyTwoLayerProof(
+		proof.RootHash,
+		namespaceKey(cfg.Namespace),
+		toLegacyKey(cfg.Key),
+		proof.NamespaceProof,
+		proof.KeyProof,
+	)
+	if errors.Cause(err) == trie.ErrNotExist {
+		return nil, errors.Wrapf(state.ErrStateNotExist, "state of ns = %s and key = %x doesn't exist", cfg.Namespace, cfg.Key)
+	}
+
+	return value, err
+}
+
 // State returns a confirmed state in the state factory
 func (sf *factory) State(s interface{}, opts ...protocol.StateOption) (uint64, error) {
 	sf.mutex.RLock()
@@ -680,10 +831,28 @@ func toLegacyKey(input []byte) []byte {
 	return key[:]
 }
 
-func (sf *factory) stateAtHeight(height uint64, ns string, key []byte, s interface{}) error {
+// checkArchiveHeight checks the history state at height is archived and retained
+func (sf *factory) checkArchiveHeight(height uint64) error {
 	if !sf.saveHistory {
+		// the states of the parent of the tip are kept until the next block is committed, see deferDeleteKVStore
+		if height+1 == sf.currentChainHeight {
+			return nil
+		}
 		return ErrNoArchiveData
 	}
+	if retention := sf.cfg.DB.HistoryStateRetention; retention > 0 && height+retention <= sf.currentChainHeight {
+		return errors.Wrapf(ErrHistoryPruned, "height %d is beyond the retention of %d blocks", height, retention)
+	}
+	return nil
+}
+
+func (sf *factory) stateAtHeight(height uint64, ns string, key []byte, s interface{}) error {
+	// the states at the tip height are always kept
+	if height < sf.currentChainHeight {
+		if err := sf.checkArchiveHeight(height); err != nil {
+			return err
+		}
+	}
 	tlt, err := newTwoLayerTrie(ArchiveTrieNamespace, sf.dao, fmt.Sprintf("%s-%d", ArchiveTrieRootKey, height), false)
 	if err != nil {
 		return errors.Wrapf(err, "failed to generate trie for %d", height)
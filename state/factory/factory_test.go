@@ -26,11 +26,13 @@ import (
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-election/test/mock/mock_committee"
 	"github.com/iotexproject/iotex-election/types"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/action/protocol/poll"
 	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
@@ -379,6 +381,111 @@ func TestHistoryState(t *testing.T) {
 	testHistoryState(sf, t, true, cfg.Chain.EnableArchiveMode)
 }
 
+func TestHistoryStateRetention(t *testing.T) {
+	r := require.New(t)
+	var err error
+	cfg := config.Default
+	cfg.Chain.TrieDBPath, err = testutil.PathOfTempFile(triePath)
+	r.NoError(err)
+	cfg.Chain.EnableArchiveMode = true
+	cfg.DB.HistoryStateRetention = 1
+	sf, err := NewFactory(cfg, DefaultTrieOption(), SkipBlockValidationOption())
+	r.NoError(err)
+	r.NoError(sf.Register(account.NewProtocol(rewarding.DepositGas)))
+	ctx := protocol.WithBlockchainCtx(
+		protocol.WithBlockCtx(context.Background(), protocol.BlockCtx{}),
+		protocol.BlockchainCtx{Genesis: config.Default.Genesis},
+	)
+	r.NoError(sf.Start(ctx))
+	defer func() {
+		r.NoError(sf.Stop(ctx))
+	}()
+	blk, err := block.NewTestingBuilder().
+		SetHeight(1).
+		SetPrevBlockHash(hash.ZeroHash256).
+		SetTimeStamp(testutil.TimestampNow()).
+		SignAndBuild(identityset.PrivateKey(27))
+	r.NoError(err)
+	r.NoError(sf.PutBlock(protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+		BlockHeight: 1,
+		Producer:    identityset.Address(27),
+	}), &blk))
+
+	// only the state at tip is retained
+	addr := identityset.Address(28)
+	_, err = accountutil.AccountState(NewHistoryStateReader(sf, 0), addr.String())
+	r.Equal(ErrHistoryPruned, errors.Cause(err))
+	_, err = sf.ProofAtHeight(0, protocol.LegacyKeyOption(hash.BytesToHash160(addr.Bytes())))
+	r.Equal(ErrHistoryPruned, errors.Cause(err))
+	exec, err := action.NewExecution(identityset.Address(31).String(), 1, big.NewInt(0), 100000, big.NewInt(0), nil)
+	r.NoError(err)
+	_, _, err = sf.SimulateExecutionAtHeight(ctx, 0, addr, exec, nil)
+	r.Equal(ErrHistoryPruned, errors.Cause(err))
+	_, _, err = sf.SimulateExecutionAtHeight(ctx, 2, addr, exec, nil)
+	r.Error(err)
+	_, err = accountutil.AccountState(NewHistoryStateReader(sf, 1), addr.String())
+	r.NoError(err)
+}
+
+func TestTraceAction(t *testing.T) {
+	r := require.New(t)
+	var err error
+	cfg := config.Default
+	cfg.Chain.TrieDBPath, err = testutil.PathOfTempFile(triePath)
+	r.NoError(err)
+	sf, err := NewFactory(cfg, DefaultTrieOption(), SkipBlockValidationOption())
+	r.NoError(err)
+	r.NoError(sf.Register(account.NewProtocol(rewarding.DepositGas)))
+	a := identityset.Address(28)
+	b := identityset.Address(31)
+	genesis.Default.InitBalanceMap[a.String()] = "100"
+	ctx := protocol.WithBlockchainCtx(
+		protocol.WithBlockCtx(context.Background(), protocol.BlockCtx{}),
+		protocol.BlockchainCtx{Genesis: config.Default.Genesis},
+	)
+	r.NoError(sf.Start(ctx))
+	defer func() {
+		r.NoError(sf.Stop(ctx))
+	}()
+
+	// b only has the balance to transfer after the transfer from a in the same block
+	tsf1, err := testutil.SignedTransfer(b.String(), identityset.PrivateKey(28), 1, big.NewInt(100), nil, 20000, big.NewInt(0))
+	r.NoError(err)
+	tsf2, err := testutil.SignedTransfer(a.String(), identityset.PrivateKey(31), 1, big.NewInt(40), nil, 20000, big.NewInt(0))
+	r.NoError(err)
+	putBlock := func(height uint64, prev hash.Hash256, actions ...action.SealedEnvelope) block.Block {
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetPrevBlockHash(prev).
+			SetTimeStamp(testutil.TimestampNow()).
+			AddActions(actions...).
+			SignAndBuild(identityset.PrivateKey(27))
+		r.NoError(err)
+		r.NoError(sf.PutBlock(protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight: height,
+			Producer:    identityset.Address(27),
+			GasLimit:    cfg.Genesis.BlockGasLimit,
+		}), &blk))
+		return blk
+	}
+	blk := putBlock(1, hash.ZeroHash256, tsf1, tsf2)
+
+	// the state of the parent of the tip is kept without archive mode
+	receipt, err := sf.TraceAction(ctx, &blk, tsf2.Hash(), evm.NewTracer(nil))
+	r.NoError(err)
+	r.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+	r.Equal(tsf2.Hash(), receipt.ActionHash)
+	_, err = sf.TraceAction(ctx, &blk, hash.ZeroHash256, evm.NewTracer(nil))
+	r.Error(err)
+
+	// the state of height 1 is gone after the next block
+	blk2 := putBlock(2, blk.HashBlock())
+	_, err = sf.TraceAction(ctx, &blk, tsf2.Hash(), evm.NewTracer(nil))
+	r.Equal(ErrNoArchiveData, errors.Cause(err))
+	_, err = sf.TraceAction(ctx, &blk2, tsf2.Hash(), evm.NewTracer(nil))
+	r.Error(err)
+}
+
 func TestFactoryStates(t *testing.T) {
 	r := require.New(t)
 	var err error
@@ -537,6 +644,12 @@ func testHistoryState(sf Factory, t *testing.T, statetx, archive bool) {
 	require.NoError(t, err)
 	require.Equal(t, big.NewInt(90), accountA.Balance)
 	require.Equal(t, big.NewInt(10), accountB.Balance)
+	if !statetx {
+		// the states at the tip height are read without archive mode
+		accountB, err = accountutil.AccountState(NewHistoryStateReader(sf, 1), b)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(10), accountB.Balance)
+	}
 
 	// check archive data
 	if statetx {
@@ -546,19 +659,76 @@ func testHistoryState(sf Factory, t *testing.T, statetx, archive bool) {
 		_, err = accountutil.AccountState(NewHistoryStateReader(sf, 0), b)
 		require.Equal(t, ErrNotSupported, errors.Cause(err))
 	} else {
-		if !archive {
-			_, err = accountutil.AccountState(NewHistoryStateReader(sf, 0), a)
-			require.Equal(t, ErrNoArchiveData, errors.Cause(err))
-			_, err = accountutil.AccountState(NewHistoryStateReader(sf, 0), b)
-			require.Equal(t, ErrNoArchiveData, errors.Cause(err))
-		} else {
-			accountA, err = accountutil.AccountState(NewHistoryStateReader(sf, 0), a)
-			require.NoError(t, err)
-			accountB, err = accountutil.AccountState(NewHistoryStateReader(sf, 0), b)
-			require.NoError(t, err)
-			require.Equal(t, big.NewInt(100), accountA.Balance)
-			require.Equal(t, big.NewInt(0), accountB.Balance)
-		}
+		// the states of the parent of the tip are kept without archive mode
+		accountA, err = accountutil.AccountState(NewHistoryStateReader(sf, 0), a)
+		require.NoError(t, err)
+		accountB, err = accountutil.AccountState(NewHistoryStateReader(sf, 0), b)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(100), accountA.Balance)
+		require.Equal(t, big.NewInt(0), accountB.Balance)
+	}
+
+	// check state proof
+	addrA := hash.BytesToHash160(identityset.Address(28).Bytes())
+	addrB := hash.BytesToHash160(identityset.Address(31).Bytes())
+	if statetx {
+		_, err = sf.ProofAtHeight(1, protocol.LegacyKeyOption(addrB))
+		require.Equal(t, ErrNotSupported, errors.Cause(err))
+		return
+	}
+	_, err = sf.ProofAtHeight(2, protocol.LegacyKeyOption(addrB))
+	require.Error(t, err)
+	proof, err := sf.ProofAtHeight(1, protocol.LegacyKeyOption(addrB))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), proof.Height)
+	value, err := VerifyStateProof(proof, protocol.LegacyKeyOption(addrB))
+	require.NoError(t, err)
+	var acct state.Account
+	require.NoError(t, state.Deserialize(&acct, value))
+	require.Equal(t, big.NewInt(10), acct.Balance)
+	// the proof cannot be used for another key
+	_, err = VerifyStateProof(proof, protocol.LegacyKeyOption(addrA))
+	require.Error(t, err)
+	proof, err = sf.ProofAtHeight(0, protocol.LegacyKeyOption(addrA))
+	require.NoError(t, err)
+	value, err = VerifyStateProof(proof, protocol.LegacyKeyOption(addrA))
+	require.NoError(t, err)
+	require.NoError(t, state.Deserialize(&acct, value))
+	require.Equal(t, big.NewInt(100), acct.Balance)
+	proof, err = sf.ProofAtHeight(0, protocol.LegacyKeyOption(addrB))
+	require.NoError(t, err)
+	_, err = VerifyStateProof(proof, protocol.LegacyKeyOption(addrB))
+	require.Equal(t, state.ErrStateNotExist, errors.Cause(err))
+
+	// commit another block, the states of height 0 are gone without archive mode
+	tsf, err = action.NewTransfer(2, big.NewInt(10), b, nil, uint64(20000), big.NewInt(0))
+	require.NoError(t, err)
+	selp, err = action.Sign(bd.SetNonce(2).SetAction(tsf).SetGasLimit(20000).Build(), priKeyA)
+	require.NoError(t, err)
+	blk, err = block.NewTestingBuilder().
+		SetHeight(2).
+		SetPrevBlockHash(blk.HashBlock()).
+		SetTimeStamp(testutil.TimestampNow()).
+		AddActions(selp).
+		SignAndBuild(identityset.PrivateKey(27))
+	require.NoError(t, err)
+	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+		BlockHeight: 2,
+		Producer:    identityset.Address(27),
+		GasLimit:    gasLimit,
+	})
+	require.NoError(t, sf.PutBlock(ctx, &blk))
+	accountB, err = accountutil.AccountState(NewHistoryStateReader(sf, 1), b)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10), accountB.Balance)
+	_, err = accountutil.AccountState(NewHistoryStateReader(sf, 0), a)
+	_, proofErr := sf.ProofAtHeight(0, protocol.LegacyKeyOption(addrA))
+	if archive {
+		require.NoError(t, err)
+		require.NoError(t, proofErr)
+	} else {
+		require.Equal(t, ErrNoArchiveData, errors.Cause(err))
+		require.Equal(t, ErrNoArchiveData, errors.Cause(proofErr))
 	}
 }
 
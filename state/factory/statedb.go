@@ -69,7 +69,7 @@ func DefaultStateDBOption() StateDBOption {
 			return errors.New("Invalid empty trie db path")
 		}
 		cfg.DB.DbPath = dbPath // TODO: remove this after moving TrieDBPath from cfg.Chain to cfg.DB
-		sdb.dao = db.NewBoltDB(cfg.DB)
+		sdb.dao = db.NewKVStore(cfg.DB)
 
 		return nil
 	}
@@ -440,6 +440,27 @@ func (sdb *stateDB) States(opts ...protocol.StateOption) (uint64, state.Iterator
 	return sdb.currentChainHeight, state.NewIterator(values), nil
 }
 
+// SimulateExecutionAtHeight simulates a running of smart contract operation on the state at height -- archive mode
+func (sdb *stateDB) SimulateExecutionAtHeight(
+	ctx context.Context,
+	height uint64,
+	caller address.Address,
+	ex *action.Execution,
+	getBlockHash evm.GetBlockHash,
+) ([]byte, *action.Receipt, error) {
+	return nil, nil, errors.Wrap(ErrNotSupported, "state db does not support archive mode")
+}
+
+// TraceAction re-runs an action of a committed block with the tracer -- archive mode
+func (sdb *stateDB) TraceAction(
+	ctx context.Context,
+	blk *block.Block,
+	h hash.Hash256,
+	tracer *evm.Tracer,
+) (*action.Receipt, error) {
+	return nil, errors.Wrap(ErrNotSupported, "state db does not support archive mode")
+}
+
 // StateAtHeight returns a confirmed state at height -- archive mode
 func (sdb *stateDB) StateAtHeight(height uint64, s interface{}, opts ...protocol.StateOption) error {
 	return ErrNotSupported
@@ -450,6 +471,11 @@ func (sdb *stateDB) StatesAtHeight(height uint64, opts ...protocol.StateOption)
 	return nil, errors.Wrap(ErrNotSupported, "state db does not support archive mode")
 }
 
+// ProofAtHeight returns the merkle proof of a state at height
+func (sdb *stateDB) ProofAtHeight(height uint64, opts ...protocol.StateOption) (*StateProof, error) {
+	return nil, errors.Wrap(ErrNotSupported, "state db does not have state trie")
+}
+
 // ReadView reads the view
 func (sdb *stateDB) ReadView(name string) (interface{}, error) {
 	return sdb.protocolView.Read(name)
@@ -19,6 +19,7 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/actpool/actioniterator"
 	"github.com/iotexproject/iotex-core/blockchain/block"
@@ -360,6 +361,38 @@ func (ws *workingSet) process(ctx context.Context, actions []action.SealedEnvelo
 	return ws.finalize()
 }
 
+// traceAction runs the actions before the action of the hash, and then runs the action with the tracer
+func (ws *workingSet) traceAction(
+	ctx context.Context,
+	actions []action.SealedEnvelope,
+	h hash.Hash256,
+	tracer *evm.Tracer,
+) (*action.Receipt, error) {
+	if err := ws.validate(ctx); err != nil {
+		return nil, err
+	}
+	for _, p := range protocol.MustGetRegistry(ctx).All() {
+		if pp, ok := p.(protocol.PreStatesCreator); ok {
+			if err := pp.CreatePreStates(ctx, ws); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, elp := range actions {
+		ctx, err := withActionCtx(ctx, elp)
+		if err != nil {
+			return nil, err
+		}
+		if elp.Hash() == h {
+			return ws.runAction(evm.WithTracer(ctx, tracer), elp)
+		}
+		if _, err := ws.runAction(ctx, elp); err != nil {
+			return nil, errors.Wrap(err, "error when run action")
+		}
+	}
+	return nil, errors.Errorf("action %x is not in block %d", h, ws.height)
+}
+
 func (ws *workingSet) pickAndRunActions(
 	ctx context.Context,
 	ap actpool.ActPool,
@@ -10,6 +10,7 @@ import (
 	hash "github.com/iotexproject/go-pkgs/hash"
 	address "github.com/iotexproject/iotex-address/address"
 	action "github.com/iotexproject/iotex-core/action"
+	actpool "github.com/iotexproject/iotex-core/actpool"
 	block "github.com/iotexproject/iotex-core/blockchain/block"
 	reflect "reflect"
 )
@@ -51,6 +52,34 @@ func (mr *MockActPoolMockRecorder) Validate(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockActPool)(nil).Validate), arg0, arg1)
 }
 
+// Start mocks base method
+func (m *MockActPool) Start(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start
+func (mr *MockActPoolMockRecorder) Start(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockActPool)(nil).Start), arg0)
+}
+
+// Stop mocks base method
+func (m *MockActPool) Stop(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop
+func (mr *MockActPoolMockRecorder) Stop(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockActPool)(nil).Stop), arg0)
+}
+
 // Reset mocks base method
 func (m *MockActPool) Reset() {
 	m.ctrl.T.Helper()
@@ -217,6 +246,20 @@ func (mr *MockActPoolMockRecorder) ReceiveBlock(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveBlock", reflect.TypeOf((*MockActPool)(nil).ReceiveBlock), arg0)
 }
 
+// AddSubscriber mocks base method
+func (m *MockActPool) AddSubscriber(arg0 actpool.ActionSubscriber) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSubscriber", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSubscriber indicates an expected call of AddSubscriber
+func (mr *MockActPoolMockRecorder) AddSubscriber(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSubscriber", reflect.TypeOf((*MockActPool)(nil).AddSubscriber), arg0)
+}
+
 // AddActionEnvelopeValidators mocks base method
 func (m *MockActPool) AddActionEnvelopeValidators(arg0 ...action.SealedEnvelopeValidator) {
 	m.ctrl.T.Helper()
@@ -232,3 +275,40 @@ func (mr *MockActPoolMockRecorder) AddActionEnvelopeValidators(arg0 ...interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActionEnvelopeValidators", reflect.TypeOf((*MockActPool)(nil).AddActionEnvelopeValidators), arg0...)
 }
+
+// MockActionSubscriber is a mock of ActionSubscriber interface
+type MockActionSubscriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockActionSubscriberMockRecorder
+}
+
+// MockActionSubscriberMockRecorder is the mock recorder for MockActionSubscriber
+type MockActionSubscriberMockRecorder struct {
+	mock *MockActionSubscriber
+}
+
+// NewMockActionSubscriber creates a new mock instance
+func NewMockActionSubscriber(ctrl *gomock.Controller) *MockActionSubscriber {
+	mock := &MockActionSubscriber{ctrl: ctrl}
+	mock.recorder = &MockActionSubscriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockActionSubscriber) EXPECT() *MockActionSubscriberMockRecorder {
+	return m.recorder
+}
+
+// ReceiveAction mocks base method
+func (m *MockActionSubscriber) ReceiveAction(arg0 action.SealedEnvelope) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveAction", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReceiveAction indicates an expected call of ReceiveAction
+func (mr *MockActionSubscriberMockRecorder) ReceiveAction(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveAction", reflect.TypeOf((*MockActionSubscriber)(nil).ReceiveAction), arg0)
+}
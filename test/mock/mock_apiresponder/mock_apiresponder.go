@@ -6,7 +6,9 @@ package mock_apiresponder
 
 import (
 	gomock "github.com/golang/mock/gomock"
+	action "github.com/iotexproject/iotex-core/action"
 	block "github.com/iotexproject/iotex-core/blockchain/block"
+	scheme "github.com/iotexproject/iotex-core/consensus/scheme"
 	reflect "reflect"
 )
 
@@ -58,3 +60,129 @@ func (mr *MockResponderMockRecorder) Exit() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exit", reflect.TypeOf((*MockResponder)(nil).Exit))
 }
+
+// MockActionResponder is a mock of ActionResponder interface
+type MockActionResponder struct {
+	ctrl     *gomock.Controller
+	recorder *MockActionResponderMockRecorder
+}
+
+// MockActionResponderMockRecorder is the mock recorder for MockActionResponder
+type MockActionResponderMockRecorder struct {
+	mock *MockActionResponder
+}
+
+// NewMockActionResponder creates a new mock instance
+func NewMockActionResponder(ctrl *gomock.Controller) *MockActionResponder {
+	mock := &MockActionResponder{ctrl: ctrl}
+	mock.recorder = &MockActionResponderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockActionResponder) EXPECT() *MockActionResponderMockRecorder {
+	return m.recorder
+}
+
+// Respond mocks base method
+func (m *MockActionResponder) Respond(arg0 *block.Block) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Respond", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Respond indicates an expected call of Respond
+func (mr *MockActionResponderMockRecorder) Respond(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Respond", reflect.TypeOf((*MockActionResponder)(nil).Respond), arg0)
+}
+
+// Exit mocks base method
+func (m *MockActionResponder) Exit() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Exit")
+}
+
+// Exit indicates an expected call of Exit
+func (mr *MockActionResponderMockRecorder) Exit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exit", reflect.TypeOf((*MockActionResponder)(nil).Exit))
+}
+
+// RespondAction mocks base method
+func (m *MockActionResponder) RespondAction(arg0 action.SealedEnvelope) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondAction", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RespondAction indicates an expected call of RespondAction
+func (mr *MockActionResponderMockRecorder) RespondAction(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondAction", reflect.TypeOf((*MockActionResponder)(nil).RespondAction), arg0)
+}
+
+// MockRoundLogResponder is a mock of RoundLogResponder interface
+type MockRoundLogResponder struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoundLogResponderMockRecorder
+}
+
+// MockRoundLogResponderMockRecorder is the mock recorder for MockRoundLogResponder
+type MockRoundLogResponderMockRecorder struct {
+	mock *MockRoundLogResponder
+}
+
+// NewMockRoundLogResponder creates a new mock instance
+func NewMockRoundLogResponder(ctrl *gomock.Controller) *MockRoundLogResponder {
+	mock := &MockRoundLogResponder{ctrl: ctrl}
+	mock.recorder = &MockRoundLogResponderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRoundLogResponder) EXPECT() *MockRoundLogResponderMockRecorder {
+	return m.recorder
+}
+
+// Respond mocks base method
+func (m *MockRoundLogResponder) Respond(arg0 *block.Block) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Respond", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Respond indicates an expected call of Respond
+func (mr *MockRoundLogResponderMockRecorder) Respond(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Respond", reflect.TypeOf((*MockRoundLogResponder)(nil).Respond), arg0)
+}
+
+// Exit mocks base method
+func (m *MockRoundLogResponder) Exit() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Exit")
+}
+
+// Exit indicates an expected call of Exit
+func (mr *MockRoundLogResponderMockRecorder) Exit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exit", reflect.TypeOf((*MockRoundLogResponder)(nil).Exit))
+}
+
+// RespondRoundLog mocks base method
+func (m *MockRoundLogResponder) RespondRoundLog(arg0 scheme.RoundLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondRoundLog", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RespondRoundLog indicates an expected call of RespondRoundLog
+func (mr *MockRoundLogResponderMockRecorder) RespondRoundLog(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondRoundLog", reflect.TypeOf((*MockRoundLogResponder)(nil).RespondRoundLog), arg0)
+}
@@ -130,3 +130,241 @@ func (mr *MockStreamBlocksServerMockRecorder) RecvMsg(m interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockStreamBlocksServer)(nil).RecvMsg), m)
 }
+
+// MockStreamPendingActionsServer is a mock of StreamPendingActionsServer interface
+type MockStreamPendingActionsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamPendingActionsServerMockRecorder
+}
+
+// MockStreamPendingActionsServerMockRecorder is the mock recorder for MockStreamPendingActionsServer
+type MockStreamPendingActionsServerMockRecorder struct {
+	mock *MockStreamPendingActionsServer
+}
+
+// NewMockStreamPendingActionsServer creates a new mock instance
+func NewMockStreamPendingActionsServer(ctrl *gomock.Controller) *MockStreamPendingActionsServer {
+	mock := &MockStreamPendingActionsServer{ctrl: ctrl}
+	mock.recorder = &MockStreamPendingActionsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockStreamPendingActionsServer) EXPECT() *MockStreamPendingActionsServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockStreamPendingActionsServer) Send(arg0 *iotexapi.StreamPendingActionsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockStreamPendingActionsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockStreamPendingActionsServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockStreamPendingActionsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockStreamPendingActionsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockStreamPendingActionsServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockStreamPendingActionsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockStreamPendingActionsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockStreamPendingActionsServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockStreamPendingActionsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockStreamPendingActionsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockStreamPendingActionsServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method
+func (m *MockStreamPendingActionsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockStreamPendingActionsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockStreamPendingActionsServer)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockStreamPendingActionsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockStreamPendingActionsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockStreamPendingActionsServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockStreamPendingActionsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockStreamPendingActionsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockStreamPendingActionsServer)(nil).RecvMsg), m)
+}
+
+// MockStreamRoundLogsServer is a mock of StreamRoundLogsServer interface
+type MockStreamRoundLogsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamRoundLogsServerMockRecorder
+}
+
+// MockStreamRoundLogsServerMockRecorder is the mock recorder for MockStreamRoundLogsServer
+type MockStreamRoundLogsServerMockRecorder struct {
+	mock *MockStreamRoundLogsServer
+}
+
+// NewMockStreamRoundLogsServer creates a new mock instance
+func NewMockStreamRoundLogsServer(ctrl *gomock.Controller) *MockStreamRoundLogsServer {
+	mock := &MockStreamRoundLogsServer{ctrl: ctrl}
+	mock.recorder = &MockStreamRoundLogsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockStreamRoundLogsServer) EXPECT() *MockStreamRoundLogsServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockStreamRoundLogsServer) Send(arg0 *iotexapi.StreamRoundLogsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockStreamRoundLogsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockStreamRoundLogsServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockStreamRoundLogsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockStreamRoundLogsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockStreamRoundLogsServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockStreamRoundLogsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockStreamRoundLogsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockStreamRoundLogsServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockStreamRoundLogsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockStreamRoundLogsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockStreamRoundLogsServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method
+func (m *MockStreamRoundLogsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockStreamRoundLogsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockStreamRoundLogsServer)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockStreamRoundLogsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockStreamRoundLogsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockStreamRoundLogsServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockStreamRoundLogsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockStreamRoundLogsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockStreamRoundLogsServer)(nil).RecvMsg), m)
+}
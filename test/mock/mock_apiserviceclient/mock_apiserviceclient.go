@@ -415,6 +415,66 @@ func (mr *MockServiceClientMockRecorder) GetTransactionLogByBlockHeight(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionLogByBlockHeight", reflect.TypeOf((*MockServiceClient)(nil).GetTransactionLogByBlockHeight), varargs...)
 }
 
+// GetAccountProof mocks base method
+func (m *MockServiceClient) GetAccountProof(ctx context.Context, in *iotexapi.GetAccountProofRequest, opts ...grpc.CallOption) (*iotexapi.GetAccountProofResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAccountProof", varargs...)
+	ret0, _ := ret[0].(*iotexapi.GetAccountProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountProof indicates an expected call of GetAccountProof
+func (mr *MockServiceClientMockRecorder) GetAccountProof(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountProof", reflect.TypeOf((*MockServiceClient)(nil).GetAccountProof), varargs...)
+}
+
+// GetActionProof mocks base method
+func (m *MockServiceClient) GetActionProof(ctx context.Context, in *iotexapi.GetActionProofRequest, opts ...grpc.CallOption) (*iotexapi.GetActionProofResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetActionProof", varargs...)
+	ret0, _ := ret[0].(*iotexapi.GetActionProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActionProof indicates an expected call of GetActionProof
+func (mr *MockServiceClientMockRecorder) GetActionProof(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActionProof", reflect.TypeOf((*MockServiceClient)(nil).GetActionProof), varargs...)
+}
+
+// TraceAction mocks base method
+func (m *MockServiceClient) TraceAction(ctx context.Context, in *iotexapi.TraceActionRequest, opts ...grpc.CallOption) (*iotexapi.TraceActionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TraceAction", varargs...)
+	ret0, _ := ret[0].(*iotexapi.TraceActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceAction indicates an expected call of TraceAction
+func (mr *MockServiceClientMockRecorder) TraceAction(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceAction", reflect.TypeOf((*MockServiceClient)(nil).TraceAction), varargs...)
+}
+
 // StreamBlocks mocks base method
 func (m *MockServiceClient) StreamBlocks(ctx context.Context, in *iotexapi.StreamBlocksRequest, opts ...grpc.CallOption) (iotexapi.APIService_StreamBlocksClient, error) {
 	m.ctrl.T.Helper()
@@ -455,6 +515,46 @@ func (mr *MockServiceClientMockRecorder) StreamLogs(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamLogs", reflect.TypeOf((*MockServiceClient)(nil).StreamLogs), varargs...)
 }
 
+// StreamPendingActions mocks base method
+func (m *MockServiceClient) StreamPendingActions(ctx context.Context, in *iotexapi.StreamPendingActionsRequest, opts ...grpc.CallOption) (iotexapi.APIService_StreamPendingActionsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamPendingActions", varargs...)
+	ret0, _ := ret[0].(iotexapi.APIService_StreamPendingActionsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamPendingActions indicates an expected call of StreamPendingActions
+func (mr *MockServiceClientMockRecorder) StreamPendingActions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamPendingActions", reflect.TypeOf((*MockServiceClient)(nil).StreamPendingActions), varargs...)
+}
+
+// StreamRoundLogs mocks base method
+func (m *MockServiceClient) StreamRoundLogs(ctx context.Context, in *iotexapi.StreamRoundLogsRequest, opts ...grpc.CallOption) (iotexapi.APIService_StreamRoundLogsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamRoundLogs", varargs...)
+	ret0, _ := ret[0].(iotexapi.APIService_StreamRoundLogsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamRoundLogs indicates an expected call of StreamRoundLogs
+func (mr *MockServiceClientMockRecorder) StreamRoundLogs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamRoundLogs", reflect.TypeOf((*MockServiceClient)(nil).StreamRoundLogs), varargs...)
+}
+
 // GetElectionBuckets mocks base method
 func (m *MockServiceClient) GetElectionBuckets(ctx context.Context, in *iotexapi.GetElectionBucketsRequest, opts ...grpc.CallOption) (*iotexapi.GetElectionBucketsResponse, error) {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ package mock_blocksync
 import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
+	proto "github.com/golang/protobuf/proto"
 	block "github.com/iotexproject/iotex-core/blockchain/block"
 	iotexrpc "github.com/iotexproject/iotex-proto/golang/iotexrpc"
 	peerstore "github.com/libp2p/go-libp2p-peerstore"
@@ -117,7 +118,7 @@ func (mr *MockBlockSyncMockRecorder) TargetHeight() *gomock.Call {
 }
 
 // ProcessSyncRequest mocks base method
-func (m *MockBlockSync) ProcessSyncRequest(ctx context.Context, peer peerstore.PeerInfo, sync *iotexrpc.BlockSync) error {
+func (m *MockBlockSync) ProcessSyncRequest(ctx context.Context, peer peerstore.PeerInfo, sync proto.Message) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ProcessSyncRequest", ctx, peer, sync)
 	ret0, _ := ret[0].(error)
@@ -157,3 +158,31 @@ func (mr *MockBlockSyncMockRecorder) ProcessBlockSync(ctx, blk interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessBlockSync", reflect.TypeOf((*MockBlockSync)(nil).ProcessBlockSync), ctx, blk)
 }
+
+// ProcessBlockHeaders mocks base method
+func (m *MockBlockSync) ProcessBlockHeaders(ctx context.Context, headers *iotexrpc.BlockHeaders) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessBlockHeaders", ctx, headers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessBlockHeaders indicates an expected call of ProcessBlockHeaders
+func (mr *MockBlockSyncMockRecorder) ProcessBlockHeaders(ctx, headers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessBlockHeaders", reflect.TypeOf((*MockBlockSync)(nil).ProcessBlockHeaders), ctx, headers)
+}
+
+// ProcessBlockBodies mocks base method
+func (m *MockBlockSync) ProcessBlockBodies(ctx context.Context, bodies *iotexrpc.BlockBodies) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessBlockBodies", ctx, bodies)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessBlockBodies indicates an expected call of ProcessBlockBodies
+func (mr *MockBlockSyncMockRecorder) ProcessBlockBodies(ctx, bodies interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessBlockBodies", reflect.TypeOf((*MockBlockSync)(nil).ProcessBlockBodies), ctx, bodies)
+}
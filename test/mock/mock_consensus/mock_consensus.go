@@ -119,6 +119,35 @@ func (mr *MockConsensusMockRecorder) Metrics() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metrics", reflect.TypeOf((*MockConsensus)(nil).Metrics))
 }
 
+// RoundLogs mocks base method
+func (m *MockConsensus) RoundLogs() ([]scheme.RoundLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RoundLogs")
+	ret0, _ := ret[0].([]scheme.RoundLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RoundLogs indicates an expected call of RoundLogs
+func (mr *MockConsensusMockRecorder) RoundLogs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoundLogs", reflect.TypeOf((*MockConsensus)(nil).RoundLogs))
+}
+
+// AddRoundLogSubscriber mocks base method
+func (m *MockConsensus) AddRoundLogSubscriber(arg0 scheme.RoundLogSubscriber) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRoundLogSubscriber", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRoundLogSubscriber indicates an expected call of AddRoundLogSubscriber
+func (mr *MockConsensusMockRecorder) AddRoundLogSubscriber(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRoundLogSubscriber", reflect.TypeOf((*MockConsensus)(nil).AddRoundLogSubscriber), arg0)
+}
+
 // Activate mocks base method
 func (m *MockConsensus) Activate(arg0 bool) {
 	m.ctrl.T.Helper()
@@ -81,7 +81,7 @@ func (mr *MockSubscriberMockRecorder) HandleBlockSync(arg0, arg1 interface{}) *g
 }
 
 // HandleSyncRequest mocks base method
-func (m *MockSubscriber) HandleSyncRequest(arg0 context.Context, arg1 peerstore.PeerInfo, arg2 *iotexrpc.BlockSync) error {
+func (m *MockSubscriber) HandleSyncRequest(arg0 context.Context, arg1 peerstore.PeerInfo, arg2 proto.Message) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "HandleSyncRequest", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
@@ -94,6 +94,34 @@ func (mr *MockSubscriberMockRecorder) HandleSyncRequest(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSyncRequest", reflect.TypeOf((*MockSubscriber)(nil).HandleSyncRequest), arg0, arg1, arg2)
 }
 
+// HandleBlockHeaders mocks base method
+func (m *MockSubscriber) HandleBlockHeaders(arg0 context.Context, arg1 *iotexrpc.BlockHeaders) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleBlockHeaders", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleBlockHeaders indicates an expected call of HandleBlockHeaders
+func (mr *MockSubscriberMockRecorder) HandleBlockHeaders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleBlockHeaders", reflect.TypeOf((*MockSubscriber)(nil).HandleBlockHeaders), arg0, arg1)
+}
+
+// HandleBlockBodies mocks base method
+func (m *MockSubscriber) HandleBlockBodies(arg0 context.Context, arg1 *iotexrpc.BlockBodies) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleBlockBodies", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleBlockBodies indicates an expected call of HandleBlockBodies
+func (mr *MockSubscriberMockRecorder) HandleBlockBodies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleBlockBodies", reflect.TypeOf((*MockSubscriber)(nil).HandleBlockBodies), arg0, arg1)
+}
+
 // HandleConsensusMsg mocks base method
 func (m *MockSubscriber) HandleConsensusMsg(arg0 *iotextypes.ConsensusMessage) error {
 	m.ctrl.T.Helper()
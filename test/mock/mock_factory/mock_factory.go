@@ -7,6 +7,7 @@ package mock_factory
 import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
+	hash "github.com/iotexproject/go-pkgs/hash"
 	address "github.com/iotexproject/iotex-address/address"
 	action "github.com/iotexproject/iotex-core/action"
 	protocol "github.com/iotexproject/iotex-core/action/protocol"
@@ -14,6 +15,7 @@ import (
 	actpool "github.com/iotexproject/iotex-core/actpool"
 	block "github.com/iotexproject/iotex-core/blockchain/block"
 	state "github.com/iotexproject/iotex-core/state"
+	factory "github.com/iotexproject/iotex-core/state/factory"
 	reflect "reflect"
 )
 
@@ -197,6 +199,37 @@ func (mr *MockFactoryMockRecorder) SimulateExecution(arg0, arg1, arg2, arg3 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateExecution", reflect.TypeOf((*MockFactory)(nil).SimulateExecution), arg0, arg1, arg2, arg3)
 }
 
+// SimulateExecutionAtHeight mocks base method
+func (m *MockFactory) SimulateExecutionAtHeight(arg0 context.Context, arg1 uint64, arg2 address.Address, arg3 *action.Execution, arg4 evm.GetBlockHash) ([]byte, *action.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateExecutionAtHeight", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*action.Receipt)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SimulateExecutionAtHeight indicates an expected call of SimulateExecutionAtHeight
+func (mr *MockFactoryMockRecorder) SimulateExecutionAtHeight(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateExecutionAtHeight", reflect.TypeOf((*MockFactory)(nil).SimulateExecutionAtHeight), arg0, arg1, arg2, arg3, arg4)
+}
+
+// TraceAction mocks base method
+func (m *MockFactory) TraceAction(arg0 context.Context, arg1 *block.Block, arg2 hash.Hash256, arg3 *evm.Tracer) (*action.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceAction", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*action.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceAction indicates an expected call of TraceAction
+func (mr *MockFactoryMockRecorder) TraceAction(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceAction", reflect.TypeOf((*MockFactory)(nil).TraceAction), arg0, arg1, arg2, arg3)
+}
+
 // PutBlock mocks base method
 func (m *MockFactory) PutBlock(arg0 context.Context, arg1 *block.Block) error {
 	m.ctrl.T.Helper()
@@ -263,3 +296,23 @@ func (mr *MockFactoryMockRecorder) StatesAtHeight(arg0 interface{}, arg1 ...inte
 	varargs := append([]interface{}{arg0}, arg1...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatesAtHeight", reflect.TypeOf((*MockFactory)(nil).StatesAtHeight), varargs...)
 }
+
+// ProofAtHeight mocks base method
+func (m *MockFactory) ProofAtHeight(arg0 uint64, arg1 ...protocol.StateOption) (*factory.StateProof, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProofAtHeight", varargs...)
+	ret0, _ := ret[0].(*factory.StateProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProofAtHeight indicates an expected call of ProofAtHeight
+func (mr *MockFactoryMockRecorder) ProofAtHeight(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProofAtHeight", reflect.TypeOf((*MockFactory)(nil).ProofAtHeight), varargs...)
+}
@@ -143,6 +143,21 @@ func (mr *MockTrieMockRecorder) Delete(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTrie)(nil).Delete), arg0)
 }
 
+// GetProof mocks base method
+func (m *MockTrie) GetProof(arg0 []byte) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProof", arg0)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProof indicates an expected call of GetProof
+func (mr *MockTrieMockRecorder) GetProof(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProof", reflect.TypeOf((*MockTrie)(nil).GetProof), arg0)
+}
+
 // RootHash mocks base method
 func (m *MockTrie) RootHash() ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -308,3 +323,19 @@ func (mr *MockTwoLayerTrieMockRecorder) Delete(arg0, arg1 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTwoLayerTrie)(nil).Delete), arg0, arg1)
 }
+
+// GetProof mocks base method
+func (m *MockTwoLayerTrie) GetProof(arg0, arg1 []byte) ([][]byte, [][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProof", arg0, arg1)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].([][]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProof indicates an expected call of GetProof
+func (mr *MockTwoLayerTrieMockRecorder) GetProof(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProof", reflect.TypeOf((*MockTwoLayerTrie)(nil).GetProof), arg0, arg1)
+}
@@ -0,0 +1,8380 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+// To compile the proto, run:
+//      protoc -I. -I ./../types --go_out=plugins=grpc:$GOPATH/src *.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.4
+// source: proto/api/api.proto
+
+package iotexapi
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	iotextypes "github.com/iotexproject/iotex-proto/golang/iotextypes"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Bucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hex string
+	Voter         string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Votes         string `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	WeightedVotes string `protobuf:"bytes,3,opt,name=weightedVotes,proto3" json:"weightedVotes,omitempty"`
+	// human readable duration
+	RemainingDuration string `protobuf:"bytes,4,opt,name=remainingDuration,proto3" json:"remainingDuration,omitempty"`
+}
+
+func (x *Bucket) Reset() {
+	*x = Bucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bucket) ProtoMessage() {}
+
+func (x *Bucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bucket.ProtoReflect.Descriptor instead.
+func (*Bucket) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Bucket) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+func (x *Bucket) GetVotes() string {
+	if x != nil {
+		return x.Votes
+	}
+	return ""
+}
+
+func (x *Bucket) GetWeightedVotes() string {
+	if x != nil {
+		return x.WeightedVotes
+	}
+	return ""
+}
+
+func (x *Bucket) GetRemainingDuration() string {
+	if x != nil {
+		return x.RemainingDuration
+	}
+	return ""
+}
+
+type GetAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height of the block to query the account at, 0 for the tip
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAccountRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetAccountRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type GetAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountMeta     *iotextypes.AccountMeta     `protobuf:"bytes,1,opt,name=accountMeta,proto3" json:"accountMeta,omitempty"`
+	BlockIdentifier *iotextypes.BlockIdentifier `protobuf:"bytes,2,opt,name=blockIdentifier,proto3" json:"blockIdentifier,omitempty"`
+}
+
+func (x *GetAccountResponse) Reset() {
+	*x = GetAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountResponse) ProtoMessage() {}
+
+func (x *GetAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAccountResponse) GetAccountMeta() *iotextypes.AccountMeta {
+	if x != nil {
+		return x.AccountMeta
+	}
+	return nil
+}
+
+func (x *GetAccountResponse) GetBlockIdentifier() *iotextypes.BlockIdentifier {
+	if x != nil {
+		return x.BlockIdentifier
+	}
+	return nil
+}
+
+type GetActionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Lookup:
+	//	*GetActionsRequest_ByIndex
+	//	*GetActionsRequest_ByHash
+	//	*GetActionsRequest_ByAddr
+	//	*GetActionsRequest_UnconfirmedByAddr
+	//	*GetActionsRequest_ByBlk
+	Lookup isGetActionsRequest_Lookup `protobuf_oneof:"lookup"`
+}
+
+func (x *GetActionsRequest) Reset() {
+	*x = GetActionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionsRequest) ProtoMessage() {}
+
+func (x *GetActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionsRequest.ProtoReflect.Descriptor instead.
+func (*GetActionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *GetActionsRequest) GetLookup() isGetActionsRequest_Lookup {
+	if m != nil {
+		return m.Lookup
+	}
+	return nil
+}
+
+func (x *GetActionsRequest) GetByIndex() *GetActionsByIndexRequest {
+	if x, ok := x.GetLookup().(*GetActionsRequest_ByIndex); ok {
+		return x.ByIndex
+	}
+	return nil
+}
+
+func (x *GetActionsRequest) GetByHash() *GetActionByHashRequest {
+	if x, ok := x.GetLookup().(*GetActionsRequest_ByHash); ok {
+		return x.ByHash
+	}
+	return nil
+}
+
+func (x *GetActionsRequest) GetByAddr() *GetActionsByAddressRequest {
+	if x, ok := x.GetLookup().(*GetActionsRequest_ByAddr); ok {
+		return x.ByAddr
+	}
+	return nil
+}
+
+func (x *GetActionsRequest) GetUnconfirmedByAddr() *GetUnconfirmedActionsByAddressRequest {
+	if x, ok := x.GetLookup().(*GetActionsRequest_UnconfirmedByAddr); ok {
+		return x.UnconfirmedByAddr
+	}
+	return nil
+}
+
+func (x *GetActionsRequest) GetByBlk() *GetActionsByBlockRequest {
+	if x, ok := x.GetLookup().(*GetActionsRequest_ByBlk); ok {
+		return x.ByBlk
+	}
+	return nil
+}
+
+type isGetActionsRequest_Lookup interface {
+	isGetActionsRequest_Lookup()
+}
+
+type GetActionsRequest_ByIndex struct {
+	ByIndex *GetActionsByIndexRequest `protobuf:"bytes,1,opt,name=byIndex,proto3,oneof"`
+}
+
+type GetActionsRequest_ByHash struct {
+	ByHash *GetActionByHashRequest `protobuf:"bytes,2,opt,name=byHash,proto3,oneof"`
+}
+
+type GetActionsRequest_ByAddr struct {
+	ByAddr *GetActionsByAddressRequest `protobuf:"bytes,3,opt,name=byAddr,proto3,oneof"`
+}
+
+type GetActionsRequest_UnconfirmedByAddr struct {
+	UnconfirmedByAddr *GetUnconfirmedActionsByAddressRequest `protobuf:"bytes,4,opt,name=unconfirmedByAddr,proto3,oneof"`
+}
+
+type GetActionsRequest_ByBlk struct {
+	ByBlk *GetActionsByBlockRequest `protobuf:"bytes,5,opt,name=byBlk,proto3,oneof"`
+}
+
+func (*GetActionsRequest_ByIndex) isGetActionsRequest_Lookup() {}
+
+func (*GetActionsRequest_ByHash) isGetActionsRequest_Lookup() {}
+
+func (*GetActionsRequest_ByAddr) isGetActionsRequest_Lookup() {}
+
+func (*GetActionsRequest_UnconfirmedByAddr) isGetActionsRequest_Lookup() {}
+
+func (*GetActionsRequest_ByBlk) isGetActionsRequest_Lookup() {}
+
+type GetActionsByIndexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetActionsByIndexRequest) Reset() {
+	*x = GetActionsByIndexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActionsByIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionsByIndexRequest) ProtoMessage() {}
+
+func (x *GetActionsByIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionsByIndexRequest.ProtoReflect.Descriptor instead.
+func (*GetActionsByIndexRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetActionsByIndexRequest) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetActionsByIndexRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetActionByHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionHash   string `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+	CheckPending bool   `protobuf:"varint,2,opt,name=checkPending,proto3" json:"checkPending,omitempty"`
+}
+
+func (x *GetActionByHashRequest) Reset() {
+	*x = GetActionByHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActionByHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionByHashRequest) ProtoMessage() {}
+
+func (x *GetActionByHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionByHashRequest.ProtoReflect.Descriptor instead.
+func (*GetActionByHashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetActionByHashRequest) GetActionHash() string {
+	if x != nil {
+		return x.ActionHash
+	}
+	return ""
+}
+
+func (x *GetActionByHashRequest) GetCheckPending() bool {
+	if x != nil {
+		return x.CheckPending
+	}
+	return false
+}
+
+type GetActionsByAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Start   uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count   uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetActionsByAddressRequest) Reset() {
+	*x = GetActionsByAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActionsByAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionsByAddressRequest) ProtoMessage() {}
+
+func (x *GetActionsByAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionsByAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetActionsByAddressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetActionsByAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetActionsByAddressRequest) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetActionsByAddressRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetUnconfirmedActionsByAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Start   uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count   uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetUnconfirmedActionsByAddressRequest) Reset() {
+	*x = GetUnconfirmedActionsByAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUnconfirmedActionsByAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnconfirmedActionsByAddressRequest) ProtoMessage() {}
+
+func (x *GetUnconfirmedActionsByAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnconfirmedActionsByAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetUnconfirmedActionsByAddressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUnconfirmedActionsByAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetUnconfirmedActionsByAddressRequest) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetUnconfirmedActionsByAddressRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetActionsByBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlkHash string `protobuf:"bytes,1,opt,name=blkHash,proto3" json:"blkHash,omitempty"`
+	Start   uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count   uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetActionsByBlockRequest) Reset() {
+	*x = GetActionsByBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActionsByBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionsByBlockRequest) ProtoMessage() {}
+
+func (x *GetActionsByBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionsByBlockRequest.ProtoReflect.Descriptor instead.
+func (*GetActionsByBlockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetActionsByBlockRequest) GetBlkHash() string {
+	if x != nil {
+		return x.BlkHash
+	}
+	return ""
+}
+
+func (x *GetActionsByBlockRequest) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetActionsByBlockRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ActionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action    *iotextypes.Action   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	ActHash   string               `protobuf:"bytes,2,opt,name=actHash,proto3" json:"actHash,omitempty"`
+	BlkHash   string               `protobuf:"bytes,3,opt,name=blkHash,proto3" json:"blkHash,omitempty"`
+	BlkHeight uint64               `protobuf:"varint,5,opt,name=blkHeight,proto3" json:"blkHeight,omitempty"`
+	Sender    string               `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	GasFee    string               `protobuf:"bytes,7,opt,name=gasFee,proto3" json:"gasFee,omitempty"`
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ActionInfo) Reset() {
+	*x = ActionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionInfo) ProtoMessage() {}
+
+func (x *ActionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionInfo.ProtoReflect.Descriptor instead.
+func (*ActionInfo) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ActionInfo) GetAction() *iotextypes.Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *ActionInfo) GetActHash() string {
+	if x != nil {
+		return x.ActHash
+	}
+	return ""
+}
+
+func (x *ActionInfo) GetBlkHash() string {
+	if x != nil {
+		return x.BlkHash
+	}
+	return ""
+}
+
+func (x *ActionInfo) GetBlkHeight() uint64 {
+	if x != nil {
+		return x.BlkHeight
+	}
+	return 0
+}
+
+func (x *ActionInfo) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *ActionInfo) GetGasFee() string {
+	if x != nil {
+		return x.GasFee
+	}
+	return ""
+}
+
+func (x *ActionInfo) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type ReceiptInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipt *iotextypes.Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	BlkHash string              `protobuf:"bytes,2,opt,name=blkHash,proto3" json:"blkHash,omitempty"`
+}
+
+func (x *ReceiptInfo) Reset() {
+	*x = ReceiptInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptInfo) ProtoMessage() {}
+
+func (x *ReceiptInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptInfo.ProtoReflect.Descriptor instead.
+func (*ReceiptInfo) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReceiptInfo) GetReceipt() *iotextypes.Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *ReceiptInfo) GetBlkHash() string {
+	if x != nil {
+		return x.BlkHash
+	}
+	return ""
+}
+
+type BlockProducerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Votes      string `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	Active     bool   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Production uint64 `protobuf:"varint,4,opt,name=production,proto3" json:"production,omitempty"`
+}
+
+func (x *BlockProducerInfo) Reset() {
+	*x = BlockProducerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockProducerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockProducerInfo) ProtoMessage() {}
+
+func (x *BlockProducerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockProducerInfo.ProtoReflect.Descriptor instead.
+func (*BlockProducerInfo) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BlockProducerInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BlockProducerInfo) GetVotes() string {
+	if x != nil {
+		return x.Votes
+	}
+	return ""
+}
+
+func (x *BlockProducerInfo) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *BlockProducerInfo) GetProduction() uint64 {
+	if x != nil {
+		return x.Production
+	}
+	return 0
+}
+
+type BlockInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block           *iotextypes.Block           `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Receipts        []*iotextypes.Receipt       `protobuf:"bytes,2,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	TransactionLogs *iotextypes.TransactionLogs `protobuf:"bytes,3,opt,name=transactionLogs,proto3" json:"transactionLogs,omitempty"`
+}
+
+func (x *BlockInfo) Reset() {
+	*x = BlockInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockInfo) ProtoMessage() {}
+
+func (x *BlockInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockInfo.ProtoReflect.Descriptor instead.
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BlockInfo) GetBlock() *iotextypes.Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *BlockInfo) GetReceipts() []*iotextypes.Receipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+func (x *BlockInfo) GetTransactionLogs() *iotextypes.TransactionLogs {
+	if x != nil {
+		return x.TransactionLogs
+	}
+	return nil
+}
+
+type GetActionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total      uint64        `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	ActionInfo []*ActionInfo `protobuf:"bytes,1,rep,name=actionInfo,proto3" json:"actionInfo,omitempty"`
+}
+
+func (x *GetActionsResponse) Reset() {
+	*x = GetActionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionsResponse) ProtoMessage() {}
+
+func (x *GetActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionsResponse.ProtoReflect.Descriptor instead.
+func (*GetActionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetActionsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetActionsResponse) GetActionInfo() []*ActionInfo {
+	if x != nil {
+		return x.ActionInfo
+	}
+	return nil
+}
+
+type GetBlockMetasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Lookup:
+	//	*GetBlockMetasRequest_ByIndex
+	//	*GetBlockMetasRequest_ByHash
+	Lookup isGetBlockMetasRequest_Lookup `protobuf_oneof:"lookup"`
+}
+
+func (x *GetBlockMetasRequest) Reset() {
+	*x = GetBlockMetasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockMetasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockMetasRequest) ProtoMessage() {}
+
+func (x *GetBlockMetasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockMetasRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockMetasRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (m *GetBlockMetasRequest) GetLookup() isGetBlockMetasRequest_Lookup {
+	if m != nil {
+		return m.Lookup
+	}
+	return nil
+}
+
+func (x *GetBlockMetasRequest) GetByIndex() *GetBlockMetasByIndexRequest {
+	if x, ok := x.GetLookup().(*GetBlockMetasRequest_ByIndex); ok {
+		return x.ByIndex
+	}
+	return nil
+}
+
+func (x *GetBlockMetasRequest) GetByHash() *GetBlockMetaByHashRequest {
+	if x, ok := x.GetLookup().(*GetBlockMetasRequest_ByHash); ok {
+		return x.ByHash
+	}
+	return nil
+}
+
+type isGetBlockMetasRequest_Lookup interface {
+	isGetBlockMetasRequest_Lookup()
+}
+
+type GetBlockMetasRequest_ByIndex struct {
+	ByIndex *GetBlockMetasByIndexRequest `protobuf:"bytes,1,opt,name=byIndex,proto3,oneof"`
+}
+
+type GetBlockMetasRequest_ByHash struct {
+	ByHash *GetBlockMetaByHashRequest `protobuf:"bytes,2,opt,name=byHash,proto3,oneof"`
+}
+
+func (*GetBlockMetasRequest_ByIndex) isGetBlockMetasRequest_Lookup() {}
+
+func (*GetBlockMetasRequest_ByHash) isGetBlockMetasRequest_Lookup() {}
+
+type GetBlockMetasByIndexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetBlockMetasByIndexRequest) Reset() {
+	*x = GetBlockMetasByIndexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockMetasByIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockMetasByIndexRequest) ProtoMessage() {}
+
+func (x *GetBlockMetasByIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockMetasByIndexRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockMetasByIndexRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetBlockMetasByIndexRequest) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetBlockMetasByIndexRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetBlockMetaByHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlkHash string `protobuf:"bytes,1,opt,name=blkHash,proto3" json:"blkHash,omitempty"`
+}
+
+func (x *GetBlockMetaByHashRequest) Reset() {
+	*x = GetBlockMetaByHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockMetaByHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockMetaByHashRequest) ProtoMessage() {}
+
+func (x *GetBlockMetaByHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockMetaByHashRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockMetaByHashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetBlockMetaByHashRequest) GetBlkHash() string {
+	if x != nil {
+		return x.BlkHash
+	}
+	return ""
+}
+
+type GetBlockMetasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total    uint64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	BlkMetas []*iotextypes.BlockMeta `protobuf:"bytes,1,rep,name=blkMetas,proto3" json:"blkMetas,omitempty"`
+}
+
+func (x *GetBlockMetasResponse) Reset() {
+	*x = GetBlockMetasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockMetasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockMetasResponse) ProtoMessage() {}
+
+func (x *GetBlockMetasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockMetasResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockMetasResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetBlockMetasResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetBlockMetasResponse) GetBlkMetas() []*iotextypes.BlockMeta {
+	if x != nil {
+		return x.BlkMetas
+	}
+	return nil
+}
+
+type GetChainMetaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetChainMetaRequest) Reset() {
+	*x = GetChainMetaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChainMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainMetaRequest) ProtoMessage() {}
+
+func (x *GetChainMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetChainMetaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{18}
+}
+
+type GetChainMetaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainMeta *iotextypes.ChainMeta `protobuf:"bytes,1,opt,name=chainMeta,proto3" json:"chainMeta,omitempty"`
+}
+
+func (x *GetChainMetaResponse) Reset() {
+	*x = GetChainMetaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChainMetaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainMetaResponse) ProtoMessage() {}
+
+func (x *GetChainMetaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainMetaResponse.ProtoReflect.Descriptor instead.
+func (*GetChainMetaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetChainMetaResponse) GetChainMeta() *iotextypes.ChainMeta {
+	if x != nil {
+		return x.ChainMeta
+	}
+	return nil
+}
+
+type GetServerMetaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetServerMetaRequest) Reset() {
+	*x = GetServerMetaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServerMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerMetaRequest) ProtoMessage() {}
+
+func (x *GetServerMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetServerMetaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{20}
+}
+
+type GetServerMetaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerMeta *iotextypes.ServerMeta `protobuf:"bytes,1,opt,name=serverMeta,proto3" json:"serverMeta,omitempty"`
+}
+
+func (x *GetServerMetaResponse) Reset() {
+	*x = GetServerMetaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServerMetaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerMetaResponse) ProtoMessage() {}
+
+func (x *GetServerMetaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerMetaResponse.ProtoReflect.Descriptor instead.
+func (*GetServerMetaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetServerMetaResponse) GetServerMeta() *iotextypes.ServerMeta {
+	if x != nil {
+		return x.ServerMeta
+	}
+	return nil
+}
+
+type SendActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action *iotextypes.Action `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *SendActionRequest) Reset() {
+	*x = SendActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendActionRequest) ProtoMessage() {}
+
+func (x *SendActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendActionRequest.ProtoReflect.Descriptor instead.
+func (*SendActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SendActionRequest) GetAction() *iotextypes.Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type SendSignedActionBytesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignedActionBytes string `protobuf:"bytes,1,opt,name=signedActionBytes,proto3" json:"signedActionBytes,omitempty"`
+}
+
+func (x *SendSignedActionBytesRequest) Reset() {
+	*x = SendSignedActionBytesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendSignedActionBytesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendSignedActionBytesRequest) ProtoMessage() {}
+
+func (x *SendSignedActionBytesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendSignedActionBytesRequest.ProtoReflect.Descriptor instead.
+func (*SendSignedActionBytesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SendSignedActionBytesRequest) GetSignedActionBytes() string {
+	if x != nil {
+		return x.SignedActionBytes
+	}
+	return ""
+}
+
+type SendActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionHash string `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+}
+
+func (x *SendActionResponse) Reset() {
+	*x = SendActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendActionResponse) ProtoMessage() {}
+
+func (x *SendActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendActionResponse.ProtoReflect.Descriptor instead.
+func (*SendActionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SendActionResponse) GetActionHash() string {
+	if x != nil {
+		return x.ActionHash
+	}
+	return ""
+}
+
+type GetReceiptByActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionHash string `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+}
+
+func (x *GetReceiptByActionRequest) Reset() {
+	*x = GetReceiptByActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReceiptByActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptByActionRequest) ProtoMessage() {}
+
+func (x *GetReceiptByActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptByActionRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptByActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetReceiptByActionRequest) GetActionHash() string {
+	if x != nil {
+		return x.ActionHash
+	}
+	return ""
+}
+
+type GetReceiptByActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReceiptInfo *ReceiptInfo `protobuf:"bytes,1,opt,name=receiptInfo,proto3" json:"receiptInfo,omitempty"`
+}
+
+func (x *GetReceiptByActionResponse) Reset() {
+	*x = GetReceiptByActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReceiptByActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptByActionResponse) ProtoMessage() {}
+
+func (x *GetReceiptByActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptByActionResponse.ProtoReflect.Descriptor instead.
+func (*GetReceiptByActionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetReceiptByActionResponse) GetReceiptInfo() *ReceiptInfo {
+	if x != nil {
+		return x.ReceiptInfo
+	}
+	return nil
+}
+
+type ReadContractRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Execution     *iotextypes.Execution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	CallerAddress string                `protobuf:"bytes,2,opt,name=callerAddress,proto3" json:"callerAddress,omitempty"`
+	// height of the block to read the contract at, 0 for the tip. Field 3 and 4 are reserved for gasLimit and
+	// gasPrice
+	Height uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *ReadContractRequest) Reset() {
+	*x = ReadContractRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadContractRequest) ProtoMessage() {}
+
+func (x *ReadContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadContractRequest.ProtoReflect.Descriptor instead.
+func (*ReadContractRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReadContractRequest) GetExecution() *iotextypes.Execution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *ReadContractRequest) GetCallerAddress() string {
+	if x != nil {
+		return x.CallerAddress
+	}
+	return ""
+}
+
+func (x *ReadContractRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type ReadContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data    string              `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Receipt *iotextypes.Receipt `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (x *ReadContractResponse) Reset() {
+	*x = ReadContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadContractResponse) ProtoMessage() {}
+
+func (x *ReadContractResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadContractResponse.ProtoReflect.Descriptor instead.
+func (*ReadContractResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReadContractResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ReadContractResponse) GetReceipt() *iotextypes.Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+type SuggestGasPriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SuggestGasPriceRequest) Reset() {
+	*x = SuggestGasPriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestGasPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestGasPriceRequest) ProtoMessage() {}
+
+func (x *SuggestGasPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestGasPriceRequest.ProtoReflect.Descriptor instead.
+func (*SuggestGasPriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{29}
+}
+
+type SuggestGasPriceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GasPrice uint64 `protobuf:"varint,1,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+}
+
+func (x *SuggestGasPriceResponse) Reset() {
+	*x = SuggestGasPriceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestGasPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestGasPriceResponse) ProtoMessage() {}
+
+func (x *SuggestGasPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestGasPriceResponse.ProtoReflect.Descriptor instead.
+func (*SuggestGasPriceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SuggestGasPriceResponse) GetGasPrice() uint64 {
+	if x != nil {
+		return x.GasPrice
+	}
+	return 0
+}
+
+// To be deprecated
+type EstimateGasForActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action *iotextypes.Action `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *EstimateGasForActionRequest) Reset() {
+	*x = EstimateGasForActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateGasForActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateGasForActionRequest) ProtoMessage() {}
+
+func (x *EstimateGasForActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateGasForActionRequest.ProtoReflect.Descriptor instead.
+func (*EstimateGasForActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EstimateGasForActionRequest) GetAction() *iotextypes.Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type EstimateActionGasConsumptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Action:
+	//	*EstimateActionGasConsumptionRequest_Transfer
+	//	*EstimateActionGasConsumptionRequest_Execution
+	//	*EstimateActionGasConsumptionRequest_StakeCreate
+	//	*EstimateActionGasConsumptionRequest_StakeUnstake
+	//	*EstimateActionGasConsumptionRequest_StakeWithdraw
+	//	*EstimateActionGasConsumptionRequest_StakeAddDeposit
+	//	*EstimateActionGasConsumptionRequest_StakeRestake
+	//	*EstimateActionGasConsumptionRequest_StakeChangeCandidate
+	//	*EstimateActionGasConsumptionRequest_StakeTransferOwnership
+	//	*EstimateActionGasConsumptionRequest_CandidateRegister
+	//	*EstimateActionGasConsumptionRequest_CandidateUpdate
+	Action        isEstimateActionGasConsumptionRequest_Action `protobuf_oneof:"action"`
+	CallerAddress string                                       `protobuf:"bytes,100,opt,name=callerAddress,proto3" json:"callerAddress,omitempty"`
+}
+
+func (x *EstimateActionGasConsumptionRequest) Reset() {
+	*x = EstimateActionGasConsumptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateActionGasConsumptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateActionGasConsumptionRequest) ProtoMessage() {}
+
+func (x *EstimateActionGasConsumptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateActionGasConsumptionRequest.ProtoReflect.Descriptor instead.
+func (*EstimateActionGasConsumptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (m *EstimateActionGasConsumptionRequest) GetAction() isEstimateActionGasConsumptionRequest_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetTransfer() *iotextypes.Transfer {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetExecution() *iotextypes.Execution {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_Execution); ok {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetStakeCreate() *iotextypes.StakeCreate {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_StakeCreate); ok {
+		return x.StakeCreate
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetStakeUnstake() *iotextypes.StakeReclaim {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_StakeUnstake); ok {
+		return x.StakeUnstake
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetStakeWithdraw() *iotextypes.StakeReclaim {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_StakeWithdraw); ok {
+		return x.StakeWithdraw
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetStakeAddDeposit() *iotextypes.StakeAddDeposit {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_StakeAddDeposit); ok {
+		return x.StakeAddDeposit
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetStakeRestake() *iotextypes.StakeRestake {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_StakeRestake); ok {
+		return x.StakeRestake
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetStakeChangeCandidate() *iotextypes.StakeChangeCandidate {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_StakeChangeCandidate); ok {
+		return x.StakeChangeCandidate
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetStakeTransferOwnership() *iotextypes.StakeTransferOwnership {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_StakeTransferOwnership); ok {
+		return x.StakeTransferOwnership
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetCandidateRegister() *iotextypes.CandidateRegister {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_CandidateRegister); ok {
+		return x.CandidateRegister
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetCandidateUpdate() *iotextypes.CandidateBasicInfo {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_CandidateUpdate); ok {
+		return x.CandidateUpdate
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetCallerAddress() string {
+	if x != nil {
+		return x.CallerAddress
+	}
+	return ""
+}
+
+type isEstimateActionGasConsumptionRequest_Action interface {
+	isEstimateActionGasConsumptionRequest_Action()
+}
+
+type EstimateActionGasConsumptionRequest_Transfer struct {
+	Transfer *iotextypes.Transfer `protobuf:"bytes,1,opt,name=transfer,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_Execution struct {
+	Execution *iotextypes.Execution `protobuf:"bytes,2,opt,name=execution,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_StakeCreate struct {
+	// Native staking
+	StakeCreate *iotextypes.StakeCreate `protobuf:"bytes,40,opt,name=stakeCreate,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_StakeUnstake struct {
+	StakeUnstake *iotextypes.StakeReclaim `protobuf:"bytes,41,opt,name=stakeUnstake,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_StakeWithdraw struct {
+	StakeWithdraw *iotextypes.StakeReclaim `protobuf:"bytes,42,opt,name=stakeWithdraw,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_StakeAddDeposit struct {
+	StakeAddDeposit *iotextypes.StakeAddDeposit `protobuf:"bytes,43,opt,name=stakeAddDeposit,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_StakeRestake struct {
+	StakeRestake *iotextypes.StakeRestake `protobuf:"bytes,44,opt,name=stakeRestake,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_StakeChangeCandidate struct {
+	StakeChangeCandidate *iotextypes.StakeChangeCandidate `protobuf:"bytes,45,opt,name=stakeChangeCandidate,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_StakeTransferOwnership struct {
+	StakeTransferOwnership *iotextypes.StakeTransferOwnership `protobuf:"bytes,46,opt,name=stakeTransferOwnership,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_CandidateRegister struct {
+	CandidateRegister *iotextypes.CandidateRegister `protobuf:"bytes,47,opt,name=candidateRegister,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_CandidateUpdate struct {
+	CandidateUpdate *iotextypes.CandidateBasicInfo `protobuf:"bytes,48,opt,name=candidateUpdate,proto3,oneof"`
+}
+
+func (*EstimateActionGasConsumptionRequest_Transfer) isEstimateActionGasConsumptionRequest_Action() {}
+
+func (*EstimateActionGasConsumptionRequest_Execution) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_StakeCreate) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_StakeUnstake) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_StakeWithdraw) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_StakeAddDeposit) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_StakeRestake) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_StakeChangeCandidate) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_StakeTransferOwnership) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_CandidateRegister) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_CandidateUpdate) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+type EstimateActionGasConsumptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gas uint64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (x *EstimateActionGasConsumptionResponse) Reset() {
+	*x = EstimateActionGasConsumptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateActionGasConsumptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateActionGasConsumptionResponse) ProtoMessage() {}
+
+func (x *EstimateActionGasConsumptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateActionGasConsumptionResponse.ProtoReflect.Descriptor instead.
+func (*EstimateActionGasConsumptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EstimateActionGasConsumptionResponse) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+type EstimateGasForActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gas uint64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (x *EstimateGasForActionResponse) Reset() {
+	*x = EstimateGasForActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateGasForActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateGasForActionResponse) ProtoMessage() {}
+
+func (x *EstimateGasForActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateGasForActionResponse.ProtoReflect.Descriptor instead.
+func (*EstimateGasForActionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EstimateGasForActionResponse) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+type ReadStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolID []byte   `protobuf:"bytes,1,opt,name=protocolID,proto3" json:"protocolID,omitempty"`
+	MethodName []byte   `protobuf:"bytes,2,opt,name=methodName,proto3" json:"methodName,omitempty"`
+	Arguments  [][]byte `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	Height     string   `protobuf:"bytes,4,opt,name=height,proto3" json:"height,omitempty"` // optional, if not present, read from tip height
+}
+
+func (x *ReadStateRequest) Reset() {
+	*x = ReadStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadStateRequest) ProtoMessage() {}
+
+func (x *ReadStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadStateRequest.ProtoReflect.Descriptor instead.
+func (*ReadStateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReadStateRequest) GetProtocolID() []byte {
+	if x != nil {
+		return x.ProtocolID
+	}
+	return nil
+}
+
+func (x *ReadStateRequest) GetMethodName() []byte {
+	if x != nil {
+		return x.MethodName
+	}
+	return nil
+}
+
+func (x *ReadStateRequest) GetArguments() [][]byte {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *ReadStateRequest) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+type ReadStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data            []byte                      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	BlockIdentifier *iotextypes.BlockIdentifier `protobuf:"bytes,2,opt,name=blockIdentifier,proto3" json:"blockIdentifier,omitempty"`
+}
+
+func (x *ReadStateResponse) Reset() {
+	*x = ReadStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadStateResponse) ProtoMessage() {}
+
+func (x *ReadStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadStateResponse.ProtoReflect.Descriptor instead.
+func (*ReadStateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReadStateResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ReadStateResponse) GetBlockIdentifier() *iotextypes.BlockIdentifier {
+	if x != nil {
+		return x.BlockIdentifier
+	}
+	return nil
+}
+
+type GetEpochMetaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
+}
+
+func (x *GetEpochMetaRequest) Reset() {
+	*x = GetEpochMetaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEpochMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpochMetaRequest) ProtoMessage() {}
+
+func (x *GetEpochMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpochMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetEpochMetaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetEpochMetaRequest) GetEpochNumber() uint64 {
+	if x != nil {
+		return x.EpochNumber
+	}
+	return 0
+}
+
+type GetEpochMetaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochData          *iotextypes.EpochData `protobuf:"bytes,1,opt,name=epochData,proto3" json:"epochData,omitempty"`
+	TotalBlocks        uint64                `protobuf:"varint,2,opt,name=totalBlocks,proto3" json:"totalBlocks,omitempty"`
+	BlockProducersInfo []*BlockProducerInfo  `protobuf:"bytes,3,rep,name=blockProducersInfo,proto3" json:"blockProducersInfo,omitempty"`
+}
+
+func (x *GetEpochMetaResponse) Reset() {
+	*x = GetEpochMetaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEpochMetaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpochMetaResponse) ProtoMessage() {}
+
+func (x *GetEpochMetaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpochMetaResponse.ProtoReflect.Descriptor instead.
+func (*GetEpochMetaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetEpochMetaResponse) GetEpochData() *iotextypes.EpochData {
+	if x != nil {
+		return x.EpochData
+	}
+	return nil
+}
+
+func (x *GetEpochMetaResponse) GetTotalBlocks() uint64 {
+	if x != nil {
+		return x.TotalBlocks
+	}
+	return 0
+}
+
+func (x *GetEpochMetaResponse) GetBlockProducersInfo() []*BlockProducerInfo {
+	if x != nil {
+		return x.BlockProducersInfo
+	}
+	return nil
+}
+
+type GetRawBlocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartHeight         uint64 `protobuf:"varint,1,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	Count               uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	WithReceipts        bool   `protobuf:"varint,3,opt,name=withReceipts,proto3" json:"withReceipts,omitempty"`
+	WithTransactionLogs bool   `protobuf:"varint,4,opt,name=withTransactionLogs,proto3" json:"withTransactionLogs,omitempty"`
+}
+
+func (x *GetRawBlocksRequest) Reset() {
+	*x = GetRawBlocksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRawBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRawBlocksRequest) ProtoMessage() {}
+
+func (x *GetRawBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRawBlocksRequest.ProtoReflect.Descriptor instead.
+func (*GetRawBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetRawBlocksRequest) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *GetRawBlocksRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetRawBlocksRequest) GetWithReceipts() bool {
+	if x != nil {
+		return x.WithReceipts
+	}
+	return false
+}
+
+func (x *GetRawBlocksRequest) GetWithTransactionLogs() bool {
+	if x != nil {
+		return x.WithTransactionLogs
+	}
+	return false
+}
+
+type GetRawBlocksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks []*BlockInfo `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *GetRawBlocksResponse) Reset() {
+	*x = GetRawBlocksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRawBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRawBlocksResponse) ProtoMessage() {}
+
+func (x *GetRawBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRawBlocksResponse.ProtoReflect.Descriptor instead.
+func (*GetRawBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetRawBlocksResponse) GetBlocks() []*BlockInfo {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type GetLogsByBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHash []byte `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+}
+
+func (x *GetLogsByBlock) Reset() {
+	*x = GetLogsByBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogsByBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogsByBlock) ProtoMessage() {}
+
+func (x *GetLogsByBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogsByBlock.ProtoReflect.Descriptor instead.
+func (*GetLogsByBlock) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetLogsByBlock) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+type GetLogsByRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromBlock uint64 `protobuf:"varint,1,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	Count     uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetLogsByRange) Reset() {
+	*x = GetLogsByRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogsByRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogsByRange) ProtoMessage() {}
+
+func (x *GetLogsByRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogsByRange.ProtoReflect.Descriptor instead.
+func (*GetLogsByRange) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetLogsByRange) GetFromBlock() uint64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+func (x *GetLogsByRange) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Topics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic [][]byte `protobuf:"bytes,1,rep,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *Topics) Reset() {
+	*x = Topics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Topics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topics) ProtoMessage() {}
+
+func (x *Topics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Topics.ProtoReflect.Descriptor instead.
+func (*Topics) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Topics) GetTopic() [][]byte {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+type LogsFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address []string  `protobuf:"bytes,1,rep,name=address,proto3" json:"address,omitempty"`
+	Topics  []*Topics `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (x *LogsFilter) Reset() {
+	*x = LogsFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogsFilter) ProtoMessage() {}
+
+func (x *LogsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogsFilter.ProtoReflect.Descriptor instead.
+func (*LogsFilter) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LogsFilter) GetAddress() []string {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *LogsFilter) GetTopics() []*Topics {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type GetLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *LogsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Types that are assignable to Lookup:
+	//	*GetLogsRequest_ByBlock
+	//	*GetLogsRequest_ByRange
+	Lookup isGetLogsRequest_Lookup `protobuf_oneof:"lookup"`
+}
+
+func (x *GetLogsRequest) Reset() {
+	*x = GetLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogsRequest) ProtoMessage() {}
+
+func (x *GetLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetLogsRequest) GetFilter() *LogsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (m *GetLogsRequest) GetLookup() isGetLogsRequest_Lookup {
+	if m != nil {
+		return m.Lookup
+	}
+	return nil
+}
+
+func (x *GetLogsRequest) GetByBlock() *GetLogsByBlock {
+	if x, ok := x.GetLookup().(*GetLogsRequest_ByBlock); ok {
+		return x.ByBlock
+	}
+	return nil
+}
+
+func (x *GetLogsRequest) GetByRange() *GetLogsByRange {
+	if x, ok := x.GetLookup().(*GetLogsRequest_ByRange); ok {
+		return x.ByRange
+	}
+	return nil
+}
+
+type isGetLogsRequest_Lookup interface {
+	isGetLogsRequest_Lookup()
+}
+
+type GetLogsRequest_ByBlock struct {
+	ByBlock *GetLogsByBlock `protobuf:"bytes,2,opt,name=byBlock,proto3,oneof"`
+}
+
+type GetLogsRequest_ByRange struct {
+	ByRange *GetLogsByRange `protobuf:"bytes,3,opt,name=byRange,proto3,oneof"`
+}
+
+func (*GetLogsRequest_ByBlock) isGetLogsRequest_Lookup() {}
+
+func (*GetLogsRequest_ByRange) isGetLogsRequest_Lookup() {}
+
+type GetLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs []*iotextypes.Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *GetLogsResponse) Reset() {
+	*x = GetLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogsResponse) ProtoMessage() {}
+
+func (x *GetLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetLogsResponse) GetLogs() []*iotextypes.Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// Deprecated
+type GetEvmTransfersByActionHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionHash string `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+}
+
+func (x *GetEvmTransfersByActionHashRequest) Reset() {
+	*x = GetEvmTransfersByActionHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEvmTransfersByActionHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvmTransfersByActionHashRequest) ProtoMessage() {}
+
+func (x *GetEvmTransfersByActionHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvmTransfersByActionHashRequest.ProtoReflect.Descriptor instead.
+func (*GetEvmTransfersByActionHashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetEvmTransfersByActionHashRequest) GetActionHash() string {
+	if x != nil {
+		return x.ActionHash
+	}
+	return ""
+}
+
+// Deprecated
+type GetEvmTransfersByActionHashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionEvmTransfers *iotextypes.ActionEvmTransfer `protobuf:"bytes,1,opt,name=actionEvmTransfers,proto3" json:"actionEvmTransfers,omitempty"`
+}
+
+func (x *GetEvmTransfersByActionHashResponse) Reset() {
+	*x = GetEvmTransfersByActionHashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEvmTransfersByActionHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvmTransfersByActionHashResponse) ProtoMessage() {}
+
+func (x *GetEvmTransfersByActionHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvmTransfersByActionHashResponse.ProtoReflect.Descriptor instead.
+func (*GetEvmTransfersByActionHashResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetEvmTransfersByActionHashResponse) GetActionEvmTransfers() *iotextypes.ActionEvmTransfer {
+	if x != nil {
+		return x.ActionEvmTransfers
+	}
+	return nil
+}
+
+// Deprecated
+type GetEvmTransfersByBlockHeightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+}
+
+func (x *GetEvmTransfersByBlockHeightRequest) Reset() {
+	*x = GetEvmTransfersByBlockHeightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEvmTransfersByBlockHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvmTransfersByBlockHeightRequest) ProtoMessage() {}
+
+func (x *GetEvmTransfersByBlockHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvmTransfersByBlockHeightRequest.ProtoReflect.Descriptor instead.
+func (*GetEvmTransfersByBlockHeightRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetEvmTransfersByBlockHeightRequest) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// Deprecated
+type GetEvmTransfersByBlockHeightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockEvmTransfers *iotextypes.BlockEvmTransfer `protobuf:"bytes,1,opt,name=blockEvmTransfers,proto3" json:"blockEvmTransfers,omitempty"`
+}
+
+func (x *GetEvmTransfersByBlockHeightResponse) Reset() {
+	*x = GetEvmTransfersByBlockHeightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEvmTransfersByBlockHeightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvmTransfersByBlockHeightResponse) ProtoMessage() {}
+
+func (x *GetEvmTransfersByBlockHeightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvmTransfersByBlockHeightResponse.ProtoReflect.Descriptor instead.
+func (*GetEvmTransfersByBlockHeightResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetEvmTransfersByBlockHeightResponse) GetBlockEvmTransfers() *iotextypes.BlockEvmTransfer {
+	if x != nil {
+		return x.BlockEvmTransfers
+	}
+	return nil
+}
+
+type GetTransactionLogByActionHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionHash string `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+}
+
+func (x *GetTransactionLogByActionHashRequest) Reset() {
+	*x = GetTransactionLogByActionHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionLogByActionHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionLogByActionHashRequest) ProtoMessage() {}
+
+func (x *GetTransactionLogByActionHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionLogByActionHashRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionLogByActionHashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetTransactionLogByActionHashRequest) GetActionHash() string {
+	if x != nil {
+		return x.ActionHash
+	}
+	return ""
+}
+
+type GetTransactionLogByActionHashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionLog *iotextypes.TransactionLog `protobuf:"bytes,1,opt,name=transactionLog,proto3" json:"transactionLog,omitempty"`
+}
+
+func (x *GetTransactionLogByActionHashResponse) Reset() {
+	*x = GetTransactionLogByActionHashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionLogByActionHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionLogByActionHashResponse) ProtoMessage() {}
+
+func (x *GetTransactionLogByActionHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionLogByActionHashResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionLogByActionHashResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetTransactionLogByActionHashResponse) GetTransactionLog() *iotextypes.TransactionLog {
+	if x != nil {
+		return x.TransactionLog
+	}
+	return nil
+}
+
+type GetTransactionLogByBlockHeightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+}
+
+func (x *GetTransactionLogByBlockHeightRequest) Reset() {
+	*x = GetTransactionLogByBlockHeightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionLogByBlockHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionLogByBlockHeightRequest) ProtoMessage() {}
+
+func (x *GetTransactionLogByBlockHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionLogByBlockHeightRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionLogByBlockHeightRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetTransactionLogByBlockHeightRequest) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+type GetTransactionLogByBlockHeightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionLogs *iotextypes.TransactionLogs `protobuf:"bytes,1,opt,name=transactionLogs,proto3" json:"transactionLogs,omitempty"`
+	BlockIdentifier *iotextypes.BlockIdentifier `protobuf:"bytes,2,opt,name=blockIdentifier,proto3" json:"blockIdentifier,omitempty"`
+}
+
+func (x *GetTransactionLogByBlockHeightResponse) Reset() {
+	*x = GetTransactionLogByBlockHeightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionLogByBlockHeightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionLogByBlockHeightResponse) ProtoMessage() {}
+
+func (x *GetTransactionLogByBlockHeightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionLogByBlockHeightResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionLogByBlockHeightResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetTransactionLogByBlockHeightResponse) GetTransactionLogs() *iotextypes.TransactionLogs {
+	if x != nil {
+		return x.TransactionLogs
+	}
+	return nil
+}
+
+func (x *GetTransactionLogByBlockHeightResponse) GetBlockIdentifier() *iotextypes.BlockIdentifier {
+	if x != nil {
+		return x.BlockIdentifier
+	}
+	return nil
+}
+
+type GetAccountProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the tip height if absent
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// keys of the contract storage slots
+	StorageKeys [][]byte `protobuf:"bytes,3,rep,name=storageKeys,proto3" json:"storageKeys,omitempty"`
+}
+
+func (x *GetAccountProofRequest) Reset() {
+	*x = GetAccountProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountProofRequest) ProtoMessage() {}
+
+func (x *GetAccountProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountProofRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountProofRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetAccountProofRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetAccountProofRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetAccountProofRequest) GetStorageKeys() [][]byte {
+	if x != nil {
+		return x.StorageKeys
+	}
+	return nil
+}
+
+type StorageProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// serialized trie nodes from the storage root to the slot
+	Proof [][]byte `protobuf:"bytes,2,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *StorageProof) Reset() {
+	*x = StorageProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageProof) ProtoMessage() {}
+
+func (x *StorageProof) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageProof.ProtoReflect.Descriptor instead.
+func (*StorageProof) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *StorageProof) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *StorageProof) GetProof() [][]byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+type GetAccountProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	StateRoot []byte `protobuf:"bytes,2,opt,name=stateRoot,proto3" json:"stateRoot,omitempty"`
+	// serialized trie nodes from the state root to the root of the account namespace
+	NamespaceProof [][]byte `protobuf:"bytes,3,rep,name=namespaceProof,proto3" json:"namespaceProof,omitempty"`
+	// serialized trie nodes from the root of the account namespace to the account
+	AccountProof  [][]byte        `protobuf:"bytes,4,rep,name=accountProof,proto3" json:"accountProof,omitempty"`
+	StorageProofs []*StorageProof `protobuf:"bytes,5,rep,name=storageProofs,proto3" json:"storageProofs,omitempty"`
+}
+
+func (x *GetAccountProofResponse) Reset() {
+	*x = GetAccountProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountProofResponse) ProtoMessage() {}
+
+func (x *GetAccountProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountProofResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountProofResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetAccountProofResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetAccountProofResponse) GetStateRoot() []byte {
+	if x != nil {
+		return x.StateRoot
+	}
+	return nil
+}
+
+func (x *GetAccountProofResponse) GetNamespaceProof() [][]byte {
+	if x != nil {
+		return x.NamespaceProof
+	}
+	return nil
+}
+
+func (x *GetAccountProofResponse) GetAccountProof() [][]byte {
+	if x != nil {
+		return x.AccountProof
+	}
+	return nil
+}
+
+func (x *GetAccountProofResponse) GetStorageProofs() []*StorageProof {
+	if x != nil {
+		return x.StorageProofs
+	}
+	return nil
+}
+
+type GetActionProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionHash string `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+}
+
+func (x *GetActionProofRequest) Reset() {
+	*x = GetActionProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActionProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionProofRequest) ProtoMessage() {}
+
+func (x *GetActionProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionProofRequest.ProtoReflect.Descriptor instead.
+func (*GetActionProofRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetActionProofRequest) GetActionHash() string {
+	if x != nil {
+		return x.ActionHash
+	}
+	return ""
+}
+
+type GetActionProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeader *iotextypes.BlockHeader `protobuf:"bytes,1,opt,name=blockHeader,proto3" json:"blockHeader,omitempty"`
+	// index of the action in the block
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// hashes of the merkle audit path from the action hash to the tx root
+	Proof [][]byte `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *GetActionProofResponse) Reset() {
+	*x = GetActionProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActionProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionProofResponse) ProtoMessage() {}
+
+func (x *GetActionProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionProofResponse.ProtoReflect.Descriptor instead.
+func (*GetActionProofResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetActionProofResponse) GetBlockHeader() *iotextypes.BlockHeader {
+	if x != nil {
+		return x.BlockHeader
+	}
+	return nil
+}
+
+func (x *GetActionProofResponse) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetActionProofResponse) GetProof() [][]byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+type TraceActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionHash string `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+	// capture the opcode-level trace
+	StructLogs     bool `protobuf:"varint,2,opt,name=structLogs,proto3" json:"structLogs,omitempty"`
+	DisableStorage bool `protobuf:"varint,3,opt,name=disableStorage,proto3" json:"disableStorage,omitempty"`
+	DisableMemory  bool `protobuf:"varint,4,opt,name=disableMemory,proto3" json:"disableMemory,omitempty"`
+	DisableStack   bool `protobuf:"varint,5,opt,name=disableStack,proto3" json:"disableStack,omitempty"`
+	// maximum number of opcodes captured, no limit if 0
+	Limit uint64 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *TraceActionRequest) Reset() {
+	*x = TraceActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceActionRequest) ProtoMessage() {}
+
+func (x *TraceActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceActionRequest.ProtoReflect.Descriptor instead.
+func (*TraceActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *TraceActionRequest) GetActionHash() string {
+	if x != nil {
+		return x.ActionHash
+	}
+	return ""
+}
+
+func (x *TraceActionRequest) GetStructLogs() bool {
+	if x != nil {
+		return x.StructLogs
+	}
+	return false
+}
+
+func (x *TraceActionRequest) GetDisableStorage() bool {
+	if x != nil {
+		return x.DisableStorage
+	}
+	return false
+}
+
+func (x *TraceActionRequest) GetDisableMemory() bool {
+	if x != nil {
+		return x.DisableMemory
+	}
+	return false
+}
+
+func (x *TraceActionRequest) GetDisableStack() bool {
+	if x != nil {
+		return x.DisableStack
+	}
+	return false
+}
+
+func (x *TraceActionRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type StorageSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StorageSlot) Reset() {
+	*x = StorageSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageSlot) ProtoMessage() {}
+
+func (x *StorageSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageSlot.ProtoReflect.Descriptor instead.
+func (*StorageSlot) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *StorageSlot) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *StorageSlot) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type StructLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pc      uint64 `protobuf:"varint,1,opt,name=pc,proto3" json:"pc,omitempty"`
+	Op      string `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Gas     uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasCost uint64 `protobuf:"varint,4,opt,name=gasCost,proto3" json:"gasCost,omitempty"`
+	Depth   int64  `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	// 32-byte words from the bottom of the stack
+	Stack  [][]byte `protobuf:"bytes,6,rep,name=stack,proto3" json:"stack,omitempty"`
+	Memory []byte   `protobuf:"bytes,7,opt,name=memory,proto3" json:"memory,omitempty"`
+	// storage slots accessed by the contract
+	Storage []*StorageSlot `protobuf:"bytes,8,rep,name=storage,proto3" json:"storage,omitempty"`
+	Error   string         `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *StructLog) Reset() {
+	*x = StructLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StructLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StructLog) ProtoMessage() {}
+
+func (x *StructLog) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StructLog.ProtoReflect.Descriptor instead.
+func (*StructLog) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *StructLog) GetPc() uint64 {
+	if x != nil {
+		return x.Pc
+	}
+	return 0
+}
+
+func (x *StructLog) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *StructLog) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *StructLog) GetGasCost() uint64 {
+	if x != nil {
+		return x.GasCost
+	}
+	return 0
+}
+
+func (x *StructLog) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *StructLog) GetStack() [][]byte {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
+func (x *StructLog) GetMemory() []byte {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *StructLog) GetStorage() []*StorageSlot {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+func (x *StructLog) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CallFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// opcode name of the call, i.e., CALL, CALLCODE, DELEGATECALL, STATICCALL, CREATE or CREATE2
+	Type    string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	From    string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To      string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value   string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Gas     uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Input   []byte `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	Output  []byte `protobuf:"bytes,8,opt,name=output,proto3" json:"output,omitempty"`
+	// reason of the call failure, empty if the call succeeds
+	Error string       `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	Calls []*CallFrame `protobuf:"bytes,10,rep,name=calls,proto3" json:"calls,omitempty"`
+}
+
+func (x *CallFrame) Reset() {
+	*x = CallFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallFrame) ProtoMessage() {}
+
+func (x *CallFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallFrame.ProtoReflect.Descriptor instead.
+func (*CallFrame) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CallFrame) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CallFrame) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CallFrame) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CallFrame) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CallFrame) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *CallFrame) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *CallFrame) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *CallFrame) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *CallFrame) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CallFrame) GetCalls() []*CallFrame {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+type StorageChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Key      []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Original []byte `protobuf:"bytes,3,opt,name=original,proto3" json:"original,omitempty"`
+	Current  []byte `protobuf:"bytes,4,opt,name=current,proto3" json:"current,omitempty"`
+}
+
+func (x *StorageChange) Reset() {
+	*x = StorageChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageChange) ProtoMessage() {}
+
+func (x *StorageChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageChange.ProtoReflect.Descriptor instead.
+func (*StorageChange) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *StorageChange) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *StorageChange) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *StorageChange) GetOriginal() []byte {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+func (x *StorageChange) GetCurrent() []byte {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+type TraceActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipt     *iotextypes.Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	ReturnValue []byte              `protobuf:"bytes,2,opt,name=returnValue,proto3" json:"returnValue,omitempty"`
+	StructLogs  []*StructLog        `protobuf:"bytes,3,rep,name=structLogs,proto3" json:"structLogs,omitempty"`
+	// absent if the action doesn't reach the EVM
+	CallTree *CallFrame `protobuf:"bytes,4,opt,name=callTree,proto3" json:"callTree,omitempty"`
+	// sorted by contract and key
+	StorageChanges []*StorageChange `protobuf:"bytes,5,rep,name=storageChanges,proto3" json:"storageChanges,omitempty"`
+}
+
+func (x *TraceActionResponse) Reset() {
+	*x = TraceActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceActionResponse) ProtoMessage() {}
+
+func (x *TraceActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceActionResponse.ProtoReflect.Descriptor instead.
+func (*TraceActionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *TraceActionResponse) GetReceipt() *iotextypes.Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *TraceActionResponse) GetReturnValue() []byte {
+	if x != nil {
+		return x.ReturnValue
+	}
+	return nil
+}
+
+func (x *TraceActionResponse) GetStructLogs() []*StructLog {
+	if x != nil {
+		return x.StructLogs
+	}
+	return nil
+}
+
+func (x *TraceActionResponse) GetCallTree() *CallFrame {
+	if x != nil {
+		return x.CallTree
+	}
+	return nil
+}
+
+func (x *TraceActionResponse) GetStorageChanges() []*StorageChange {
+	if x != nil {
+		return x.StorageChanges
+	}
+	return nil
+}
+
+// below are streaming APIs
+type StreamBlocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamBlocksRequest) Reset() {
+	*x = StreamBlocksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBlocksRequest) ProtoMessage() {}
+
+func (x *StreamBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBlocksRequest.ProtoReflect.Descriptor instead.
+func (*StreamBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{66}
+}
+
+type StreamBlocksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block *BlockInfo `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (x *StreamBlocksResponse) Reset() {
+	*x = StreamBlocksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBlocksResponse) ProtoMessage() {}
+
+func (x *StreamBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBlocksResponse.ProtoReflect.Descriptor instead.
+func (*StreamBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *StreamBlocksResponse) GetBlock() *BlockInfo {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+type StreamLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *LogsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *StreamLogsRequest) Reset() {
+	*x = StreamLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsRequest) ProtoMessage() {}
+
+func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *StreamLogsRequest) GetFilter() *LogsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type StreamLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Log *iotextypes.Log `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (x *StreamLogsResponse) Reset() {
+	*x = StreamLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsResponse) ProtoMessage() {}
+
+func (x *StreamLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *StreamLogsResponse) GetLog() *iotextypes.Log {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+// an empty list matches any action, and an action matches the filter if it matches all the lists
+type PendingActionsFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Senders []string `protobuf:"bytes,1,rep,name=senders,proto3" json:"senders,omitempty"`
+	// recipients of transfers, or contracts of executions
+	Recipients []string `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// names of actions in the action core, e.g., transfer, execution and stakeCreate
+	ActionTypes []string `protobuf:"bytes,3,rep,name=actionTypes,proto3" json:"actionTypes,omitempty"`
+}
+
+func (x *PendingActionsFilter) Reset() {
+	*x = PendingActionsFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingActionsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingActionsFilter) ProtoMessage() {}
+
+func (x *PendingActionsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingActionsFilter.ProtoReflect.Descriptor instead.
+func (*PendingActionsFilter) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PendingActionsFilter) GetSenders() []string {
+	if x != nil {
+		return x.Senders
+	}
+	return nil
+}
+
+func (x *PendingActionsFilter) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *PendingActionsFilter) GetActionTypes() []string {
+	if x != nil {
+		return x.ActionTypes
+	}
+	return nil
+}
+
+type StreamPendingActionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *PendingActionsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *StreamPendingActionsRequest) Reset() {
+	*x = StreamPendingActionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPendingActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPendingActionsRequest) ProtoMessage() {}
+
+func (x *StreamPendingActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPendingActionsRequest.ProtoReflect.Descriptor instead.
+func (*StreamPendingActionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *StreamPendingActionsRequest) GetFilter() *PendingActionsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type StreamPendingActionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action *ActionInfo `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *StreamPendingActionsResponse) Reset() {
+	*x = StreamPendingActionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPendingActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPendingActionsResponse) ProtoMessage() {}
+
+func (x *StreamPendingActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPendingActionsResponse.ProtoReflect.Descriptor instead.
+func (*StreamPendingActionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *StreamPendingActionsResponse) GetAction() *ActionInfo {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type StreamRoundLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// only the logs of the rounds at the height are streamed, unless it is 0
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *StreamRoundLogsRequest) Reset() {
+	*x = StreamRoundLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamRoundLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRoundLogsRequest) ProtoMessage() {}
+
+func (x *StreamRoundLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRoundLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamRoundLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *StreamRoundLogsRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type StreamRoundLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundLog *RoundLog `protobuf:"bytes,1,opt,name=roundLog,proto3" json:"roundLog,omitempty"`
+}
+
+func (x *StreamRoundLogsResponse) Reset() {
+	*x = StreamRoundLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamRoundLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRoundLogsResponse) ProtoMessage() {}
+
+func (x *StreamRoundLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRoundLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamRoundLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *StreamRoundLogsResponse) GetRoundLog() *RoundLog {
+	if x != nil {
+		return x.RoundLog
+	}
+	return nil
+}
+
+// the events of a consensus round
+type RoundLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    uint64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round     uint32               `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	Proposer  string               `protobuf:"bytes,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Delegates []string             `protobuf:"bytes,5,rep,name=delegates,proto3" json:"delegates,omitempty"`
+	// the time the proposed block is received, absent if no block is received
+	BlockReceived *timestamp.Timestamp `protobuf:"bytes,6,opt,name=blockReceived,proto3" json:"blockReceived,omitempty"`
+	Endorsements  []*EndorsementLog    `protobuf:"bytes,7,rep,name=endorsements,proto3" json:"endorsements,omitempty"`
+	Transitions   []*TransitionLog     `protobuf:"bytes,8,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	// committed or failed
+	Outcome string `protobuf:"bytes,9,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	// the reason of a failed round
+	Reason string `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the delegates whose endorsements are not received, by topic
+	Missing []*MissingEndorsers `protobuf:"bytes,11,rep,name=missing,proto3" json:"missing,omitempty"`
+}
+
+func (x *RoundLog) Reset() {
+	*x = RoundLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoundLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundLog) ProtoMessage() {}
+
+func (x *RoundLog) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundLog.ProtoReflect.Descriptor instead.
+func (*RoundLog) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RoundLog) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *RoundLog) GetRound() uint32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *RoundLog) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *RoundLog) GetProposer() string {
+	if x != nil {
+		return x.Proposer
+	}
+	return ""
+}
+
+func (x *RoundLog) GetDelegates() []string {
+	if x != nil {
+		return x.Delegates
+	}
+	return nil
+}
+
+func (x *RoundLog) GetBlockReceived() *timestamp.Timestamp {
+	if x != nil {
+		return x.BlockReceived
+	}
+	return nil
+}
+
+func (x *RoundLog) GetEndorsements() []*EndorsementLog {
+	if x != nil {
+		return x.Endorsements
+	}
+	return nil
+}
+
+func (x *RoundLog) GetTransitions() []*TransitionLog {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+func (x *RoundLog) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *RoundLog) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RoundLog) GetMissing() []*MissingEndorsers {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+type EndorsementLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endorser string `protobuf:"bytes,1,opt,name=endorser,proto3" json:"endorser,omitempty"`
+	// PROPOSAL, LOCK or COMMIT
+	Topic     string               `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	BlockHash string               `protobuf:"bytes,3,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Received  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=received,proto3" json:"received,omitempty"`
+	// whether the endorsement is received after the time to accept endorsements on the topic
+	Late bool `protobuf:"varint,5,opt,name=late,proto3" json:"late,omitempty"`
+}
+
+func (x *EndorsementLog) Reset() {
+	*x = EndorsementLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndorsementLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndorsementLog) ProtoMessage() {}
+
+func (x *EndorsementLog) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndorsementLog.ProtoReflect.Descriptor instead.
+func (*EndorsementLog) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *EndorsementLog) GetEndorser() string {
+	if x != nil {
+		return x.Endorser
+	}
+	return ""
+}
+
+func (x *EndorsementLog) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *EndorsementLog) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *EndorsementLog) GetReceived() *timestamp.Timestamp {
+	if x != nil {
+		return x.Received
+	}
+	return nil
+}
+
+func (x *EndorsementLog) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+// a state transition of the consensus fsm
+type TransitionLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Src   string               `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dst   string               `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
+	Event string               `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Time  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *TransitionLog) Reset() {
+	*x = TransitionLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitionLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionLog) ProtoMessage() {}
+
+func (x *TransitionLog) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionLog.ProtoReflect.Descriptor instead.
+func (*TransitionLog) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *TransitionLog) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *TransitionLog) GetDst() string {
+	if x != nil {
+		return x.Dst
+	}
+	return ""
+}
+
+func (x *TransitionLog) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *TransitionLog) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type MissingEndorsers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic     string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Delegates []string `protobuf:"bytes,2,rep,name=delegates,proto3" json:"delegates,omitempty"`
+}
+
+func (x *MissingEndorsers) Reset() {
+	*x = MissingEndorsers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissingEndorsers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingEndorsers) ProtoMessage() {}
+
+func (x *MissingEndorsers) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissingEndorsers.ProtoReflect.Descriptor instead.
+func (*MissingEndorsers) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *MissingEndorsers) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *MissingEndorsers) GetDelegates() []string {
+	if x != nil {
+		return x.Delegates
+	}
+	return nil
+}
+
+// election APIs
+type GetElectionBucketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epochNum,proto3" json:"epochNum,omitempty"`
+}
+
+func (x *GetElectionBucketsRequest) Reset() {
+	*x = GetElectionBucketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetElectionBucketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetElectionBucketsRequest) ProtoMessage() {}
+
+func (x *GetElectionBucketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetElectionBucketsRequest.ProtoReflect.Descriptor instead.
+func (*GetElectionBucketsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetElectionBucketsRequest) GetEpochNum() uint64 {
+	if x != nil {
+		return x.EpochNum
+	}
+	return 0
+}
+
+type GetElectionBucketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buckets []*iotextypes.ElectionBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *GetElectionBucketsResponse) Reset() {
+	*x = GetElectionBucketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetElectionBucketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetElectionBucketsResponse) ProtoMessage() {}
+
+func (x *GetElectionBucketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetElectionBucketsResponse.ProtoReflect.Descriptor instead.
+func (*GetElectionBucketsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetElectionBucketsResponse) GetBuckets() []*iotextypes.ElectionBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+var File_proto_api_api_proto protoreflect.FileDescriptor
+
+var file_proto_api_api_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x1a,
+	0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x88, 0x01, 0x0a, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x12, 0x45, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xf6, 0x02, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3e, 0x0a, 0x07, 0x62, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x62, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x3a, 0x0a, 0x06, 0x62, 0x79, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x62, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3e, 0x0a, 0x06,
+	0x62, 0x79, 0x41, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x62, 0x79, 0x41, 0x64, 0x64, 0x72, 0x12, 0x5f, 0x0a, 0x11,
+	0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42, 0x79, 0x41, 0x64, 0x64,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x11, 0x75, 0x6e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3a, 0x0a,
+	0x05, 0x62, 0x79, 0x42, 0x6c, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x05, 0x62, 0x79, 0x42, 0x6c, 0x6b, 0x42, 0x08, 0x0a, 0x06, 0x6c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x22, 0x46, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x42, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x62, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6d, 0x0a,
+	0x25, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6b, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf4,
+	0x01, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a,
+	0x09, 0x62, 0x6c, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x62, 0x6c, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x61, 0x73, 0x46, 0x65, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x73, 0x46, 0x65, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x56, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x7b, 0x0a,
+	0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xac, 0x01, 0x0a, 0x09, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x12, 0x45, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x22, 0x60, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa2, 0x01, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x62, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x42, 0x79,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07,
+	0x62, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3d, 0x0a, 0x06, 0x62, 0x79, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x42,
+	0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06,
+	0x62, 0x79, 0x48, 0x61, 0x73, 0x68, 0x42, 0x08, 0x0a, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x22, 0x49, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61,
+	0x73, 0x42, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x79, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6b, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0x60, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65,
+	0x74, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x31, 0x0a, 0x08, 0x62, 0x6c, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x62, 0x6c, 0x6b, 0x4d,
+	0x65, 0x74, 0x61, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x4f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74,
+	0x61, 0x22, 0x3f, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x1c, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0x34, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0x3b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x61, 0x73, 0x68, 0x22, 0x55, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x88, 0x01, 0x0a, 0x13, 0x52,
+	0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x59, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x22, 0x18, 0x0a, 0x16, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x17, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x22, 0x49, 0x0a, 0x1b, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73,
+	0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2a, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd9, 0x06, 0x0a,
+	0x23, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47,
+	0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3b, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x28,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
+	0x0b, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x29, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x48, 0x00, 0x52, 0x0c,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x40, 0x0a, 0x0d,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x2a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x48, 0x00, 0x52,
+	0x0d, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x47,
+	0x0a, 0x0f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x64, 0x64,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x56, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x14, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x5c, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x48, 0x00, 0x52, 0x16, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x4d, 0x0a,
+	0x11, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x11, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x0f,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x30, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69,
+	0x63, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x08,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x38, 0x0a, 0x24, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67,
+	0x61, 0x73, 0x22, 0x30, 0x0a, 0x1c, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61,
+	0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x67, 0x61, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x72,
+	0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x6e, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x45, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22,
+	0x37, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xba, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x09, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x4b, 0x0a, 0x12, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa3, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x69, 0x74,
+	0x68, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x77, 0x69, 0x74,
+	0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x77, 0x69, 0x74, 0x68, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x22, 0x43, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x22, 0x2e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x22, 0x44, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1e, 0x0a, 0x06, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x50, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x73, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28,
+	0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x07, 0x62, 0x79, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6f, 0x74,
+	0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x07, 0x62, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x34, 0x0a, 0x07, 0x62, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x62, 0x79,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x22,
+	0x36, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x6f,
+	0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x44, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0x74, 0x0a,
+	0x23, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
+	0x12, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x22, 0x47, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x72, 0x0a, 0x24,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42,
+	0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x6d,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x11, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x22, 0x46, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0x6b, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x42, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x22, 0x49, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0xb6, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67,
+	0x73, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x67, 0x73, 0x12, 0x45, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x36, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22,
+	0xd9, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x3c, 0x0a,
+	0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x0d, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22, 0x37, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0x7f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xdc, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x63, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x35, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53,
+	0x6c, 0x6f, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x09,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x70, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61,
+	0x73, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6f, 0x74,
+	0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x6c, 0x6f,
+	0x74, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0xf4, 0x01, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x67, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x05,
+	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x73, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x8d, 0x02, 0x0a,
+	0x13, 0x54, 0x72, 0x61, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x0a,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x63, 0x61,
+	0x6c, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x15, 0x0a, 0x13,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6f, 0x74,
+	0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x41, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x37, 0x0a, 0x12, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x03, 0x6c,
+	0x6f, 0x67, 0x22, 0x72, 0x0a, 0x14, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x4c, 0x0a,
+	0x1c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x16, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x49, 0x0a,
+	0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x4c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6f, 0x74,
+	0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x08,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x22, 0xcf, 0x03, 0x0a, 0x08, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x64, 0x6f, 0x72,
+	0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x6f, 0x72,
+	0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x72,
+	0x73, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0xac, 0x01, 0x0a, 0x0e, 0x45,
+	0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12,
+	0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x36, 0x0a,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x79, 0x0a, 0x0d, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03,
+	0x64, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x10, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x45,
+	0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x22, 0x37, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x4e, 0x75, 0x6d, 0x22, 0x52, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x32, 0xdf, 0x13, 0x0a, 0x0a, 0x41, 0x50,
+	0x49, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x12,
+	0x1e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65,
+	0x74, 0x61, 0x12, 0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4d, 0x65, 0x74, 0x61, 0x12, 0x1e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x42, 0x79,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x47, 0x61,
+	0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x67, 0x0a, 0x14, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x46,
+	0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x46,
+	0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x1c, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x52, 0x65,
+	0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x65,
+	0x74, 0x61, 0x12, 0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12,
+	0x18, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2c, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x2d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42,
+	0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x85, 0x01, 0x0a, 0x1e, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67,
+	0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2f, 0x2e,
+	0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x20, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1f,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x51, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x12, 0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f,
+	0x67, 0x73, 0x12, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x69, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x0f,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x12,
+	0x20, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x23,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa4, 0x02, 0x0a, 0x15,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x85, 0x01, 0x0a, 0x1e, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67,
+	0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2f, 0x2e,
+	0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x59, 0x0a, 0x20, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x61, 0x70, 0x69, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
+	0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_api_api_proto_rawDescOnce sync.Once
+	file_proto_api_api_proto_rawDescData = file_proto_api_api_proto_rawDesc
+)
+
+func file_proto_api_api_proto_rawDescGZIP() []byte {
+	file_proto_api_api_proto_rawDescOnce.Do(func() {
+		file_proto_api_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_api_api_proto_rawDescData)
+	})
+	return file_proto_api_api_proto_rawDescData
+}
+
+var file_proto_api_api_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
+var file_proto_api_api_proto_goTypes = []interface{}{
+	(*Bucket)(nil),                                 // 0: iotexapi.Bucket
+	(*GetAccountRequest)(nil),                      // 1: iotexapi.GetAccountRequest
+	(*GetAccountResponse)(nil),                     // 2: iotexapi.GetAccountResponse
+	(*GetActionsRequest)(nil),                      // 3: iotexapi.GetActionsRequest
+	(*GetActionsByIndexRequest)(nil),               // 4: iotexapi.GetActionsByIndexRequest
+	(*GetActionByHashRequest)(nil),                 // 5: iotexapi.GetActionByHashRequest
+	(*GetActionsByAddressRequest)(nil),             // 6: iotexapi.GetActionsByAddressRequest
+	(*GetUnconfirmedActionsByAddressRequest)(nil),  // 7: iotexapi.GetUnconfirmedActionsByAddressRequest
+	(*GetActionsByBlockRequest)(nil),               // 8: iotexapi.GetActionsByBlockRequest
+	(*ActionInfo)(nil),                             // 9: iotexapi.ActionInfo
+	(*ReceiptInfo)(nil),                            // 10: iotexapi.ReceiptInfo
+	(*BlockProducerInfo)(nil),                      // 11: iotexapi.BlockProducerInfo
+	(*BlockInfo)(nil),                              // 12: iotexapi.BlockInfo
+	(*GetActionsResponse)(nil),                     // 13: iotexapi.GetActionsResponse
+	(*GetBlockMetasRequest)(nil),                   // 14: iotexapi.GetBlockMetasRequest
+	(*GetBlockMetasByIndexRequest)(nil),            // 15: iotexapi.GetBlockMetasByIndexRequest
+	(*GetBlockMetaByHashRequest)(nil),              // 16: iotexapi.GetBlockMetaByHashRequest
+	(*GetBlockMetasResponse)(nil),                  // 17: iotexapi.GetBlockMetasResponse
+	(*GetChainMetaRequest)(nil),                    // 18: iotexapi.GetChainMetaRequest
+	(*GetChainMetaResponse)(nil),                   // 19: iotexapi.GetChainMetaResponse
+	(*GetServerMetaRequest)(nil),                   // 20: iotexapi.GetServerMetaRequest
+	(*GetServerMetaResponse)(nil),                  // 21: iotexapi.GetServerMetaResponse
+	(*SendActionRequest)(nil),                      // 22: iotexapi.SendActionRequest
+	(*SendSignedActionBytesRequest)(nil),           // 23: iotexapi.SendSignedActionBytesRequest
+	(*SendActionResponse)(nil),                     // 24: iotexapi.SendActionResponse
+	(*GetReceiptByActionRequest)(nil),              // 25: iotexapi.GetReceiptByActionRequest
+	(*GetReceiptByActionResponse)(nil),             // 26: iotexapi.GetReceiptByActionResponse
+	(*ReadContractRequest)(nil),                    // 27: iotexapi.ReadContractRequest
+	(*ReadContractResponse)(nil),                   // 28: iotexapi.ReadContractResponse
+	(*SuggestGasPriceRequest)(nil),                 // 29: iotexapi.SuggestGasPriceRequest
+	(*SuggestGasPriceResponse)(nil),                // 30: iotexapi.SuggestGasPriceResponse
+	(*EstimateGasForActionRequest)(nil),            // 31: iotexapi.EstimateGasForActionRequest
+	(*EstimateActionGasConsumptionRequest)(nil),    // 32: iotexapi.EstimateActionGasConsumptionRequest
+	(*EstimateActionGasConsumptionResponse)(nil),   // 33: iotexapi.EstimateActionGasConsumptionResponse
+	(*EstimateGasForActionResponse)(nil),           // 34: iotexapi.EstimateGasForActionResponse
+	(*ReadStateRequest)(nil),                       // 35: iotexapi.ReadStateRequest
+	(*ReadStateResponse)(nil),                      // 36: iotexapi.ReadStateResponse
+	(*GetEpochMetaRequest)(nil),                    // 37: iotexapi.GetEpochMetaRequest
+	(*GetEpochMetaResponse)(nil),                   // 38: iotexapi.GetEpochMetaResponse
+	(*GetRawBlocksRequest)(nil),                    // 39: iotexapi.GetRawBlocksRequest
+	(*GetRawBlocksResponse)(nil),                   // 40: iotexapi.GetRawBlocksResponse
+	(*GetLogsByBlock)(nil),                         // 41: iotexapi.GetLogsByBlock
+	(*GetLogsByRange)(nil),                         // 42: iotexapi.GetLogsByRange
+	(*Topics)(nil),                                 // 43: iotexapi.Topics
+	(*LogsFilter)(nil),                             // 44: iotexapi.LogsFilter
+	(*GetLogsRequest)(nil),                         // 45: iotexapi.GetLogsRequest
+	(*GetLogsResponse)(nil),                        // 46: iotexapi.GetLogsResponse
+	(*GetEvmTransfersByActionHashRequest)(nil),     // 47: iotexapi.GetEvmTransfersByActionHashRequest
+	(*GetEvmTransfersByActionHashResponse)(nil),    // 48: iotexapi.GetEvmTransfersByActionHashResponse
+	(*GetEvmTransfersByBlockHeightRequest)(nil),    // 49: iotexapi.GetEvmTransfersByBlockHeightRequest
+	(*GetEvmTransfersByBlockHeightResponse)(nil),   // 50: iotexapi.GetEvmTransfersByBlockHeightResponse
+	(*GetTransactionLogByActionHashRequest)(nil),   // 51: iotexapi.GetTransactionLogByActionHashRequest
+	(*GetTransactionLogByActionHashResponse)(nil),  // 52: iotexapi.GetTransactionLogByActionHashResponse
+	(*GetTransactionLogByBlockHeightRequest)(nil),  // 53: iotexapi.GetTransactionLogByBlockHeightRequest
+	(*GetTransactionLogByBlockHeightResponse)(nil), // 54: iotexapi.GetTransactionLogByBlockHeightResponse
+	(*GetAccountProofRequest)(nil),                 // 55: iotexapi.GetAccountProofRequest
+	(*StorageProof)(nil),                           // 56: iotexapi.StorageProof
+	(*GetAccountProofResponse)(nil),                // 57: iotexapi.GetAccountProofResponse
+	(*GetActionProofRequest)(nil),                  // 58: iotexapi.GetActionProofRequest
+	(*GetActionProofResponse)(nil),                 // 59: iotexapi.GetActionProofResponse
+	(*TraceActionRequest)(nil),                     // 60: iotexapi.TraceActionRequest
+	(*StorageSlot)(nil),                            // 61: iotexapi.StorageSlot
+	(*StructLog)(nil),                              // 62: iotexapi.StructLog
+	(*CallFrame)(nil),                              // 63: iotexapi.CallFrame
+	(*StorageChange)(nil),                          // 64: iotexapi.StorageChange
+	(*TraceActionResponse)(nil),                    // 65: iotexapi.TraceActionResponse
+	(*StreamBlocksRequest)(nil),                    // 66: iotexapi.StreamBlocksRequest
+	(*StreamBlocksResponse)(nil),                   // 67: iotexapi.StreamBlocksResponse
+	(*StreamLogsRequest)(nil),                      // 68: iotexapi.StreamLogsRequest
+	(*StreamLogsResponse)(nil),                     // 69: iotexapi.StreamLogsResponse
+	(*PendingActionsFilter)(nil),                   // 70: iotexapi.PendingActionsFilter
+	(*StreamPendingActionsRequest)(nil),            // 71: iotexapi.StreamPendingActionsRequest
+	(*StreamPendingActionsResponse)(nil),           // 72: iotexapi.StreamPendingActionsResponse
+	(*StreamRoundLogsRequest)(nil),                 // 73: iotexapi.StreamRoundLogsRequest
+	(*StreamRoundLogsResponse)(nil),                // 74: iotexapi.StreamRoundLogsResponse
+	(*RoundLog)(nil),                               // 75: iotexapi.RoundLog
+	(*EndorsementLog)(nil),                         // 76: iotexapi.EndorsementLog
+	(*TransitionLog)(nil),                          // 77: iotexapi.TransitionLog
+	(*MissingEndorsers)(nil),                       // 78: iotexapi.MissingEndorsers
+	(*GetElectionBucketsRequest)(nil),              // 79: iotexapi.GetElectionBucketsRequest
+	(*GetElectionBucketsResponse)(nil),             // 80: iotexapi.GetElectionBucketsResponse
+	(*iotextypes.AccountMeta)(nil),                 // 81: iotextypes.AccountMeta
+	(*iotextypes.BlockIdentifier)(nil),             // 82: iotextypes.BlockIdentifier
+	(*iotextypes.Action)(nil),                      // 83: iotextypes.Action
+	(*timestamp.Timestamp)(nil),                    // 84: google.protobuf.Timestamp
+	(*iotextypes.Receipt)(nil),                     // 85: iotextypes.Receipt
+	(*iotextypes.Block)(nil),                       // 86: iotextypes.Block
+	(*iotextypes.TransactionLogs)(nil),             // 87: iotextypes.TransactionLogs
+	(*iotextypes.BlockMeta)(nil),                   // 88: iotextypes.BlockMeta
+	(*iotextypes.ChainMeta)(nil),                   // 89: iotextypes.ChainMeta
+	(*iotextypes.ServerMeta)(nil),                  // 90: iotextypes.ServerMeta
+	(*iotextypes.Execution)(nil),                   // 91: iotextypes.Execution
+	(*iotextypes.Transfer)(nil),                    // 92: iotextypes.Transfer
+	(*iotextypes.StakeCreate)(nil),                 // 93: iotextypes.StakeCreate
+	(*iotextypes.StakeReclaim)(nil),                // 94: iotextypes.StakeReclaim
+	(*iotextypes.StakeAddDeposit)(nil),             // 95: iotextypes.StakeAddDeposit
+	(*iotextypes.StakeRestake)(nil),                // 96: iotextypes.StakeRestake
+	(*iotextypes.StakeChangeCandidate)(nil),        // 97: iotextypes.StakeChangeCandidate
+	(*iotextypes.StakeTransferOwnership)(nil),      // 98: iotextypes.StakeTransferOwnership
+	(*iotextypes.CandidateRegister)(nil),           // 99: iotextypes.CandidateRegister
+	(*iotextypes.CandidateBasicInfo)(nil),          // 100: iotextypes.CandidateBasicInfo
+	(*iotextypes.EpochData)(nil),                   // 101: iotextypes.EpochData
+	(*iotextypes.Log)(nil),                         // 102: iotextypes.Log
+	(*iotextypes.ActionEvmTransfer)(nil),           // 103: iotextypes.ActionEvmTransfer
+	(*iotextypes.BlockEvmTransfer)(nil),            // 104: iotextypes.BlockEvmTransfer
+	(*iotextypes.TransactionLog)(nil),              // 105: iotextypes.TransactionLog
+	(*iotextypes.BlockHeader)(nil),                 // 106: iotextypes.BlockHeader
+	(*iotextypes.ElectionBucket)(nil),              // 107: iotextypes.ElectionBucket
+}
+var file_proto_api_api_proto_depIdxs = []int32{
+	81,  // 0: iotexapi.GetAccountResponse.accountMeta:type_name -> iotextypes.AccountMeta
+	82,  // 1: iotexapi.GetAccountResponse.blockIdentifier:type_name -> iotextypes.BlockIdentifier
+	4,   // 2: iotexapi.GetActionsRequest.byIndex:type_name -> iotexapi.GetActionsByIndexRequest
+	5,   // 3: iotexapi.GetActionsRequest.byHash:type_name -> iotexapi.GetActionByHashRequest
+	6,   // 4: iotexapi.GetActionsRequest.byAddr:type_name -> iotexapi.GetActionsByAddressRequest
+	7,   // 5: iotexapi.GetActionsRequest.unconfirmedByAddr:type_name -> iotexapi.GetUnconfirmedActionsByAddressRequest
+	8,   // 6: iotexapi.GetActionsRequest.byBlk:type_name -> iotexapi.GetActionsByBlockRequest
+	83,  // 7: iotexapi.ActionInfo.action:type_name -> iotextypes.Action
+	84,  // 8: iotexapi.ActionInfo.timestamp:type_name -> google.protobuf.Timestamp
+	85,  // 9: iotexapi.ReceiptInfo.receipt:type_name -> iotextypes.Receipt
+	86,  // 10: iotexapi.BlockInfo.block:type_name -> iotextypes.Block
+	85,  // 11: iotexapi.BlockInfo.receipts:type_name -> iotextypes.Receipt
+	87,  // 12: iotexapi.BlockInfo.transactionLogs:type_name -> iotextypes.TransactionLogs
+	9,   // 13: iotexapi.GetActionsResponse.actionInfo:type_name -> iotexapi.ActionInfo
+	15,  // 14: iotexapi.GetBlockMetasRequest.byIndex:type_name -> iotexapi.GetBlockMetasByIndexRequest
+	16,  // 15: iotexapi.GetBlockMetasRequest.byHash:type_name -> iotexapi.GetBlockMetaByHashRequest
+	88,  // 16: iotexapi.GetBlockMetasResponse.blkMetas:type_name -> iotextypes.BlockMeta
+	89,  // 17: iotexapi.GetChainMetaResponse.chainMeta:type_name -> iotextypes.ChainMeta
+	90,  // 18: iotexapi.GetServerMetaResponse.serverMeta:type_name -> iotextypes.ServerMeta
+	83,  // 19: iotexapi.SendActionRequest.action:type_name -> iotextypes.Action
+	10,  // 20: iotexapi.GetReceiptByActionResponse.receiptInfo:type_name -> iotexapi.ReceiptInfo
+	91,  // 21: iotexapi.ReadContractRequest.execution:type_name -> iotextypes.Execution
+	85,  // 22: iotexapi.ReadContractResponse.receipt:type_name -> iotextypes.Receipt
+	83,  // 23: iotexapi.EstimateGasForActionRequest.action:type_name -> iotextypes.Action
+	92,  // 24: iotexapi.EstimateActionGasConsumptionRequest.transfer:type_name -> iotextypes.Transfer
+	91,  // 25: iotexapi.EstimateActionGasConsumptionRequest.execution:type_name -> iotextypes.Execution
+	93,  // 26: iotexapi.EstimateActionGasConsumptionRequest.stakeCreate:type_name -> iotextypes.StakeCreate
+	94,  // 27: iotexapi.EstimateActionGasConsumptionRequest.stakeUnstake:type_name -> iotextypes.StakeReclaim
+	94,  // 28: iotexapi.EstimateActionGasConsumptionRequest.stakeWithdraw:type_name -> iotextypes.StakeReclaim
+	95,  // 29: iotexapi.EstimateActionGasConsumptionRequest.stakeAddDeposit:type_name -> iotextypes.StakeAddDeposit
+	96,  // 30: iotexapi.EstimateActionGasConsumptionRequest.stakeRestake:type_name -> iotextypes.StakeRestake
+	97,  // 31: iotexapi.EstimateActionGasConsumptionRequest.stakeChangeCandidate:type_name -> iotextypes.StakeChangeCandidate
+	98,  // 32: iotexapi.EstimateActionGasConsumptionRequest.stakeTransferOwnership:type_name -> iotextypes.StakeTransferOwnership
+	99,  // 33: iotexapi.EstimateActionGasConsumptionRequest.candidateRegister:type_name -> iotextypes.CandidateRegister
+	100, // 34: iotexapi.EstimateActionGasConsumptionRequest.candidateUpdate:type_name -> iotextypes.CandidateBasicInfo
+	82,  // 35: iotexapi.ReadStateResponse.blockIdentifier:type_name -> iotextypes.BlockIdentifier
+	101, // 36: iotexapi.GetEpochMetaResponse.epochData:type_name -> iotextypes.EpochData
+	11,  // 37: iotexapi.GetEpochMetaResponse.blockProducersInfo:type_name -> iotexapi.BlockProducerInfo
+	12,  // 38: iotexapi.GetRawBlocksResponse.blocks:type_name -> iotexapi.BlockInfo
+	43,  // 39: iotexapi.LogsFilter.topics:type_name -> iotexapi.Topics
+	44,  // 40: iotexapi.GetLogsRequest.filter:type_name -> iotexapi.LogsFilter
+	41,  // 41: iotexapi.GetLogsRequest.byBlock:type_name -> iotexapi.GetLogsByBlock
+	42,  // 42: iotexapi.GetLogsRequest.byRange:type_name -> iotexapi.GetLogsByRange
+	102, // 43: iotexapi.GetLogsResponse.logs:type_name -> iotextypes.Log
+	103, // 44: iotexapi.GetEvmTransfersByActionHashResponse.actionEvmTransfers:type_name -> iotextypes.ActionEvmTransfer
+	104, // 45: iotexapi.GetEvmTransfersByBlockHeightResponse.blockEvmTransfers:type_name -> iotextypes.BlockEvmTransfer
+	105, // 46: iotexapi.GetTransactionLogByActionHashResponse.transactionLog:type_name -> iotextypes.TransactionLog
+	87,  // 47: iotexapi.GetTransactionLogByBlockHeightResponse.transactionLogs:type_name -> iotextypes.TransactionLogs
+	82,  // 48: iotexapi.GetTransactionLogByBlockHeightResponse.blockIdentifier:type_name -> iotextypes.BlockIdentifier
+	56,  // 49: iotexapi.GetAccountProofResponse.storageProofs:type_name -> iotexapi.StorageProof
+	106, // 50: iotexapi.GetActionProofResponse.blockHeader:type_name -> iotextypes.BlockHeader
+	61,  // 51: iotexapi.StructLog.storage:type_name -> iotexapi.StorageSlot
+	63,  // 52: iotexapi.CallFrame.calls:type_name -> iotexapi.CallFrame
+	85,  // 53: iotexapi.TraceActionResponse.receipt:type_name -> iotextypes.Receipt
+	62,  // 54: iotexapi.TraceActionResponse.structLogs:type_name -> iotexapi.StructLog
+	63,  // 55: iotexapi.TraceActionResponse.callTree:type_name -> iotexapi.CallFrame
+	64,  // 56: iotexapi.TraceActionResponse.storageChanges:type_name -> iotexapi.StorageChange
+	12,  // 57: iotexapi.StreamBlocksResponse.block:type_name -> iotexapi.BlockInfo
+	44,  // 58: iotexapi.StreamLogsRequest.filter:type_name -> iotexapi.LogsFilter
+	102, // 59: iotexapi.StreamLogsResponse.log:type_name -> iotextypes.Log
+	70,  // 60: iotexapi.StreamPendingActionsRequest.filter:type_name -> iotexapi.PendingActionsFilter
+	9,   // 61: iotexapi.StreamPendingActionsResponse.action:type_name -> iotexapi.ActionInfo
+	75,  // 62: iotexapi.StreamRoundLogsResponse.roundLog:type_name -> iotexapi.RoundLog
+	84,  // 63: iotexapi.RoundLog.startTime:type_name -> google.protobuf.Timestamp
+	84,  // 64: iotexapi.RoundLog.blockReceived:type_name -> google.protobuf.Timestamp
+	76,  // 65: iotexapi.RoundLog.endorsements:type_name -> iotexapi.EndorsementLog
+	77,  // 66: iotexapi.RoundLog.transitions:type_name -> iotexapi.TransitionLog
+	78,  // 67: iotexapi.RoundLog.missing:type_name -> iotexapi.MissingEndorsers
+	84,  // 68: iotexapi.EndorsementLog.received:type_name -> google.protobuf.Timestamp
+	84,  // 69: iotexapi.TransitionLog.time:type_name -> google.protobuf.Timestamp
+	107, // 70: iotexapi.GetElectionBucketsResponse.buckets:type_name -> iotextypes.ElectionBucket
+	1,   // 71: iotexapi.APIService.GetAccount:input_type -> iotexapi.GetAccountRequest
+	3,   // 72: iotexapi.APIService.GetActions:input_type -> iotexapi.GetActionsRequest
+	14,  // 73: iotexapi.APIService.GetBlockMetas:input_type -> iotexapi.GetBlockMetasRequest
+	18,  // 74: iotexapi.APIService.GetChainMeta:input_type -> iotexapi.GetChainMetaRequest
+	20,  // 75: iotexapi.APIService.GetServerMeta:input_type -> iotexapi.GetServerMetaRequest
+	22,  // 76: iotexapi.APIService.SendAction:input_type -> iotexapi.SendActionRequest
+	25,  // 77: iotexapi.APIService.GetReceiptByAction:input_type -> iotexapi.GetReceiptByActionRequest
+	27,  // 78: iotexapi.APIService.ReadContract:input_type -> iotexapi.ReadContractRequest
+	29,  // 79: iotexapi.APIService.SuggestGasPrice:input_type -> iotexapi.SuggestGasPriceRequest
+	31,  // 80: iotexapi.APIService.EstimateGasForAction:input_type -> iotexapi.EstimateGasForActionRequest
+	32,  // 81: iotexapi.APIService.EstimateActionGasConsumption:input_type -> iotexapi.EstimateActionGasConsumptionRequest
+	35,  // 82: iotexapi.APIService.ReadState:input_type -> iotexapi.ReadStateRequest
+	37,  // 83: iotexapi.APIService.GetEpochMeta:input_type -> iotexapi.GetEpochMetaRequest
+	39,  // 84: iotexapi.APIService.GetRawBlocks:input_type -> iotexapi.GetRawBlocksRequest
+	45,  // 85: iotexapi.APIService.GetLogs:input_type -> iotexapi.GetLogsRequest
+	47,  // 86: iotexapi.APIService.GetEvmTransfersByActionHash:input_type -> iotexapi.GetEvmTransfersByActionHashRequest
+	49,  // 87: iotexapi.APIService.GetEvmTransfersByBlockHeight:input_type -> iotexapi.GetEvmTransfersByBlockHeightRequest
+	51,  // 88: iotexapi.APIService.GetTransactionLogByActionHash:input_type -> iotexapi.GetTransactionLogByActionHashRequest
+	53,  // 89: iotexapi.APIService.GetTransactionLogByBlockHeight:input_type -> iotexapi.GetTransactionLogByBlockHeightRequest
+	55,  // 90: iotexapi.APIService.GetAccountProof:input_type -> iotexapi.GetAccountProofRequest
+	58,  // 91: iotexapi.APIService.GetActionProof:input_type -> iotexapi.GetActionProofRequest
+	60,  // 92: iotexapi.APIService.TraceAction:input_type -> iotexapi.TraceActionRequest
+	66,  // 93: iotexapi.APIService.StreamBlocks:input_type -> iotexapi.StreamBlocksRequest
+	68,  // 94: iotexapi.APIService.StreamLogs:input_type -> iotexapi.StreamLogsRequest
+	71,  // 95: iotexapi.APIService.StreamPendingActions:input_type -> iotexapi.StreamPendingActionsRequest
+	73,  // 96: iotexapi.APIService.StreamRoundLogs:input_type -> iotexapi.StreamRoundLogsRequest
+	79,  // 97: iotexapi.APIService.GetElectionBuckets:input_type -> iotexapi.GetElectionBucketsRequest
+	51,  // 98: iotexapi.TransactionLogService.GetTransactionLogByActionHash:input_type -> iotexapi.GetTransactionLogByActionHashRequest
+	53,  // 99: iotexapi.TransactionLogService.GetTransactionLogByBlockHeight:input_type -> iotexapi.GetTransactionLogByBlockHeightRequest
+	2,   // 100: iotexapi.APIService.GetAccount:output_type -> iotexapi.GetAccountResponse
+	13,  // 101: iotexapi.APIService.GetActions:output_type -> iotexapi.GetActionsResponse
+	17,  // 102: iotexapi.APIService.GetBlockMetas:output_type -> iotexapi.GetBlockMetasResponse
+	19,  // 103: iotexapi.APIService.GetChainMeta:output_type -> iotexapi.GetChainMetaResponse
+	21,  // 104: iotexapi.APIService.GetServerMeta:output_type -> iotexapi.GetServerMetaResponse
+	24,  // 105: iotexapi.APIService.SendAction:output_type -> iotexapi.SendActionResponse
+	26,  // 106: iotexapi.APIService.GetReceiptByAction:output_type -> iotexapi.GetReceiptByActionResponse
+	28,  // 107: iotexapi.APIService.ReadContract:output_type -> iotexapi.ReadContractResponse
+	30,  // 108: iotexapi.APIService.SuggestGasPrice:output_type -> iotexapi.SuggestGasPriceResponse
+	34,  // 109: iotexapi.APIService.EstimateGasForAction:output_type -> iotexapi.EstimateGasForActionResponse
+	33,  // 110: iotexapi.APIService.EstimateActionGasConsumption:output_type -> iotexapi.EstimateActionGasConsumptionResponse
+	36,  // 111: iotexapi.APIService.ReadState:output_type -> iotexapi.ReadStateResponse
+	38,  // 112: iotexapi.APIService.GetEpochMeta:output_type -> iotexapi.GetEpochMetaResponse
+	40,  // 113: iotexapi.APIService.GetRawBlocks:output_type -> iotexapi.GetRawBlocksResponse
+	46,  // 114: iotexapi.APIService.GetLogs:output_type -> iotexapi.GetLogsResponse
+	48,  // 115: iotexapi.APIService.GetEvmTransfersByActionHash:output_type -> iotexapi.GetEvmTransfersByActionHashResponse
+	50,  // 116: iotexapi.APIService.GetEvmTransfersByBlockHeight:output_type -> iotexapi.GetEvmTransfersByBlockHeightResponse
+	52,  // 117: iotexapi.APIService.GetTransactionLogByActionHash:output_type -> iotexapi.GetTransactionLogByActionHashResponse
+	54,  // 118: iotexapi.APIService.GetTransactionLogByBlockHeight:output_type -> iotexapi.GetTransactionLogByBlockHeightResponse
+	57,  // 119: iotexapi.APIService.GetAccountProof:output_type -> iotexapi.GetAccountProofResponse
+	59,  // 120: iotexapi.APIService.GetActionProof:output_type -> iotexapi.GetActionProofResponse
+	65,  // 121: iotexapi.APIService.TraceAction:output_type -> iotexapi.TraceActionResponse
+	67,  // 122: iotexapi.APIService.StreamBlocks:output_type -> iotexapi.StreamBlocksResponse
+	69,  // 123: iotexapi.APIService.StreamLogs:output_type -> iotexapi.StreamLogsResponse
+	72,  // 124: iotexapi.APIService.StreamPendingActions:output_type -> iotexapi.StreamPendingActionsResponse
+	74,  // 125: iotexapi.APIService.StreamRoundLogs:output_type -> iotexapi.StreamRoundLogsResponse
+	80,  // 126: iotexapi.APIService.GetElectionBuckets:output_type -> iotexapi.GetElectionBucketsResponse
+	52,  // 127: iotexapi.TransactionLogService.GetTransactionLogByActionHash:output_type -> iotexapi.GetTransactionLogByActionHashResponse
+	54,  // 128: iotexapi.TransactionLogService.GetTransactionLogByBlockHeight:output_type -> iotexapi.GetTransactionLogByBlockHeightResponse
+	100, // [100:129] is the sub-list for method output_type
+	71,  // [71:100] is the sub-list for method input_type
+	71,  // [71:71] is the sub-list for extension type_name
+	71,  // [71:71] is the sub-list for extension extendee
+	0,   // [0:71] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_api_proto_init() }
+func file_proto_api_api_proto_init() {
+	if File_proto_api_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_api_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionsByIndexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionByHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionsByAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUnconfirmedActionsByAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionsByBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockProducerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockMetasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockMetasByIndexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockMetaByHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockMetasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChainMetaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChainMetaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerMetaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerMetaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendSignedActionBytesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReceiptByActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReceiptByActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadContractRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadContractResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestGasPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestGasPriceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateGasForActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateActionGasConsumptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateActionGasConsumptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateGasForActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEpochMetaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEpochMetaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRawBlocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRawBlocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsByBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsByRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Topics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogsFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEvmTransfersByActionHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEvmTransfersByActionHashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEvmTransfersByBlockHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEvmTransfersByBlockHeightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionLogByActionHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionLogByActionHashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionLogByBlockHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionLogByBlockHeightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageSlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StructLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBlocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBlocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingActionsFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPendingActionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPendingActionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamRoundLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamRoundLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndorsementLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitionLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissingEndorsers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetElectionBucketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetElectionBucketsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_api_api_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*GetActionsRequest_ByIndex)(nil),
+		(*GetActionsRequest_ByHash)(nil),
+		(*GetActionsRequest_ByAddr)(nil),
+		(*GetActionsRequest_UnconfirmedByAddr)(nil),
+		(*GetActionsRequest_ByBlk)(nil),
+	}
+	file_proto_api_api_proto_msgTypes[14].OneofWrappers = []interface{}{
+		(*GetBlockMetasRequest_ByIndex)(nil),
+		(*GetBlockMetasRequest_ByHash)(nil),
+	}
+	file_proto_api_api_proto_msgTypes[32].OneofWrappers = []interface{}{
+		(*EstimateActionGasConsumptionRequest_Transfer)(nil),
+		(*EstimateActionGasConsumptionRequest_Execution)(nil),
+		(*EstimateActionGasConsumptionRequest_StakeCreate)(nil),
+		(*EstimateActionGasConsumptionRequest_StakeUnstake)(nil),
+		(*EstimateActionGasConsumptionRequest_StakeWithdraw)(nil),
+		(*EstimateActionGasConsumptionRequest_StakeAddDeposit)(nil),
+		(*EstimateActionGasConsumptionRequest_StakeRestake)(nil),
+		(*EstimateActionGasConsumptionRequest_StakeChangeCandidate)(nil),
+		(*EstimateActionGasConsumptionRequest_StakeTransferOwnership)(nil),
+		(*EstimateActionGasConsumptionRequest_CandidateRegister)(nil),
+		(*EstimateActionGasConsumptionRequest_CandidateUpdate)(nil),
+	}
+	file_proto_api_api_proto_msgTypes[45].OneofWrappers = []interface{}{
+		(*GetLogsRequest_ByBlock)(nil),
+		(*GetLogsRequest_ByRange)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_api_api_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   81,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_proto_api_api_proto_goTypes,
+		DependencyIndexes: file_proto_api_api_proto_depIdxs,
+		MessageInfos:      file_proto_api_api_proto_msgTypes,
+	}.Build()
+	File_proto_api_api_proto = out.File
+	file_proto_api_api_proto_rawDesc = nil
+	file_proto_api_api_proto_goTypes = nil
+	file_proto_api_api_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// APIServiceClient is the client API for APIService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIServiceClient interface {
+	// get the address detail of an address
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	// get action(s) by:
+	// 1. start index and action count
+	// 2. action hash
+	// 3. address with start index and action count
+	// 4. get unconfirmed actions by address with start index and action count
+	// 5. block hash with start index and action count
+	GetActions(ctx context.Context, in *GetActionsRequest, opts ...grpc.CallOption) (*GetActionsResponse, error)
+	// get block metadata(s) by:
+	// 1. start index and block count
+	// 2. block hash
+	GetBlockMetas(ctx context.Context, in *GetBlockMetasRequest, opts ...grpc.CallOption) (*GetBlockMetasResponse, error)
+	// get chain metadata
+	GetChainMeta(ctx context.Context, in *GetChainMetaRequest, opts ...grpc.CallOption) (*GetChainMetaResponse, error)
+	// get server version
+	GetServerMeta(ctx context.Context, in *GetServerMetaRequest, opts ...grpc.CallOption) (*GetServerMetaResponse, error)
+	// sendAction
+	SendAction(ctx context.Context, in *SendActionRequest, opts ...grpc.CallOption) (*SendActionResponse, error)
+	// get receipt by action Hash
+	GetReceiptByAction(ctx context.Context, in *GetReceiptByActionRequest, opts ...grpc.CallOption) (*GetReceiptByActionResponse, error)
+	// TODO: read contract
+	ReadContract(ctx context.Context, in *ReadContractRequest, opts ...grpc.CallOption) (*ReadContractResponse, error)
+	// suggest gas price
+	SuggestGasPrice(ctx context.Context, in *SuggestGasPriceRequest, opts ...grpc.CallOption) (*SuggestGasPriceResponse, error)
+	// estimate gas for action, to be deprecated
+	EstimateGasForAction(ctx context.Context, in *EstimateGasForActionRequest, opts ...grpc.CallOption) (*EstimateGasForActionResponse, error)
+	// estimate gas for action and transfer not sealed
+	EstimateActionGasConsumption(ctx context.Context, in *EstimateActionGasConsumptionRequest, opts ...grpc.CallOption) (*EstimateActionGasConsumptionResponse, error)
+	// read state from blockchain
+	ReadState(ctx context.Context, in *ReadStateRequest, opts ...grpc.CallOption) (*ReadStateResponse, error)
+	// get epoch metadata
+	GetEpochMeta(ctx context.Context, in *GetEpochMetaRequest, opts ...grpc.CallOption) (*GetEpochMetaResponse, error)
+	// get raw blocks data
+	GetRawBlocks(ctx context.Context, in *GetRawBlocksRequest, opts ...grpc.CallOption) (*GetRawBlocksResponse, error)
+	// get logs filtered by contract address and topics
+	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
+	// Deprecated
+	GetEvmTransfersByActionHash(ctx context.Context, in *GetEvmTransfersByActionHashRequest, opts ...grpc.CallOption) (*GetEvmTransfersByActionHashResponse, error)
+	// Deprecated
+	GetEvmTransfersByBlockHeight(ctx context.Context, in *GetEvmTransfersByBlockHeightRequest, opts ...grpc.CallOption) (*GetEvmTransfersByBlockHeightResponse, error)
+	GetTransactionLogByActionHash(ctx context.Context, in *GetTransactionLogByActionHashRequest, opts ...grpc.CallOption) (*GetTransactionLogByActionHashResponse, error)
+	GetTransactionLogByBlockHeight(ctx context.Context, in *GetTransactionLogByBlockHeightRequest, opts ...grpc.CallOption) (*GetTransactionLogByBlockHeightResponse, error)
+	// get the merkle proof of an account and its storage slots against the state root
+	GetAccountProof(ctx context.Context, in *GetAccountProofRequest, opts ...grpc.CallOption) (*GetAccountProofResponse, error)
+	// get the merkle proof of an action against the tx root of the block containing it
+	GetActionProof(ctx context.Context, in *GetActionProofRequest, opts ...grpc.CallOption) (*GetActionProofResponse, error)
+	// re-run a committed action with the tracer, on top of the state of the parent block and the actions before it in the block
+	TraceAction(ctx context.Context, in *TraceActionRequest, opts ...grpc.CallOption) (*TraceActionResponse, error)
+	// get block info in stream
+	StreamBlocks(ctx context.Context, in *StreamBlocksRequest, opts ...grpc.CallOption) (APIService_StreamBlocksClient, error)
+	// get logs filtered by contract address and topics in stream
+	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (APIService_StreamLogsClient, error)
+	// get the actions entering actpool filtered by senders, recipients and action types in stream
+	StreamPendingActions(ctx context.Context, in *StreamPendingActionsRequest, opts ...grpc.CallOption) (APIService_StreamPendingActionsClient, error)
+	// get the logs of the consensus rounds in stream, a log is sent once its round is closed
+	StreamRoundLogs(ctx context.Context, in *StreamRoundLogsRequest, opts ...grpc.CallOption) (APIService_StreamRoundLogsClient, error)
+	//
+	// election APIs
+	GetElectionBuckets(ctx context.Context, in *GetElectionBucketsRequest, opts ...grpc.CallOption) (*GetElectionBucketsResponse, error)
+}
+
+type aPIServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAPIServiceClient(cc grpc.ClientConnInterface) APIServiceClient {
+	return &aPIServiceClient{cc}
+}
+
+func (c *aPIServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetActions(ctx context.Context, in *GetActionsRequest, opts ...grpc.CallOption) (*GetActionsResponse, error) {
+	out := new(GetActionsResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetBlockMetas(ctx context.Context, in *GetBlockMetasRequest, opts ...grpc.CallOption) (*GetBlockMetasResponse, error) {
+	out := new(GetBlockMetasResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetBlockMetas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetChainMeta(ctx context.Context, in *GetChainMetaRequest, opts ...grpc.CallOption) (*GetChainMetaResponse, error) {
+	out := new(GetChainMetaResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetChainMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetServerMeta(ctx context.Context, in *GetServerMetaRequest, opts ...grpc.CallOption) (*GetServerMetaResponse, error) {
+	out := new(GetServerMetaResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetServerMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) SendAction(ctx context.Context, in *SendActionRequest, opts ...grpc.CallOption) (*SendActionResponse, error) {
+	out := new(SendActionResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/SendAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetReceiptByAction(ctx context.Context, in *GetReceiptByActionRequest, opts ...grpc.CallOption) (*GetReceiptByActionResponse, error) {
+	out := new(GetReceiptByActionResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetReceiptByAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ReadContract(ctx context.Context, in *ReadContractRequest, opts ...grpc.CallOption) (*ReadContractResponse, error) {
+	out := new(ReadContractResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/ReadContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) SuggestGasPrice(ctx context.Context, in *SuggestGasPriceRequest, opts ...grpc.CallOption) (*SuggestGasPriceResponse, error) {
+	out := new(SuggestGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/SuggestGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) EstimateGasForAction(ctx context.Context, in *EstimateGasForActionRequest, opts ...grpc.CallOption) (*EstimateGasForActionResponse, error) {
+	out := new(EstimateGasForActionResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/EstimateGasForAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) EstimateActionGasConsumption(ctx context.Context, in *EstimateActionGasConsumptionRequest, opts ...grpc.CallOption) (*EstimateActionGasConsumptionResponse, error) {
+	out := new(EstimateActionGasConsumptionResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/EstimateActionGasConsumption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ReadState(ctx context.Context, in *ReadStateRequest, opts ...grpc.CallOption) (*ReadStateResponse, error) {
+	out := new(ReadStateResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/ReadState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetEpochMeta(ctx context.Context, in *GetEpochMetaRequest, opts ...grpc.CallOption) (*GetEpochMetaResponse, error) {
+	out := new(GetEpochMetaResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetEpochMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetRawBlocks(ctx context.Context, in *GetRawBlocksRequest, opts ...grpc.CallOption) (*GetRawBlocksResponse, error) {
+	out := new(GetRawBlocksResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetRawBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error) {
+	out := new(GetLogsResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetEvmTransfersByActionHash(ctx context.Context, in *GetEvmTransfersByActionHashRequest, opts ...grpc.CallOption) (*GetEvmTransfersByActionHashResponse, error) {
+	out := new(GetEvmTransfersByActionHashResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetEvmTransfersByActionHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetEvmTransfersByBlockHeight(ctx context.Context, in *GetEvmTransfersByBlockHeightRequest, opts ...grpc.CallOption) (*GetEvmTransfersByBlockHeightResponse, error) {
+	out := new(GetEvmTransfersByBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetEvmTransfersByBlockHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetTransactionLogByActionHash(ctx context.Context, in *GetTransactionLogByActionHashRequest, opts ...grpc.CallOption) (*GetTransactionLogByActionHashResponse, error) {
+	out := new(GetTransactionLogByActionHashResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetTransactionLogByActionHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetTransactionLogByBlockHeight(ctx context.Context, in *GetTransactionLogByBlockHeightRequest, opts ...grpc.CallOption) (*GetTransactionLogByBlockHeightResponse, error) {
+	out := new(GetTransactionLogByBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetTransactionLogByBlockHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetAccountProof(ctx context.Context, in *GetAccountProofRequest, opts ...grpc.CallOption) (*GetAccountProofResponse, error) {
+	out := new(GetAccountProofResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetAccountProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetActionProof(ctx context.Context, in *GetActionProofRequest, opts ...grpc.CallOption) (*GetActionProofResponse, error) {
+	out := new(GetActionProofResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetActionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) TraceAction(ctx context.Context, in *TraceActionRequest, opts ...grpc.CallOption) (*TraceActionResponse, error) {
+	out := new(TraceActionResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/TraceAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) StreamBlocks(ctx context.Context, in *StreamBlocksRequest, opts ...grpc.CallOption) (APIService_StreamBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[0], "/iotexapi.APIService/StreamBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceStreamBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_StreamBlocksClient interface {
+	Recv() (*StreamBlocksResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceStreamBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceStreamBlocksClient) Recv() (*StreamBlocksResponse, error) {
+	m := new(StreamBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIServiceClient) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (APIService_StreamLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[1], "/iotexapi.APIService/StreamLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceStreamLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_StreamLogsClient interface {
+	Recv() (*StreamLogsResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceStreamLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceStreamLogsClient) Recv() (*StreamLogsResponse, error) {
+	m := new(StreamLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIServiceClient) StreamPendingActions(ctx context.Context, in *StreamPendingActionsRequest, opts ...grpc.CallOption) (APIService_StreamPendingActionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[2], "/iotexapi.APIService/StreamPendingActions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceStreamPendingActionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_StreamPendingActionsClient interface {
+	Recv() (*StreamPendingActionsResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceStreamPendingActionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceStreamPendingActionsClient) Recv() (*StreamPendingActionsResponse, error) {
+	m := new(StreamPendingActionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIServiceClient) StreamRoundLogs(ctx context.Context, in *StreamRoundLogsRequest, opts ...grpc.CallOption) (APIService_StreamRoundLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[3], "/iotexapi.APIService/StreamRoundLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceStreamRoundLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_StreamRoundLogsClient interface {
+	Recv() (*StreamRoundLogsResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceStreamRoundLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceStreamRoundLogsClient) Recv() (*StreamRoundLogsResponse, error) {
+	m := new(StreamRoundLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIServiceClient) GetElectionBuckets(ctx context.Context, in *GetElectionBucketsRequest, opts ...grpc.CallOption) (*GetElectionBucketsResponse, error) {
+	out := new(GetElectionBucketsResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetElectionBuckets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIServiceServer is the server API for APIService service.
+type APIServiceServer interface {
+	// get the address detail of an address
+	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	// get action(s) by:
+	// 1. start index and action count
+	// 2. action hash
+	// 3. address with start index and action count
+	// 4. get unconfirmed actions by address with start index and action count
+	// 5. block hash with start index and action count
+	GetActions(context.Context, *GetActionsRequest) (*GetActionsResponse, error)
+	// get block metadata(s) by:
+	// 1. start index and block count
+	// 2. block hash
+	GetBlockMetas(context.Context, *GetBlockMetasRequest) (*GetBlockMetasResponse, error)
+	// get chain metadata
+	GetChainMeta(context.Context, *GetChainMetaRequest) (*GetChainMetaResponse, error)
+	// get server version
+	GetServerMeta(context.Context, *GetServerMetaRequest) (*GetServerMetaResponse, error)
+	// sendAction
+	SendAction(context.Context, *SendActionRequest) (*SendActionResponse, error)
+	// get receipt by action Hash
+	GetReceiptByAction(context.Context, *GetReceiptByActionRequest) (*GetReceiptByActionResponse, error)
+	// TODO: read contract
+	ReadContract(context.Context, *ReadContractRequest) (*ReadContractResponse, error)
+	// suggest gas price
+	SuggestGasPrice(context.Context, *SuggestGasPriceRequest) (*SuggestGasPriceResponse, error)
+	// estimate gas for action, to be deprecated
+	EstimateGasForAction(context.Context, *EstimateGasForActionRequest) (*EstimateGasForActionResponse, error)
+	// estimate gas for action and transfer not sealed
+	EstimateActionGasConsumption(context.Context, *EstimateActionGasConsumptionRequest) (*EstimateActionGasConsumptionResponse, error)
+	// read state from blockchain
+	ReadState(context.Context, *ReadStateRequest) (*ReadStateResponse, error)
+	// get epoch metadata
+	GetEpochMeta(context.Context, *GetEpochMetaRequest) (*GetEpochMetaResponse, error)
+	// get raw blocks data
+	GetRawBlocks(context.Context, *GetRawBlocksRequest) (*GetRawBlocksResponse, error)
+	// get logs filtered by contract address and topics
+	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
+	// Deprecated
+	GetEvmTransfersByActionHash(context.Context, *GetEvmTransfersByActionHashRequest) (*GetEvmTransfersByActionHashResponse, error)
+	// Deprecated
+	GetEvmTransfersByBlockHeight(context.Context, *GetEvmTransfersByBlockHeightRequest) (*GetEvmTransfersByBlockHeightResponse, error)
+	GetTransactionLogByActionHash(context.Context, *GetTransactionLogByActionHashRequest) (*GetTransactionLogByActionHashResponse, error)
+	GetTransactionLogByBlockHeight(context.Context, *GetTransactionLogByBlockHeightRequest) (*GetTransactionLogByBlockHeightResponse, error)
+	// get the merkle proof of an account and its storage slots against the state root
+	GetAccountProof(context.Context, *GetAccountProofRequest) (*GetAccountProofResponse, error)
+	// get the merkle proof of an action against the tx root of the block containing it
+	GetActionProof(context.Context, *GetActionProofRequest) (*GetActionProofResponse, error)
+	// re-run a committed action with the tracer, on top of the state of the parent block and the actions before it in the block
+	TraceAction(context.Context, *TraceActionRequest) (*TraceActionResponse, error)
+	// get block info in stream
+	StreamBlocks(*StreamBlocksRequest, APIService_StreamBlocksServer) error
+	// get logs filtered by contract address and topics in stream
+	StreamLogs(*StreamLogsRequest, APIService_StreamLogsServer) error
+	// get the actions entering actpool filtered by senders, recipients and action types in stream
+	StreamPendingActions(*StreamPendingActionsRequest, APIService_StreamPendingActionsServer) error
+	// get the logs of the consensus rounds in stream, a log is sent once its round is closed
+	StreamRoundLogs(*StreamRoundLogsRequest, APIService_StreamRoundLogsServer) error
+	//
+	// election APIs
+	GetElectionBuckets(context.Context, *GetElectionBucketsRequest) (*GetElectionBucketsResponse, error)
+}
+
+// UnimplementedAPIServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAPIServiceServer struct {
+}
+
+func (*UnimplementedAPIServiceServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetActions(context.Context, *GetActionsRequest) (*GetActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActions not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetBlockMetas(context.Context, *GetBlockMetasRequest) (*GetBlockMetasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockMetas not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetChainMeta(context.Context, *GetChainMetaRequest) (*GetChainMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainMeta not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetServerMeta(context.Context, *GetServerMetaRequest) (*GetServerMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerMeta not implemented")
+}
+func (*UnimplementedAPIServiceServer) SendAction(context.Context, *SendActionRequest) (*SendActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAction not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetReceiptByAction(context.Context, *GetReceiptByActionRequest) (*GetReceiptByActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceiptByAction not implemented")
+}
+func (*UnimplementedAPIServiceServer) ReadContract(context.Context, *ReadContractRequest) (*ReadContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadContract not implemented")
+}
+func (*UnimplementedAPIServiceServer) SuggestGasPrice(context.Context, *SuggestGasPriceRequest) (*SuggestGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestGasPrice not implemented")
+}
+func (*UnimplementedAPIServiceServer) EstimateGasForAction(context.Context, *EstimateGasForActionRequest) (*EstimateGasForActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasForAction not implemented")
+}
+func (*UnimplementedAPIServiceServer) EstimateActionGasConsumption(context.Context, *EstimateActionGasConsumptionRequest) (*EstimateActionGasConsumptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateActionGasConsumption not implemented")
+}
+func (*UnimplementedAPIServiceServer) ReadState(context.Context, *ReadStateRequest) (*ReadStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadState not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetEpochMeta(context.Context, *GetEpochMetaRequest) (*GetEpochMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpochMeta not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetRawBlocks(context.Context, *GetRawBlocksRequest) (*GetRawBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRawBlocks not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetEvmTransfersByActionHash(context.Context, *GetEvmTransfersByActionHashRequest) (*GetEvmTransfersByActionHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvmTransfersByActionHash not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetEvmTransfersByBlockHeight(context.Context, *GetEvmTransfersByBlockHeightRequest) (*GetEvmTransfersByBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvmTransfersByBlockHeight not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetTransactionLogByActionHash(context.Context, *GetTransactionLogByActionHashRequest) (*GetTransactionLogByActionHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionLogByActionHash not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetTransactionLogByBlockHeight(context.Context, *GetTransactionLogByBlockHeightRequest) (*GetTransactionLogByBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionLogByBlockHeight not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetAccountProof(context.Context, *GetAccountProofRequest) (*GetAccountProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountProof not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetActionProof(context.Context, *GetActionProofRequest) (*GetActionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActionProof not implemented")
+}
+func (*UnimplementedAPIServiceServer) TraceAction(context.Context, *TraceActionRequest) (*TraceActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceAction not implemented")
+}
+func (*UnimplementedAPIServiceServer) StreamBlocks(*StreamBlocksRequest, APIService_StreamBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlocks not implemented")
+}
+func (*UnimplementedAPIServiceServer) StreamLogs(*StreamLogsRequest, APIService_StreamLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
+}
+func (*UnimplementedAPIServiceServer) StreamPendingActions(*StreamPendingActionsRequest, APIService_StreamPendingActionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPendingActions not implemented")
+}
+func (*UnimplementedAPIServiceServer) StreamRoundLogs(*StreamRoundLogsRequest, APIService_StreamRoundLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRoundLogs not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetElectionBuckets(context.Context, *GetElectionBucketsRequest) (*GetElectionBucketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetElectionBuckets not implemented")
+}
+
+func RegisterAPIServiceServer(s *grpc.Server, srv APIServiceServer) {
+	s.RegisterService(&_APIService_serviceDesc, srv)
+}
+
+func _APIService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetActions(ctx, req.(*GetActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetBlockMetas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockMetasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetBlockMetas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetBlockMetas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetBlockMetas(ctx, req.(*GetBlockMetasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetChainMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetChainMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetChainMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetChainMeta(ctx, req.(*GetChainMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetServerMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetServerMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetServerMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetServerMeta(ctx, req.(*GetServerMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_SendAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SendAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/SendAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SendAction(ctx, req.(*SendActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetReceiptByAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiptByActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetReceiptByAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetReceiptByAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetReceiptByAction(ctx, req.(*GetReceiptByActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ReadContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ReadContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/ReadContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ReadContract(ctx, req.(*ReadContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_SuggestGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SuggestGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/SuggestGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SuggestGasPrice(ctx, req.(*SuggestGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_EstimateGasForAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasForActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).EstimateGasForAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/EstimateGasForAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).EstimateGasForAction(ctx, req.(*EstimateGasForActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_EstimateActionGasConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateActionGasConsumptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).EstimateActionGasConsumption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/EstimateActionGasConsumption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).EstimateActionGasConsumption(ctx, req.(*EstimateActionGasConsumptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ReadState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ReadState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/ReadState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ReadState(ctx, req.(*ReadStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetEpochMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpochMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetEpochMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetEpochMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetEpochMeta(ctx, req.(*GetEpochMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetRawBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetRawBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetRawBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetRawBlocks(ctx, req.(*GetRawBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetLogs(ctx, req.(*GetLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetEvmTransfersByActionHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEvmTransfersByActionHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetEvmTransfersByActionHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetEvmTransfersByActionHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetEvmTransfersByActionHash(ctx, req.(*GetEvmTransfersByActionHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetEvmTransfersByBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEvmTransfersByBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetEvmTransfersByBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetEvmTransfersByBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetEvmTransfersByBlockHeight(ctx, req.(*GetEvmTransfersByBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetTransactionLogByActionHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionLogByActionHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetTransactionLogByActionHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetTransactionLogByActionHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetTransactionLogByActionHash(ctx, req.(*GetTransactionLogByActionHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetTransactionLogByBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionLogByBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetTransactionLogByBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetTransactionLogByBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetTransactionLogByBlockHeight(ctx, req.(*GetTransactionLogByBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetAccountProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetAccountProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetAccountProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetAccountProof(ctx, req.(*GetAccountProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetActionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetActionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetActionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetActionProof(ctx, req.(*GetActionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_TraceAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).TraceAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/TraceAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).TraceAction(ctx, req.(*TraceActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_StreamBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).StreamBlocks(m, &aPIServiceStreamBlocksServer{stream})
+}
+
+type APIService_StreamBlocksServer interface {
+	Send(*StreamBlocksResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceStreamBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceStreamBlocksServer) Send(m *StreamBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _APIService_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).StreamLogs(m, &aPIServiceStreamLogsServer{stream})
+}
+
+type APIService_StreamLogsServer interface {
+	Send(*StreamLogsResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceStreamLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceStreamLogsServer) Send(m *StreamLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _APIService_StreamPendingActions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPendingActionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).StreamPendingActions(m, &aPIServiceStreamPendingActionsServer{stream})
+}
+
+type APIService_StreamPendingActionsServer interface {
+	Send(*StreamPendingActionsResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceStreamPendingActionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceStreamPendingActionsServer) Send(m *StreamPendingActionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _APIService_StreamRoundLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRoundLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).StreamRoundLogs(m, &aPIServiceStreamRoundLogsServer{stream})
+}
+
+type APIService_StreamRoundLogsServer interface {
+	Send(*StreamRoundLogsResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceStreamRoundLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceStreamRoundLogsServer) Send(m *StreamRoundLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _APIService_GetElectionBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetElectionBucketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetElectionBuckets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetElectionBuckets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetElectionBuckets(ctx, req.(*GetElectionBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _APIService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "iotexapi.APIService",
+	HandlerType: (*APIServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccount",
+			Handler:    _APIService_GetAccount_Handler,
+		},
+		{
+			MethodName: "GetActions",
+			Handler:    _APIService_GetActions_Handler,
+		},
+		{
+			MethodName: "GetBlockMetas",
+			Handler:    _APIService_GetBlockMetas_Handler,
+		},
+		{
+			MethodName: "GetChainMeta",
+			Handler:    _APIService_GetChainMeta_Handler,
+		},
+		{
+			MethodName: "GetServerMeta",
+			Handler:    _APIService_GetServerMeta_Handler,
+		},
+		{
+			MethodName: "SendAction",
+			Handler:    _APIService_SendAction_Handler,
+		},
+		{
+			MethodName: "GetReceiptByAction",
+			Handler:    _APIService_GetReceiptByAction_Handler,
+		},
+		{
+			MethodName: "ReadContract",
+			Handler:    _APIService_ReadContract_Handler,
+		},
+		{
+			MethodName: "SuggestGasPrice",
+			Handler:    _APIService_SuggestGasPrice_Handler,
+		},
+		{
+			MethodName: "EstimateGasForAction",
+			Handler:    _APIService_EstimateGasForAction_Handler,
+		},
+		{
+			MethodName: "EstimateActionGasConsumption",
+			Handler:    _APIService_EstimateActionGasConsumption_Handler,
+		},
+		{
+			MethodName: "ReadState",
+			Handler:    _APIService_ReadState_Handler,
+		},
+		{
+			MethodName: "GetEpochMeta",
+			Handler:    _APIService_GetEpochMeta_Handler,
+		},
+		{
+			MethodName: "GetRawBlocks",
+			Handler:    _APIService_GetRawBlocks_Handler,
+		},
+		{
+			MethodName: "GetLogs",
+			Handler:    _APIService_GetLogs_Handler,
+		},
+		{
+			MethodName: "GetEvmTransfersByActionHash",
+			Handler:    _APIService_GetEvmTransfersByActionHash_Handler,
+		},
+		{
+			MethodName: "GetEvmTransfersByBlockHeight",
+			Handler:    _APIService_GetEvmTransfersByBlockHeight_Handler,
+		},
+		{
+			MethodName: "GetTransactionLogByActionHash",
+			Handler:    _APIService_GetTransactionLogByActionHash_Handler,
+		},
+		{
+			MethodName: "GetTransactionLogByBlockHeight",
+			Handler:    _APIService_GetTransactionLogByBlockHeight_Handler,
+		},
+		{
+			MethodName: "GetAccountProof",
+			Handler:    _APIService_GetAccountProof_Handler,
+		},
+		{
+			MethodName: "GetActionProof",
+			Handler:    _APIService_GetActionProof_Handler,
+		},
+		{
+			MethodName: "TraceAction",
+			Handler:    _APIService_TraceAction_Handler,
+		},
+		{
+			MethodName: "GetElectionBuckets",
+			Handler:    _APIService_GetElectionBuckets_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBlocks",
+			Handler:       _APIService_StreamBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _APIService_StreamLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPendingActions",
+			Handler:       _APIService_StreamPendingActions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamRoundLogs",
+			Handler:       _APIService_StreamRoundLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/api/api.proto",
+}
+
+// TransactionLogServiceClient is the client API for TransactionLogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TransactionLogServiceClient interface {
+	GetTransactionLogByActionHash(ctx context.Context, in *GetTransactionLogByActionHashRequest, opts ...grpc.CallOption) (*GetTransactionLogByActionHashResponse, error)
+	GetTransactionLogByBlockHeight(ctx context.Context, in *GetTransactionLogByBlockHeightRequest, opts ...grpc.CallOption) (*GetTransactionLogByBlockHeightResponse, error)
+}
+
+type transactionLogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransactionLogServiceClient(cc grpc.ClientConnInterface) TransactionLogServiceClient {
+	return &transactionLogServiceClient{cc}
+}
+
+func (c *transactionLogServiceClient) GetTransactionLogByActionHash(ctx context.Context, in *GetTransactionLogByActionHashRequest, opts ...grpc.CallOption) (*GetTransactionLogByActionHashResponse, error) {
+	out := new(GetTransactionLogByActionHashResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.TransactionLogService/GetTransactionLogByActionHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionLogServiceClient) GetTransactionLogByBlockHeight(ctx context.Context, in *GetTransactionLogByBlockHeightRequest, opts ...grpc.CallOption) (*GetTransactionLogByBlockHeightResponse, error) {
+	out := new(GetTransactionLogByBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.TransactionLogService/GetTransactionLogByBlockHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransactionLogServiceServer is the server API for TransactionLogService service.
+type TransactionLogServiceServer interface {
+	GetTransactionLogByActionHash(context.Context, *GetTransactionLogByActionHashRequest) (*GetTransactionLogByActionHashResponse, error)
+	GetTransactionLogByBlockHeight(context.Context, *GetTransactionLogByBlockHeightRequest) (*GetTransactionLogByBlockHeightResponse, error)
+}
+
+// UnimplementedTransactionLogServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedTransactionLogServiceServer struct {
+}
+
+func (*UnimplementedTransactionLogServiceServer) GetTransactionLogByActionHash(context.Context, *GetTransactionLogByActionHashRequest) (*GetTransactionLogByActionHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionLogByActionHash not implemented")
+}
+func (*UnimplementedTransactionLogServiceServer) GetTransactionLogByBlockHeight(context.Context, *GetTransactionLogByBlockHeightRequest) (*GetTransactionLogByBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionLogByBlockHeight not implemented")
+}
+
+func RegisterTransactionLogServiceServer(s *grpc.Server, srv TransactionLogServiceServer) {
+	s.RegisterService(&_TransactionLogService_serviceDesc, srv)
+}
+
+func _TransactionLogService_GetTransactionLogByActionHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionLogByActionHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionLogServiceServer).GetTransactionLogByActionHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.TransactionLogService/GetTransactionLogByActionHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionLogServiceServer).GetTransactionLogByActionHash(ctx, req.(*GetTransactionLogByActionHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionLogService_GetTransactionLogByBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionLogByBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionLogServiceServer).GetTransactionLogByBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.TransactionLogService/GetTransactionLogByBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionLogServiceServer).GetTransactionLogByBlockHeight(ctx, req.(*GetTransactionLogByBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TransactionLogService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "iotexapi.TransactionLogService",
+	HandlerType: (*TransactionLogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTransactionLogByActionHash",
+			Handler:    _TransactionLogService_GetTransactionLogByActionHash_Handler,
+		},
+		{
+			MethodName: "GetTransactionLogByBlockHeight",
+			Handler:    _TransactionLogService_GetTransactionLogByBlockHeight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/api/api.proto",
+}
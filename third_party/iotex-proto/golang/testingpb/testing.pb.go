@@ -0,0 +1,162 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+// To compile the proto, run:
+//      protoc --go_out=plugins=grpc:$GOPATH/src *.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.4
+// source: proto/testing/testing.proto
+
+package testingpb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type TestPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgBody []byte `protobuf:"bytes,1,opt,name=msg_body,json=msgBody,proto3" json:"msg_body,omitempty"`
+}
+
+func (x *TestPayload) Reset() {
+	*x = TestPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_testing_testing_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestPayload) ProtoMessage() {}
+
+func (x *TestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_testing_testing_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestPayload.ProtoReflect.Descriptor instead.
+func (*TestPayload) Descriptor() ([]byte, []int) {
+	return file_proto_testing_testing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TestPayload) GetMsgBody() []byte {
+	if x != nil {
+		return x.MsgBody
+	}
+	return nil
+}
+
+var File_proto_testing_testing_proto protoreflect.FileDescriptor
+
+var file_proto_testing_testing_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f,
+	0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x74,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x22, 0x28, 0x0a, 0x0b, 0x54, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x5f, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x42, 0x6f,
+	0x64, 0x79, 0x42, 0x5e, 0x0a, 0x24, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_testing_testing_proto_rawDescOnce sync.Once
+	file_proto_testing_testing_proto_rawDescData = file_proto_testing_testing_proto_rawDesc
+)
+
+func file_proto_testing_testing_proto_rawDescGZIP() []byte {
+	file_proto_testing_testing_proto_rawDescOnce.Do(func() {
+		file_proto_testing_testing_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_testing_testing_proto_rawDescData)
+	})
+	return file_proto_testing_testing_proto_rawDescData
+}
+
+var file_proto_testing_testing_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_testing_testing_proto_goTypes = []interface{}{
+	(*TestPayload)(nil), // 0: testingpb.TestPayload
+}
+var file_proto_testing_testing_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_testing_testing_proto_init() }
+func file_proto_testing_testing_proto_init() {
+	if File_proto_testing_testing_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_testing_testing_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_testing_testing_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_testing_testing_proto_goTypes,
+		DependencyIndexes: file_proto_testing_testing_proto_depIdxs,
+		MessageInfos:      file_proto_testing_testing_proto_msgTypes,
+	}.Build()
+	File_proto_testing_testing_proto = out.File
+	file_proto_testing_testing_proto_rawDesc = nil
+	file_proto_testing_testing_proto_goTypes = nil
+	file_proto_testing_testing_proto_depIdxs = nil
+}
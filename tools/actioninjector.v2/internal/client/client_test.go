@@ -59,6 +59,7 @@ func TestClient(t *testing.T) {
 	})
 	sf.EXPECT().Height().Return(uint64(10), nil).AnyTimes()
 	bc.EXPECT().ChainID().Return(chainID).AnyTimes()
+	bc.EXPECT().TipHeight().Return(uint64(10)).AnyTimes()
 	bc.EXPECT().AddSubscriber(gomock.Any()).Return(nil).AnyTimes()
 	bh := &iotextypes.BlockHeader{Core: &iotextypes.BlockHeaderCore{
 		Version:          chainID,
@@ -74,6 +75,7 @@ func TestClient(t *testing.T) {
 	bc.EXPECT().BlockHeaderByHeight(gomock.Any()).Return(&blh, nil).AnyTimes()
 	ap.EXPECT().GetPendingNonce(gomock.Any()).Return(uint64(1), nil).AnyTimes()
 	ap.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ap.EXPECT().AddSubscriber(gomock.Any()).Return(nil).AnyTimes()
 	newOption := api.WithBroadcastOutbound(func(_ context.Context, _ uint32, _ proto.Message) error {
 		return nil
 	})
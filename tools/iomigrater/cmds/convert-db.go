@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/util/fileutil"
+	"github.com/iotexproject/iotex-core/tools/iomigrater/common"
+)
+
+// Multi-language support
+var (
+	convertDbCmdShorts = map[string]string{
+		"english": "Sub-Command for converting IoTeX bolt db file to level db.",
+		"chinese": "将IoTeX bolt db 文件转换为 level db 的子命令",
+	}
+	convertDbCmdLongs = map[string]string{
+		"english": "Sub-Command for converting IoTeX state db or index db file from bolt db to level db, " +
+			"set dbType to leveldb in config to use the converted db.",
+		"chinese": "将IoTeX状态 db 或索引 db 文件从 bolt db 转换为 level db 的子命令，在配置中将dbType设置为leveldb以使用转换后的 db",
+	}
+	convertDbCmdUse = map[string]string{
+		"english": "convert",
+		"chinese": "convert",
+	}
+	convertDbFlagBoltFileUse = map[string]string{
+		"english": "The bolt db file you want to convert.",
+		"chinese": "您要转换的 bolt db 文件。",
+	}
+	convertDbFlagLevelDirUse = map[string]string{
+		"english": "The path of the level db directory you want to convert to.",
+		"chinese": "您要转换到的 level db 目录路径。",
+	}
+	convertDbFlagBatchSizeUse = map[string]string{
+		"english": "The number of records written in one batch.",
+		"chinese": "每批写入的记录数。",
+	}
+)
+
+var (
+	// ConvertDb Used to Sub command.
+	ConvertDb = &cobra.Command{
+		Use:   common.TranslateInLang(convertDbCmdUse),
+		Short: common.TranslateInLang(convertDbCmdShorts),
+		Long:  common.TranslateInLang(convertDbCmdLongs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return convertDbFile()
+		},
+	}
+)
+
+var (
+	boltFile  = ""
+	levelDir  = ""
+	batchSize = 10000
+)
+
+func init() {
+	ConvertDb.PersistentFlags().StringVarP(&boltFile, "bolt-file", "o", "", common.TranslateInLang(convertDbFlagBoltFileUse))
+	ConvertDb.PersistentFlags().StringVarP(&levelDir, "level-dir", "n", "", common.TranslateInLang(convertDbFlagLevelDirUse))
+	ConvertDb.PersistentFlags().IntVarP(&batchSize, "batch-size", "b", 10000, common.TranslateInLang(convertDbFlagBatchSizeUse))
+}
+
+func convertDbFile() error {
+	// Check flags
+	if boltFile == "" {
+		return fmt.Errorf("--bolt-file is empty")
+	}
+	if levelDir == "" {
+		return fmt.Errorf("--level-dir is empty")
+	}
+	if !fileutil.FileExists(boltFile) {
+		return fmt.Errorf("The bolt db file %s does not exist", boltFile)
+	}
+	if fileutil.FileExists(levelDir) {
+		return fmt.Errorf("The level db directory %s already exists", levelDir)
+	}
+
+	cfg, err := config.New()
+	if err != nil {
+		return fmt.Errorf("Failed to new config: %v", err)
+	}
+
+	cfg.DB.DbPath = boltFile
+	bolt := db.NewBoltDB(cfg.DB)
+	cfg.DB.DbPath = levelDir
+	level := db.NewLevelDB(cfg.DB)
+
+	ctx := context.Background()
+	if err := bolt.Start(ctx); err != nil {
+		return fmt.Errorf("Failed to start the bolt db file")
+	}
+	defer bolt.Stop(ctx)
+	if err := level.Start(ctx); err != nil {
+		return fmt.Errorf("Failed to start the level db")
+	}
+	defer level.Stop(ctx)
+
+	if err := bolt.CopyTo(level, batchSize); err != nil {
+		return fmt.Errorf("Failed to convert %s to level db: %v", boltFile, err)
+	}
+	fmt.Printf("Converted %s to level db %s.\n", boltFile, levelDir)
+	return nil
+}
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/blockchain/integrity"
+	"github.com/iotexproject/iotex-core/blockindex"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/util/fileutil"
+	"github.com/iotexproject/iotex-core/server/itx"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/tools/iomigrater/common"
+)
+
+// Multi-language support
+var (
+	verifyDbCmdShorts = map[string]string{
+		"english": "Sub-Command for verifying the integrity of IoTeX blockchain db files.",
+		"chinese": "校验IoTeX区块链 db 文件完整性的子命令",
+	}
+	verifyDbCmdLongs = map[string]string{
+		"english": "Sub-Command for verifying the hash linkage, tx roots, receipt roots, footer endorsements, " +
+			"block index and state of IoTeX blockchain db files, and reporting the first inconsistency.",
+		"chinese": "校验IoTeX区块链 db 文件的哈希链接、交易根、收据根、背书、区块索引和状态，并报告第一个不一致之处的子命令",
+	}
+	verifyDbCmdUse = map[string]string{
+		"english": "verify",
+		"chinese": "verify",
+	}
+	verifyDbFlagStartHeightUse = map[string]string{
+		"english": "The height to start verifying from, 1 by default.",
+		"chinese": "开始校验的高度，默认为1。",
+	}
+	verifyDbFlagEndHeightUse = map[string]string{
+		"english": "The height to stop verifying at, the tip height by default.",
+		"chinese": "停止校验的高度，默认为最高高度。",
+	}
+	verifyDbFlagReplayUse = map[string]string{
+		"english": "Replay the blocks from genesis and compare the state with the state db.",
+		"chinese": "从创世区块重放区块并与状态数据库比较。",
+	}
+)
+
+var (
+	// VerifyDb Used to Sub command.
+	VerifyDb = &cobra.Command{
+		Use:   common.TranslateInLang(verifyDbCmdUse),
+		Short: common.TranslateInLang(verifyDbCmdShorts),
+		Long:  common.TranslateInLang(verifyDbCmdLongs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, err := verifyDbFile()
+			if err != nil {
+				fmt.Printf("Verify db err: %v\n", err)
+				return err
+			}
+			fmt.Println(report.String())
+			if report.Inconsistency != nil {
+				return fmt.Errorf("inconsistency found at height %d", report.Inconsistency.Height)
+			}
+			return nil
+		},
+	}
+)
+
+var (
+	verifyStartHeight = uint64(0)
+	verifyEndHeight   = uint64(0)
+	verifyReplay      = false
+)
+
+func init() {
+	VerifyDb.PersistentFlags().Uint64VarP(&verifyStartHeight, "start-height", "s", uint64(0), common.TranslateInLang(verifyDbFlagStartHeightUse))
+	VerifyDb.PersistentFlags().Uint64VarP(&verifyEndHeight, "end-height", "e", uint64(0), common.TranslateInLang(verifyDbFlagEndHeightUse))
+	VerifyDb.PersistentFlags().BoolVarP(&verifyReplay, "replay", "r", false, common.TranslateInLang(verifyDbFlagReplayUse))
+}
+
+func verifyDbFile() (*integrity.Report, error) {
+	if verifyReplay && verifyStartHeight > 1 {
+		return nil, fmt.Errorf("--replay can only be used when verifying from genesis")
+	}
+	cfg, err := config.New()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to new config: %v", err)
+	}
+	if cfg.Genesis, err = genesis.New(); err != nil {
+		return nil, fmt.Errorf("Failed to new genesis config: %v", err)
+	}
+
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{
+		Genesis: cfg.Genesis,
+		ChainID: cfg.Chain.ID,
+	})
+	dbCfg := cfg.DB
+	dbCfg.DbPath = cfg.Chain.ChainDBPath
+	dbCfg.CompressLegacy = cfg.Chain.CompressBlock
+	dao := blockdao.NewBlockDAO(nil, dbCfg)
+	if err := dao.Start(ctx); err != nil {
+		return nil, err
+	}
+	defer dao.Stop(ctx)
+
+	var opts []integrity.CheckerOption
+	if cfg.Consensus.Scheme == config.RollDPoSScheme {
+		opts = append(opts, integrity.EndorsementQuorumOption(cfg.Genesis.NumDelegates))
+	}
+	if _, gateway := cfg.Plugins[config.GatewayPlugin]; gateway && fileutil.FileExists(cfg.Chain.IndexDBPath) {
+		dbCfg.DbPath = cfg.Chain.IndexDBPath
+		indexer, err := blockindex.NewIndexer(db.NewKVStore(dbCfg), cfg.Genesis.Hash())
+		if err != nil {
+			return nil, err
+		}
+		if err := indexer.Start(ctx); err != nil {
+			return nil, err
+		}
+		defer indexer.Stop(ctx)
+		opts = append(opts, integrity.IndexerOption(indexer))
+	}
+	if verifyReplay {
+		replay, cleanup, err := newReplayFactory(cfg)
+		if err != nil {
+			return nil, err
+		}
+		defer cleanup()
+		if err := replay.Start(ctx); err != nil {
+			return nil, err
+		}
+		defer replay.Stop(ctx)
+
+		var sf factory.Factory
+		if cfg.Chain.EnableTrielessStateDB {
+			sf, err = factory.NewStateDB(cfg, factory.DefaultStateDBOption())
+		} else {
+			sf, err = factory.NewFactory(cfg, factory.DefaultTrieOption())
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := sf.Start(ctx); err != nil {
+			return nil, err
+		}
+		defer sf.Stop(ctx)
+		opts = append(opts, integrity.ReplayOption(replay), integrity.StateOption(sf))
+	}
+
+	checker, err := integrity.NewChecker(dao, cfg.Genesis, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return checker.Check(ctx, verifyStartHeight, verifyEndHeight)
+}
+
+// newReplayFactory creates a state factory in a temporary file, with all protocols registered
+func newReplayFactory(cfg config.Config) (factory.Factory, func(), error) {
+	tmp, err := ioutil.TempFile("", "replay.db")
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() { os.RemoveAll(tmp.Name()) }
+	if err := os.Remove(tmp.Name()); err != nil {
+		return nil, nil, err
+	}
+	cfg.Chain.TrieDBPath = tmp.Name()
+	cfg.Chain.EnableArchiveMode = false
+	svr, err := itx.NewServer(cfg)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return svr.ChainService(cfg.Chain.ID).StateFactory(), cleanup, nil
+}
@@ -48,6 +48,8 @@ func Execute() {
 func init() {
 	RootCmd.AddCommand(cmd.CheckHeight)
 	RootCmd.AddCommand(cmd.MigrateDb)
+	RootCmd.AddCommand(cmd.VerifyDb)
+	RootCmd.AddCommand(cmd.ConvertDb)
 
 	RootCmd.HelpFunc()
 }
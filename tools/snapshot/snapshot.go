@@ -0,0 +1,136 @@
+// Copyright (c) 2020 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+// This is a tool that exports the states of a stopped node into a snapshot archive, and imports the archive to
+// bootstrap a new node from the height of the snapshot.
+// To build, run "make snapshot"
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	glog "log"
+	"os"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/snapshot"
+)
+
+var (
+	// snapshotFile is the path of the snapshot archive
+	snapshotFile string
+	// exportHeight is the height of the exported snapshot
+	exportHeight uint64
+	// numHeaders is the number of block headers in the exported snapshot
+	numHeaders uint64
+	// trustedHash is the trusted hash of the block at the height of the imported snapshot
+	trustedHash string
+	// trustedStateDigest is the trusted digest of the states at the height of the imported snapshot
+	trustedStateDigest string
+)
+
+func init() {
+	flag.StringVar(&snapshotFile, "file", "snapshot.tar.gz", "Path of the snapshot archive")
+	flag.Uint64Var(&exportHeight, "height", 0, "Height to export, which must be the height of the state db")
+	flag.Uint64Var(&numHeaders, "headers", 0, "Number of block headers to export, 2 epochs by default")
+	flag.StringVar(&trustedHash, "trusted-hash", "", "Trusted hash of the block at the height of the snapshot to import")
+	flag.StringVar(&trustedStateDigest, "trusted-state-digest", "", "Trusted digest of the states at the height of the snapshot to import")
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr,
+			"usage: snapshot -config-path=[string] -file=[string] [-height=[int]] [-headers=[int]] export\n"+
+				"       snapshot -config-path=[string] -file=[string] -trusted-hash=[string] -trusted-state-digest=[string] import\n"+
+				"       snapshot -config-path=[string] digest\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+}
+
+func main() {
+	if flag.NArg() != 1 {
+		flag.Usage()
+	}
+	genesisCfg, err := genesis.New()
+	if err != nil {
+		glog.Fatalln("Failed to new genesis config.", zap.Error(err))
+	}
+	cfg, err := config.New()
+	if err != nil {
+		glog.Fatalln("Failed to new config.", zap.Error(err))
+	}
+	cfg.Genesis = genesisCfg
+
+	switch flag.Arg(0) {
+	case "export":
+		if numHeaders == 0 {
+			// headers of the current and the last epoch are needed by consensus
+			numHeaders = 2 * cfg.Genesis.NumDelegates * cfg.Genesis.NumSubEpochs
+		}
+		file, err := os.Create(snapshotFile)
+		if err != nil {
+			log.L().Fatal("Failed to create snapshot file.", zap.Error(err))
+		}
+		manifest, err := snapshot.Export(cfg, file, exportHeight, numHeaders)
+		if err != nil {
+			file.Close()
+			os.Remove(snapshotFile)
+			log.L().Fatal("Failed to export snapshot.", zap.Error(err))
+		}
+		if err := file.Close(); err != nil {
+			log.L().Fatal("Failed to close snapshot file.", zap.Error(err))
+		}
+		log.S().Infof("Exported snapshot at height %d, block hash %s, state digest %s",
+			manifest.Height, manifest.TipHash, manifest.StateDigest)
+	case "import":
+		trusted, err := decodeHash(trustedHash)
+		if err != nil {
+			log.L().Fatal("Invalid trusted hash.", zap.String("hash", trustedHash), zap.Error(err))
+		}
+		trustedState, err := decodeHash(trustedStateDigest)
+		if err != nil {
+			log.L().Fatal("Invalid trusted state digest.", zap.String("digest", trustedStateDigest), zap.Error(err))
+		}
+		file, err := os.Open(snapshotFile)
+		if err != nil {
+			log.L().Fatal("Failed to open snapshot file.", zap.Error(err))
+		}
+		defer file.Close()
+		manifest, err := snapshot.Import(cfg, file, trusted, trustedState)
+		if err != nil {
+			log.L().Fatal("Failed to import snapshot.", zap.Error(err))
+		}
+		log.S().Infof("Imported snapshot at height %d, block hash %s", manifest.Height, manifest.TipHash)
+	case "digest":
+		// prints the state digest of a stopped trusted node, to be used as the trusted state digest of a snapshot
+		dbCfg := cfg.DB
+		dbCfg.DbPath = cfg.Chain.TrieDBPath
+		digest, err := snapshot.StateDigest(dbCfg)
+		if err != nil {
+			log.L().Fatal("Failed to read state digest.", zap.Error(err))
+		}
+		log.S().Infof("State digest %x", digest)
+	default:
+		flag.Usage()
+	}
+}
+
+func decodeHash(s string) (hash.Hash256, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return hash.ZeroHash256, err
+	}
+	if len(b) != len(hash.ZeroHash256) {
+		return hash.ZeroHash256, errors.Errorf("invalid length %d", len(b))
+	}
+	return hash.BytesToHash256(b), nil
+}